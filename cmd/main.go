@@ -44,6 +44,8 @@ const (
 	WorkerArg      = "worker"
 	BroadcasterArg = "broadcaster"
 	MonitorArg     = "monitor"
+	ReplayArg      = "replay"
+	BackfillArg    = "backfill"
 )
 
 var command = cobra.Command{
@@ -229,6 +231,10 @@ var command = cobra.Command{
 			return runBroadcaster(cmd.Context(), configFile)
 		case MonitorArg:
 			return runMonitor(cmd.Context(), configFile, databaseClient, redisClient, networkParamsCaller, settlementCaller)
+		case ReplayArg:
+			return runReplay(cmd.Context(), configFile, databaseClient, streamClient, redisClient)
+		case BackfillArg:
+			return runBackfill(cmd.Context(), configFile, databaseClient, streamClient, redisClient)
 		}
 
 		return fmt.Errorf("unsupported module %s", lo.Must(flags.GetString(flag.KeyModule)))
@@ -262,7 +268,10 @@ func recordFirstStartTime() error {
 func runCoreService(ctx context.Context, configFile *config.File, databaseClient database.Client, redisClient rueidis.Client, networkParamsCaller *vsl.NetworkParamsCaller, settlementCaller *vsl.SettlementCaller) error {
 	zap.L().Info("initializing core service")
 
-	server := node.NewCoreService(ctx, configFile, databaseClient, redisClient, networkParamsCaller, settlementCaller)
+	server, err := node.NewCoreService(ctx, configFile, databaseClient, redisClient, networkParamsCaller, settlementCaller)
+	if err != nil {
+		return fmt.Errorf("new core service: %w", err)
+	}
 
 	checkCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -361,6 +370,58 @@ func runWorker(ctx context.Context, configFile *config.File, databaseClient data
 	return server.Run(ctx)
 }
 
+// runReplay replays the tasks of the worker that failed to be transformed.
+func runReplay(ctx context.Context, configFile *config.File, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) error {
+	workerID, err := flags.GetString(flag.KeyWorkerID)
+	if err != nil {
+		return fmt.Errorf("invalid worker id: %w", err)
+	}
+
+	zap.L().Info("starting replay", zap.String("workerID", workerID))
+
+	module, err := findModuleByID(configFile, workerID)
+	if err != nil {
+		return fmt.Errorf("find module by id: %w", err)
+	}
+
+	if err := indexer.Replay(ctx, module, databaseClient, streamClient, redisClient); err != nil {
+		return fmt.Errorf("replay dead letters: %w", err)
+	}
+
+	return nil
+}
+
+// runBackfill indexes a block range of the worker with range-sharded servers, or the history of accounts.
+// The live worker must be stopped before the block range is merged into its checkpoint.
+func runBackfill(ctx context.Context, configFile *config.File, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) error {
+	workerID, err := flags.GetString(flag.KeyWorkerID)
+	if err != nil {
+		return fmt.Errorf("invalid worker id: %w", err)
+	}
+
+	module, err := findModuleByID(configFile, workerID)
+	if err != nil {
+		return fmt.Errorf("find module by id: %w", err)
+	}
+
+	option := indexer.BackfillOption{
+		BlockStart:  lo.Must(flags.GetUint64(flag.KeyBackfillBlockStart)),
+		BlockTarget: lo.Must(flags.GetUint64(flag.KeyBackfillBlockTarget)),
+		Shards:      lo.Must(flags.GetUint64(flag.KeyBackfillShards)),
+		Accounts:    lo.Must(flags.GetStringSlice(flag.KeyBackfillAccounts)),
+	}
+
+	zap.L().Info("starting backfill",
+		zap.String("workerID", workerID),
+		zap.Any("option", option))
+
+	if err := indexer.Backfill(ctx, module, option, databaseClient, streamClient, redisClient); err != nil {
+		return fmt.Errorf("backfill: %w", err)
+	}
+
+	return nil
+}
+
 func runBroadcaster(ctx context.Context, config *config.File) error {
 	zap.L().Info("initializing broadcaster")
 
@@ -467,6 +528,10 @@ func init() {
 	command.PersistentFlags().String(flag.KeyConfig, "config.yaml", "config file name")
 	command.PersistentFlags().String(flag.KeyModule, WorkerArg, "module name")
 	command.PersistentFlags().String(flag.KeyWorkerID, "", "worker id")
+	command.PersistentFlags().Uint64(flag.KeyBackfillBlockStart, 0, "block number the backfill starts after")
+	command.PersistentFlags().Uint64(flag.KeyBackfillBlockTarget, 0, "block number the backfill stops at")
+	command.PersistentFlags().Uint64(flag.KeyBackfillShards, 4, "number of shards the backfill range is split into")
+	command.PersistentFlags().StringSlice(flag.KeyBackfillAccounts, nil, "handles of the accounts to backfill, e.g. @username@domain")
 	zap.L().Debug("command flags initialized")
 }
 
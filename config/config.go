@@ -12,11 +12,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-playground/validator/v10"
 	"github.com/mitchellh/mapstructure"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/stream"
 	"github.com/rss3-network/node/v2/provider/ethereum"
 	"github.com/rss3-network/node/v2/schema/worker"
 	"github.com/rss3-network/node/v2/schema/worker/federated"
 	"github.com/rss3-network/node/v2/schema/worker/rss"
 	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
 	"github.com/samber/lo"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -89,6 +92,20 @@ type Server struct {
 	Endpoint              string `mapstructure:"endpoint"`
 	GlobalIndexerEndpoint string `mapstructure:"global_indexer_endpoint"`
 	AccessToken           string `mapstructure:"access_token"`
+	// APIKeys are the named keys with scopes and limits, the access token is a key with all scopes and without limits.
+	APIKeys []*APIKey `mapstructure:"api_keys" validate:"dive"`
+}
+
+type APIKey struct {
+	Name   string   `mapstructure:"name" validate:"required"`
+	Key    string   `mapstructure:"key" validate:"required,min=16"`
+	Scopes []string `mapstructure:"scopes" validate:"min=1,dive,oneof=decentralized federated rss ai info operator"`
+	// RateLimit is the number of requests per second, zero disables the rate limit.
+	RateLimit float64 `mapstructure:"rate_limit" validate:"min=0"`
+	// Burst is the number of requests allowed at once, it defaults to the rate limit.
+	Burst int `mapstructure:"burst" validate:"min=0"`
+	// DailyQuota is the number of requests per UTC day, zero disables the quota.
+	DailyQuota int64 `mapstructure:"daily_quota" validate:"min=0"`
 }
 
 type Component struct {
@@ -130,13 +147,67 @@ func (e Endpoint) BuildEthereumOptions() []ethereum.Option {
 }
 
 type Database struct {
-	CoveragePeriod int    `mapstructure:"coverage_period" validate:"min=3,max=12" default:"3"`
-	URI            string `mapstructure:"uri" validate:"required" default:"postgres://postgres@localhost:5432/postgres"`
+	Driver         database.Driver `mapstructure:"driver" validate:"required,oneof=postgres mysql sqlite" default:"postgres"`
+	CoveragePeriod int             `mapstructure:"coverage_period" validate:"min=3,max=12" default:"3"`
+	// URI is the path of the database file if the driver is sqlite.
+	URI string `mapstructure:"uri" validate:"required" default:"postgres://postgres@localhost:5432/postgres"`
 }
 
 type Stream struct {
-	Enable *bool  `mapstructure:"enable" validate:"required" default:"false"`
-	URI    string `mapstructure:"uri" validate:"required" default:"localhost:9092"`
+	Enable *bool         `mapstructure:"enable" validate:"required" default:"false"`
+	Driver stream.Driver `mapstructure:"driver" validate:"required,oneof=kafka pulsar nats redis" default:"kafka"`
+	URI    string        `mapstructure:"uri" validate:"required" default:"localhost:9092"`
+	// Topic is the topic of activities that do not match any route.
+	Topic string `mapstructure:"topic" validate:"required" default:"rss3.node.activities"`
+	// Partitions and ReplicationFactor are used to create the Kafka topics.
+	Partitions        int32 `mapstructure:"partitions" validate:"min=1" default:"1"`
+	ReplicationFactor int16 `mapstructure:"replication_factor" validate:"min=1" default:"1"`
+	// Key is the field of activities used as the message key.
+	Key      stream.Key      `mapstructure:"key" validate:"required,oneof=id owner network" default:"id"`
+	Encoding stream.Encoding `mapstructure:"encoding" validate:"required,oneof=json avro" default:"json"`
+	// SchemaRegistry is the endpoint of the schema registry, which is required by the avro encoding.
+	SchemaRegistry string         `mapstructure:"schema_registry" validate:"required_if=Encoding avro"`
+	Routes         []*StreamRoute `mapstructure:"routes" validate:"dive"`
+}
+
+// Validate returns an error if options supported by the Kafka driver only are set for another driver,
+// the other drivers write the JSON activities to the default topic.
+func (s *Stream) Validate() error {
+	if s.Driver == stream.DriverKafka {
+		return nil
+	}
+
+	var unsupported []string
+
+	if len(s.Routes) > 0 {
+		unsupported = append(unsupported, "routes")
+	}
+
+	if s.Key != stream.KeyID {
+		unsupported = append(unsupported, "key")
+	}
+
+	if s.Encoding != stream.EncodingJSON || s.SchemaRegistry != "" {
+		unsupported = append(unsupported, "encoding")
+	}
+
+	if s.Partitions != 1 || s.ReplicationFactor != 1 {
+		unsupported = append(unsupported, "partitions")
+	}
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("stream options %s are not supported by the %s driver", strings.Join(unsupported, ", "), s.Driver)
+	}
+
+	return nil
+}
+
+// StreamRoute writes the activities matching any of the networks and any of the tags to the topic,
+// an empty list matches all networks or tags.
+type StreamRoute struct {
+	Topic    string            `mapstructure:"topic" validate:"required"`
+	Networks []network.Network `mapstructure:"networks"`
+	Tags     []tag.Tag         `mapstructure:"tags"`
 }
 
 type Telemetry struct {
@@ -160,6 +231,9 @@ type OpenTelemetryTracesConfig struct {
 }
 
 type Redis struct {
+	// Embedded starts an in-process Redis server listening on the endpoint instead of connecting to an external one.
+	// The data of the embedded server is not persisted, and the node fails to start if the endpoint is already in use.
+	Embedded bool     `mapstructure:"embedded" default:"false"`
 	Endpoint string   `mapstructure:"endpoint" default:"localhost:6379" validate:"required"`
 	Username string   `mapstructure:"username"`
 	Password string   `mapstructure:"password"`
@@ -258,6 +332,7 @@ func _Setup(configName, configType string, v *viper.Viper) (*File, error) {
 		network.HookFunc(),
 		worker.HookFunc(),
 		EvmAddressHookFunc(),
+		TagHookFunc(),
 	))); err != nil {
 		return nil, fmt.Errorf("unmarshal config file: %w", err)
 	}
@@ -313,6 +388,12 @@ func _Setup(configName, configType string, v *viper.Viper) (*File, error) {
 		return nil, fmt.Errorf("validate config file: %w", err)
 	}
 
+	if configFile.Stream != nil {
+		if err := configFile.Stream.Validate(); err != nil {
+			return nil, fmt.Errorf("validate stream config: %w", err)
+		}
+	}
+
 	zap.L().Info("configuration setup completed successfully")
 
 	return &configFile, nil
@@ -340,3 +421,20 @@ func EvmAddressHookFunc() mapstructure.DecodeHookFuncType {
 		return common.HexToAddress(data.(string)), nil
 	}
 }
+
+func TagHookFunc() mapstructure.DecodeHookFuncType {
+	return func(
+		// data type
+		f reflect.Type,
+		// target data type
+		t reflect.Type,
+		// raw data
+		data interface{},
+	) (interface{}, error) {
+		if f.Kind() != reflect.String || t != reflect.TypeOf(tag.Unknown) {
+			return data, nil
+		}
+
+		return tag.TagString(data.(string))
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/stream"
 	"github.com/rss3-network/node/v2/schema/worker/decentralized"
 	"github.com/rss3-network/node/v2/schema/worker/federated"
 	"github.com/rss3-network/node/v2/schema/worker/rss"
@@ -44,6 +46,7 @@ database:
   uri: postgres://postgres@localhost:5432/postgres
 stream:
   enable: false
+  driver: kafka
   uri: localhost:9092
 redis:
   endpoint: localhost:6379
@@ -129,6 +132,7 @@ component:
   },
   "stream": {
     "enable": false,
+    "driver": "kafka",
     "uri": "localhost:9092"
   },
   "redis": {
@@ -235,6 +239,7 @@ uri = "postgres://postgres@localhost:5432/postgres"
 
 [stream]
 enable = false
+driver = "kafka"
 uri = "localhost:9092"
 
 [redis]
@@ -404,12 +409,19 @@ var configFileExpected = &File{
 		},
 	},
 	Database: &Database{
+		Driver:         database.DriverPostgreSQL,
 		CoveragePeriod: 3,
 		URI:            "postgres://postgres@localhost:5432/postgres",
 	},
 	Stream: &Stream{
-		Enable: lo.ToPtr(false),
-		URI:    "localhost:9092",
+		Enable:            lo.ToPtr(false),
+		Driver:            stream.DriverKafka,
+		URI:               "localhost:9092",
+		Topic:             "rss3.node.activities",
+		Partitions:        1,
+		ReplicationFactor: 1,
+		Key:               stream.KeyID,
+		Encoding:          stream.EncodingJSON,
 	},
 	Redis: &Redis{
 		Endpoint: "localhost:6379",
@@ -708,3 +720,77 @@ func AssertParameters(t *testing.T, got, expect map[string]any) {
 		}
 	}
 }
+
+func TestStreamValidate(t *testing.T) {
+	t.Parallel()
+
+	base := Stream{
+		Driver:            stream.DriverKafka,
+		URI:               "localhost:9092",
+		Topic:             "rss3.node.activities",
+		Partitions:        1,
+		ReplicationFactor: 1,
+		Key:               stream.KeyID,
+		Encoding:          stream.EncodingJSON,
+	}
+
+	testcases := []struct {
+		name      string
+		stream    func(stream Stream) Stream
+		wantError string
+	}{
+		{
+			name: "Kafka with all options",
+			stream: func(value Stream) Stream {
+				value.Routes = []*StreamRoute{{Topic: "rss3.node.social"}}
+				value.Key, value.Encoding, value.SchemaRegistry, value.Partitions = stream.KeyOwner, stream.EncodingAvro, "http://localhost:8081", 3
+
+				return value
+			},
+		},
+		{
+			name: "Pulsar with defaults",
+			stream: func(value Stream) Stream {
+				value.Driver = stream.DriverPulsar
+
+				return value
+			},
+		},
+		{
+			name: "NATS with routes",
+			stream: func(value Stream) Stream {
+				value.Driver, value.Routes = stream.DriverNATS, []*StreamRoute{{Topic: "rss3.node.social"}}
+
+				return value
+			},
+			wantError: "stream options routes are not supported by the nats driver",
+		},
+		{
+			name: "Redis with key, avro and partitions",
+			stream: func(value Stream) Stream {
+				value.Driver = stream.DriverRedis
+				value.Key, value.Encoding, value.SchemaRegistry, value.Partitions = stream.KeyNetwork, stream.EncodingAvro, "http://localhost:8081", 3
+
+				return value
+			},
+			wantError: "stream options key, encoding, partitions are not supported by the redis driver",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			value := testcase.stream(base)
+
+			err := value.Validate()
+			if testcase.wantError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, testcase.wantError)
+			}
+		})
+	}
+}
@@ -4,4 +4,9 @@ var (
 	KeyConfig   = "config"
 	KeyModule   = "module"
 	KeyWorkerID = "worker.id"
+
+	KeyBackfillBlockStart  = "backfill.block_start"
+	KeyBackfillBlockTarget = "backfill.block_target"
+	KeyBackfillShards      = "backfill.shards"
+	KeyBackfillAccounts    = "backfill.accounts"
 )
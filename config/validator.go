@@ -15,10 +15,28 @@ func HasOneWorker(config *File) error {
 	return nil
 }
 
-// IsRSSOrAIComponentOnly Check if the configuration contains an RSS or AI component only
+// IsRSSOrAIComponentOnly Check if the configuration contains an RSS or AI component only,
+// an RSS component indexing feeds is excluded as it stores the activities like the other workers.
 func IsRSSOrAIComponentOnly(config *File) bool {
 	return len(config.Component.Decentralized) == 0 && len(config.Component.Federated) == 0 &&
-		(config.Component.RSS != nil || config.Component.AI != nil)
+		(config.Component.RSS != nil || config.Component.AI != nil) && !HasRSSFeeds(config)
+}
+
+// HasRSSFeeds Check if the RSS component is configured to index feeds
+func HasRSSFeeds(config *File) bool {
+	if config.Component.RSS == nil || config.Component.RSS.Parameters == nil {
+		return false
+	}
+
+	var option struct {
+		Feeds []string `json:"feeds"`
+	}
+
+	if err := config.Component.RSS.Parameters.Decode(&option); err != nil {
+		return false
+	}
+
+	return len(option.Feeds) > 0
 }
 
 // CalculateWorkerCount returns the number of workers deployed
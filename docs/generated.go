@@ -154,6 +154,42 @@ type GetDecentralizedPlatformParams struct {
 	Network []network.Network `form:"network,omitempty" json:"network,omitempty" query:"network"`
 }
 
+// GetDecentralizedSearchParams defines parameters for GetDecentralizedSearch.
+type GetDecentralizedSearchParams struct {
+	// Keyword Search activities containing all the words of the keyword.
+	Keyword string `form:"keyword" json:"keyword" query:"keyword" validate:"required,min=1,max=256"`
+
+	// Limit Specify the number of activities to retrieve.
+	Limit *int `default:"100" form:"limit,omitempty" json:"limit,omitempty" query:"limit" validate:"min=1,max=100"`
+
+	// ActionLimit Specify the number of actions within the activity to retrieve.
+	ActionLimit *int `default:"10" form:"action_limit,omitempty" json:"action_limit,omitempty" query:"action_limit" validate:"min=1,max=20"`
+
+	// Cursor Specify the cursor used for pagination.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty" query:"cursor"`
+
+	// SinceTimestamp Retrieve activities starting from this timestamp.
+	SinceTimestamp *uint64 `form:"since_timestamp,omitempty" json:"since_timestamp,omitempty" query:"since_timestamp"`
+
+	// UntilTimestamp Retrieve activities up to this timestamp.
+	UntilTimestamp *uint64 `form:"until_timestamp,omitempty" json:"until_timestamp,omitempty" query:"until_timestamp"`
+
+	// Status Retrieve activities based on success status.
+	Status *bool `form:"success,omitempty" json:"success,omitempty" query:"success"`
+
+	// Tag Retrieve activities for the specified tag(s).
+	Tag []tag.Tag `form:"tag,omitempty" json:"tag,omitempty" query:"tag"`
+
+	// Type Retrieve activities for the specified type(s).
+	Type []schema.Type `form:"type,omitempty" json:"type,omitempty" query:"-"`
+
+	// Network Retrieve activities from the specified network(s).
+	Network []network.Network `form:"network,omitempty" json:"network,omitempty" query:"network"`
+
+	// Platform Retrieve activities from the specified platform(s).
+	Platform []decentralized.Platform `form:"platform,omitempty" json:"platform,omitempty" query:"platform"`
+}
+
 // GetDecentralizedTxIDParams defines parameters for GetDecentralizedTxID.
 type GetDecentralizedTxIDParams struct {
 	// ActionLimit Specify the number of actions within the activity to retrieve.
@@ -297,6 +333,42 @@ type GetFederatedPlatformParams struct {
 	Network []network.Network `form:"network,omitempty" json:"network,omitempty" query:"network"`
 }
 
+// GetFederatedSearchParams defines parameters for GetFederatedSearch.
+type GetFederatedSearchParams struct {
+	// Keyword Search activities containing all the words of the keyword.
+	Keyword string `form:"keyword" json:"keyword" query:"keyword" validate:"required,min=1,max=256"`
+
+	// Limit Specify the number of activities to retrieve.
+	Limit *int `default:"100" form:"limit,omitempty" json:"limit,omitempty" query:"limit" validate:"min=1,max=100"`
+
+	// ActionLimit Specify the number of actions within the activity to retrieve.
+	ActionLimit *int `default:"10" form:"action_limit,omitempty" json:"action_limit,omitempty" query:"action_limit" validate:"min=1,max=20"`
+
+	// Cursor Specify the cursor used for pagination.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty" query:"cursor"`
+
+	// SinceTimestamp Retrieve activities starting from this timestamp.
+	SinceTimestamp *uint64 `form:"since_timestamp,omitempty" json:"since_timestamp,omitempty" query:"since_timestamp"`
+
+	// UntilTimestamp Retrieve activities up to this timestamp.
+	UntilTimestamp *uint64 `form:"until_timestamp,omitempty" json:"until_timestamp,omitempty" query:"until_timestamp"`
+
+	// Status Retrieve activities based on success status.
+	Status *bool `form:"success,omitempty" json:"success,omitempty" query:"success"`
+
+	// Tag Retrieve activities for the specified tag(s).
+	Tag []tag.Tag `form:"tag,omitempty" json:"tag,omitempty" query:"tag"`
+
+	// Type Retrieve activities for the specified type(s).
+	Type []schema.Type `form:"type,omitempty" json:"type,omitempty" query:"-"`
+
+	// Network Retrieve activities from the specified network(s).
+	Network []network.Network `form:"network,omitempty" json:"network,omitempty" query:"network"`
+
+	// Platform Retrieve activities from the specified platform(s).
+	Platform []federated.Platform `form:"platform,omitempty" json:"platform,omitempty" query:"platform"`
+}
+
 // GetFederatedTxIDParams defines parameters for GetFederatedTxID.
 type GetFederatedTxIDParams struct {
 	// ActionLimit Specify the number of actions within the activity to retrieve.
@@ -342,6 +414,24 @@ type GetFederatedAccountParams struct {
 	Platform []federated.Platform `form:"platform,omitempty" json:"platform,omitempty" query:"platform"`
 }
 
+// GetRSSFeedsParams defines parameters for GetRSSFeeds.
+type GetRSSFeedsParams struct {
+	// Feed Retrieve activities of the specified feed URL.
+	Feed *string `form:"feed,omitempty" json:"feed,omitempty" query:"feed"`
+
+	// Limit Specify the number of activities to retrieve.
+	Limit *int `default:"100" form:"limit,omitempty" json:"limit,omitempty" query:"limit" validate:"min=1,max=100"`
+
+	// Cursor Specify the cursor used for pagination.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty" query:"cursor"`
+
+	// SinceTimestamp Retrieve activities starting from this timestamp.
+	SinceTimestamp *uint64 `form:"since_timestamp,omitempty" json:"since_timestamp,omitempty" query:"since_timestamp"`
+
+	// UntilTimestamp Retrieve activities up to this timestamp.
+	UntilTimestamp *uint64 `form:"until_timestamp,omitempty" json:"until_timestamp,omitempty" query:"until_timestamp"`
+}
+
 // PostDecentralizedAccountsJSONRequestBody defines body for PostDecentralizedAccounts for application/json ContentType.
 type PostDecentralizedAccountsJSONRequestBody PostDecentralizedAccountsJSONBody
 
@@ -368,6 +458,9 @@ type ServerInterface interface {
 	// Get Platform Activities
 	// (GET /decentralized/platform/{platform})
 	GetDecentralizedPlatform(ctx echo.Context, platform decentralized.Platform, params GetDecentralizedPlatformParams) error
+	// Search Activities
+	// (GET /decentralized/search)
+	GetDecentralizedSearch(ctx echo.Context, params GetDecentralizedSearchParams) error
 	// Get Activity by ID
 	// (GET /decentralized/tx/{id})
 	GetDecentralizedTxID(ctx echo.Context, id string, params GetDecentralizedTxIDParams) error
@@ -383,6 +476,9 @@ type ServerInterface interface {
 	// Get Platform Activities
 	// (GET /federated/platform/{platform})
 	GetFederatedPlatform(ctx echo.Context, platform federated.Platform, params GetFederatedPlatformParams) error
+	// Search Activities
+	// (GET /federated/search)
+	GetFederatedSearch(ctx echo.Context, params GetFederatedSearchParams) error
 	// Get Activity by ID
 	// (GET /federated/tx/{id})
 	GetFederatedTxID(ctx echo.Context, id string, params GetFederatedTxIDParams) error
@@ -404,6 +500,9 @@ type ServerInterface interface {
 	// Get Node Worker Status
 	// (GET /operators/workers_status)
 	GetWorkersStatus(ctx echo.Context) error
+	// Get Feed Activities
+	// (GET /rss/feeds)
+	GetRSSFeeds(ctx echo.Context, params GetRSSFeedsParams) error
 	// Get RSS Activity by Path
 	// (GET /rss/{path})
 	GetRSS(ctx echo.Context, path string) error
@@ -511,6 +610,26 @@ func (w *ServerInterfaceWrapper) GetDecentralizedPlatform(ctx echo.Context) erro
 	return err
 }
 
+// GetDecentralizedSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDecentralizedSearch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDecentralizedSearchParams
+
+	binder := new(echo.DefaultBinder)
+	err = binder.BindQueryParams(ctx, &params)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Error unmarshaling query parameters: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDecentralizedSearch(ctx, params)
+	return err
+}
+
 // GetDecentralizedTxID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDecentralizedTxID(ctx echo.Context) error {
 	var err error
@@ -634,6 +753,26 @@ func (w *ServerInterfaceWrapper) GetFederatedPlatform(ctx echo.Context) error {
 	return err
 }
 
+// GetFederatedSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFederatedSearch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederatedSearchParams
+
+	binder := new(echo.DefaultBinder)
+	err = binder.BindQueryParams(ctx, &params)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Error unmarshaling query parameters: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFederatedSearch(ctx, params)
+	return err
+}
+
 // GetFederatedTxID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetFederatedTxID(ctx echo.Context) error {
 	var err error
@@ -735,6 +874,26 @@ func (w *ServerInterfaceWrapper) GetWorkersStatus(ctx echo.Context) error {
 	return err
 }
 
+// GetRSSFeeds converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRSSFeeds(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRSSFeedsParams
+
+	binder := new(echo.DefaultBinder)
+	err = binder.BindQueryParams(ctx, &params)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Error unmarshaling query parameters: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRSSFeeds(ctx, params)
+	return err
+}
+
 // GetRSS converts echo context to params.
 func (w *ServerInterfaceWrapper) GetRSS(ctx echo.Context) error {
 	var err error
@@ -787,11 +946,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/decentralized/metadata", wrapper.PostDecentralizedMetadata)
 	router.GET(baseURL+"/decentralized/network/:network", wrapper.GetDecentralizedNetwork)
 	router.GET(baseURL+"/decentralized/platform/:platform", wrapper.GetDecentralizedPlatform)
+	router.GET(baseURL+"/decentralized/search", wrapper.GetDecentralizedSearch)
 	router.GET(baseURL+"/decentralized/tx/:id", wrapper.GetDecentralizedTxID)
 	router.GET(baseURL+"/decentralized/:account", wrapper.GetDecentralizedAccount)
 	router.POST(baseURL+"/federated/accounts", wrapper.PostFederatedAccounts)
 	router.GET(baseURL+"/federated/network/:network", wrapper.GetFederatedNetwork)
 	router.GET(baseURL+"/federated/platform/:platform", wrapper.GetFederatedPlatform)
+	router.GET(baseURL+"/federated/search", wrapper.GetFederatedSearch)
 	router.GET(baseURL+"/federated/tx/:id", wrapper.GetFederatedTxID)
 	router.GET(baseURL+"/federated/:account", wrapper.GetFederatedAccount)
 	router.GET(baseURL+"/networks/config", wrapper.GetNetworksConfig)
@@ -799,6 +960,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/operators/activity_count", wrapper.GetActivityCount)
 	router.GET(baseURL+"/operators/info", wrapper.GetNodeInfo)
 	router.GET(baseURL+"/operators/workers_status", wrapper.GetWorkersStatus)
+	router.GET(baseURL+"/rss/feeds", wrapper.GetRSSFeeds)
 	router.GET(baseURL+"/rss/:path", wrapper.GetRSS)
 
 }
@@ -806,180 +968,187 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9627jOBIo/CqC9gNmF3Ac+W4HGOw4ne6dfKe7JyfOzGC3NzBoiba5kUiNSMXxNPLu",
-	"B7xJlEQ7lmOnL5PuH7EtinVhsVgsVhU/uz6JYoIhZtQ9++zGIAERZDApfZvGgC2nwPdJitk0gD7ELAEh",
-	"+hMGvGEAqZ+gmCGC3TP3GrIEwXvoAJ+he8QQpM48IZHDltChMfTRHMHAUb013YYLH0AUh9A9c72HYBiA",
-	"/mze7o/6XTAfBQMIgxH0OrDX6bZ6QWcAwKjvdXtuw0UcGsfMbbgYRPx91anbcBP4R4oSjh9LUthwqb+E",
-	"EeDY/n8JnLtn7t9Oc9pP5dPs7/TCJHGsOn18bGzkyRwGMAHsoPwQg/A+vQP0z59JQgLo3yH+0z0IU07s",
-	"T6VnP8UkbFLiIxC6jw2XQuAvAxAtC+9kv/7U8vj/ZhwKwo7IzHeaN9sZKZiznqJtLAwgAyikzpwkFfbJ",
-	"953Li5JEDbse7LaD3qADZ7A1n7cHYAS7o1a3N+jMutDrtYZzP+gPe/5gPup0WkGnNQIzOOx154OhBzt2",
-	"SUPBVr6wdcxbUZYgvLATjPaic7yAmF0ABhyFT04qiNHpfesUoCnCDIZ2vNW352COIVuR5G5vSVfvF2c+",
-	"ZEuYwDSyY61BPlcUrxLCiE/Cj6o/G3lxCNicJNGB9JzurrlhPNTjw6qsK93rVgKfr7ReirhMhWwlLKF0",
-	"rzl1PZlYZtPMP8YM+iOFyVpoO4KnIYoQq6I8EZitBZY4jWYwccjcke9QZ4XYEmHxMFN6jDiJorM4rVqe",
-	"JkIANpW7gUHd4RiLl9+Ldx8b7sMJATE68UkAFxCfwAeWgBMGFmo05iANmcZFolGBfw9CFADGMYsQ/rHV",
-	"iMDDj21vK/disIDbmReDBcKAPxCjrjhoMqi1lTsCwn7MueKv1uBNlTUKeJkzdpb4aUJJsp0bso2TUhgI",
-	"duTcaW4QEtVrXQ68ka89Rb2mV0Gx0hWgBPqSll1U1Axw4gh2svc2kZZ3vO8acpH1sCuhOUwrrbW1gSJ6",
-	"4+Rvb5r8+836+vPdnPBbZzpvauXJgWyNv9N/bBKE3LSoxw1lQtCdRx/bbQ5J55GMji10G2tzTjhiMKLP",
-	"tDgaegEESQI4wIeTBTnhv53QOxSfEEELCE9iwu3URC6gu3Ix3mAAlNh4ONPmqCy02DVfkn0UYR9OGYog",
-	"ZSCKd+MdZSBhCC80ExF1sh42ca4MqO7ku8ne3JXwMkQ7/anvw61GpG2tUW9xRrCUbiRZ9V2X1Il671FJ",
-	"gupuIoC5u1KvO7FRzcBix1lSsZwZWGyZH7zj2iPLMd91UDkAO0liCu1J0zqG24jiXdemah3D3ck6sROV",
-	"YobCurMzjbl9sNusLAM4/qwsQ3zkhPOdFaTsnARI+sDyH9bTc8D85b8gs3no6Dijm7/mE8wgFhYViOMQ",
-	"+cLWPf0fldZkTluckBgm+jXl9qLPWw8zX9fe+ry8R9xjY5bvCuqZ7QWre2+buBbmGc6GxVfXDMsWtwOs",
-	"+XyKfEljyLIU156CxnJWc6VRS0Idha1VbsY1EIa/zN2zT/UkiGtK97HxOWeU4Uq53Z+fFuVZk585bDL7",
-	"H/SZVFZlOROaypmRYC2WlhlXV3p7xo2k0tITpSFDcQidTOuUPUsFhbhB/32ADASAgVf9dxj9t5/mitQo",
-	"7C/8ehzdx9uysD2xoBq76kx8HovadD+UMk/5oTAylfSzFOtXoSH3VHLcbj0UQ7XifY6+zXFRqnZPXL5S",
-	"LZuv8HqONrRnyAFYbGPk33UMHUSdjKYdlXH5hPMLG6LVA9dXI/QvYYQe0p30aoC+GqASd6H0aEww1dtx",
-	"9WU6vrxWn2upuTLKsgtxsOmML08WEEspdlSP4rwDrx3KktRnaQLdHTjx/09++ehoVEVPAGHOABsE16Rx",
-	"eg4CxcdaZO0ynG+TRBx1VdC9WUJHLS18BYpAyOcvDBySaOSpg7BYeJ3cNdQsYl4ywfVIH2CUJH6Km9lA",
-	"yGNVJ0SUFQ+lmm6jtJppy7jarf3t52w95Kl4RfkpG33XTrk1/iY/m8xnxSdJzO0OUmgO6wpQ7Suep+EO",
-	"I7fea9zsbH8WF+ty7YYwEF6BBaS7aa2duWRYNn8Z2S7T/G3JdQX7F5ZpK/e+Gnm+5CYCBqFcFl5ssRlj",
-	"BynIDoXJPUwcyNs6xPfTJIGBs1qiEDpxQjjefNVkOUklGpTx+4bgOVrsNbo1TGwJ5UL4SJ7B948kgJd4",
-	"To6HrgJwAEzZO5Li4GUtkXw3kkBK0sSHAktMmDPn2JSQvJ5M9lLIWaiKntOfPrs6SIt/5rsj98xdrVZN",
-	"MPObGLImSN2Spy1lS5LI9uoc620UAef3NcbSs1Ek77+p53X8AN2LD1B+Xbblt0vs0CVJ2Jn8+VT/Lr/G",
-	"8stH5C9hiIQ17aeUkQgmzhuEg7VzjfwlSAJKsEPBmjp0KfwJ5B4mqyUMuT0nrFyfUEYbDsJ+mAa8nwRi",
-	"1nAikrAFWEDhjOBaizpBCuUBHnRCghdOAEPeMR/YEIpj9yVMnCVJKWwqpGMLzpMldJaAOmIUYMC7BPLU",
-	"mr9O0xgmAONUxsoR7Agqlih2Fgkfb+rMUimoCeRKDwZWYJpdvy8B+4E6GD6wf27j5O9j5w2JIsjl6wPC",
-	"iDKYOJMUOm/DECZrzjqEHQbCOxn2KMPYSBTz3QCYkZQJ+QzvtYYSLNK8IVhzK+Lt9VBRB/DFHfgIL6xU",
-	"nObCgflSn86mytfV9trdE29w4rVvvO5Zq3PWGTY9z/sP348gJmKupBzIKR3HhEpez7glILBE2AEOhitn",
-	"SSLozNYOJqumc4kpgyDg8vIDVbg5M4DvkjRm/rroV3CvJ5Of05nYqIV8aZumScgngLtkLKZnp6fFCXOK",
-	"4Yqe5rif4lyATzgaJ7MUhVwMTyS/TnyQUvFQScFpy+t63cGw5QnjgO/N3UTEMjBim59qgZxDGMgZmLty",
-	"XJIyt7FxZqPAPXsJMhouwgF8cM88w9nDaVoKxpIV5lv3Kn6ZR2MOQgpLvMj39q1BazQc9drD0UYWcZNi",
-	"mim7Vplpj40nzZ+jWJu5Kv+27MzfSXIHk73X9P0NzDf6EYd9APMwG3iByfVk0jlRtk/mojr5F5kmcE6n",
-	"SwgCOo0AwlMSQwxiNP0AA2TzJQdBojxxJZdWw41QBLOImWrweomcxj445cs1wXAHp1xtENoimL4hYch1",
-	"zSyE4zhOyL1IhnoJaKn2Ob8AsPM0eSFIH5Dwjr0ApJsEBPDFQGE6h8kRob198JcAL+B79EeKAqlNjw6L",
-	"APwCYCYM3EnVcXRIKxAfEcy/uF2OAfbhVUJiQo+qKnJgvxF2TDnn2vYeJvTYSiKDc2QVkcE5toIwAR1b",
-	"PVxPJkfsfSKSgMXW6qhDI+FcwBAeVaIlmPfo7vhAjizLEsgVoS8AJCFzFMKXgPOwPjqUa3iPKHwBMCuQ",
-	"BEcHM1mC5JjECAUm95YvYAQb0M4TFCxeiLIjL28GpCMrBQNSvvLcHmLjdUPuIK63GQygjyIQmg8RZnAh",
-	"VkNXFmeYkyQCTD7pd3N/j9FQemIt/ccgoTCYoggs4DRNQmsjygAO+EQ8OzDPIU6j6UT3ziGtoxmxI5Em",
-	"qEAs/96oNlOVNXbgykH20je1ov1qd88RzMzvw8u86N6Q9yNBmOgiKMfo3NhHHgnCr/gOk9WxmJOZuUfq",
-	"/3oyyWKoDt97vo2yRK3uM6GESrB4jcZZ7CFv4Z59coF4AoXf/Z7cQfc48KUfqQreTyBgHPpM1J6ZIywC",
-	"NdyG63N2hG7DTeNANlGBQvzLUZAUW7EqirN07TZcCkOODJnzhYx/ZRxHjX2G9+EQuzAjRTUuCHMcxGED",
-	"heHctYY45xF+upTGQ/PCqAyQB/ehKCaJcCQbJSLEC25DlgQ5cxeILdNZ0yfRaUJp50QdLJzGmpAFUdJ/",
-	"CjIf+95UVzxMFokNuKTMSJKQFR8DOYBCgCMtybGIYqRpHIf8wwqxZZCA1QGHx3RPbRFrjUoCuYjwCd5w",
-	"Q0GbfE7hYaWm5M6qYkYZuOOAU6w/+SFA0QFRKPqEqhjMRfENsAAIUz5sYEYZQPiAGBSdK5tmdIgo0xP7",
-	"cLA/5kHWGlyqVr6GC5IZYomoBwWSFQRCWsE94NNtBqjUg4J1JzQCCTvxl0BM+RliPhGf/IRQOpPKyCgv",
-	"NQeJDygTummBCUVUUIghcBtuBCgjgZj7GALehMQMRYjyN2MSrhfiWXZQSME9f3JPQ6EtQrAW/c7CFNK7",
-	"9VNKR1fC+pjVoLApnPraxSw1saeNbPoQtk5aDFdijqwSEJtLkPh+OGExnA3WlZkgbCi2A8I1tiIa3K+Z",
-	"lL69fnMiqqzwD61+T30atFv6t1ZP/9ga9Qduw/349uqk1W0dEMUbGStfnUO+Yak2XKit+oa7yI0oeaQr",
-	"7cGGOshWNQQbLjMs9dtCtba8r23yzcCieQMWB5Nrke+/e8j9nuysOk9e1BasuFOq0AMYEyqq6Rx2ud56",
-	"inq8Tad0U/D9QjkBapf0n0Nb/OVYhVz+S7v324OxOM0IPTqHj8vJwt6lOqA+oezgw6loezze+AhP40sM",
-	"zrEIEA7Mb5kAefT3zc8P08j+bmZH5rP+5gSsGqNx9pJL0KYNPB8rTvDuib97M7QU32df+TJ0DnImYY9a",
-	"+TKMzx0Sjw0XRCJX+mis5tY4YDCRptSRJrl1+AzIxxlCHRH0RUax6L95bLgxTBAJqshAXDy94hvVE4Yi",
-	"aDvWEVXtdm1eYrx8tyEA2hjO9LHcSwqBBHqk8V+BuMpvGeN9NM1FXm6tfA6PLEFmFU7NSLC2nkFCHExn",
-	"IfHvrE/laWzl5xBhe3u5US4uK5VG5YhvIcwbkShJGwoOLGGlqLkXVTBW76xQ5GqdqB57JxCo0PIqX48y",
-	"6bfGMr6kKihGHX5zpmAxmPHbRf/lNkovNw+tZxRiHn47+6VqbOk3J2PXk4lF/+rsS2NJKbbYEAlkW2TL",
-	"S08ha8YWS5Rl5u1q1aksveOkuWwIvz3eQG8KKz3osBdifL8LWkQg8XdByZEXqxek5Erpcpt22RgmuNFs",
-	"Vml/UxXCV3m+BDgI7fGJkc6fO47jRabnWXRdLI9apxts+jid6ezFTU0SGbt8tA0XTaMIJHaG67qAu28r",
-	"GEgWkB3PHi6IrIK2UZAKVbl2iG59yWVEx/G/6NbHdvz/2NgaODxDxL6FfYiRlJrdlugtc1MHDdsn9R20",
-	"y+bmUOTtUy4L7H2ZQX5Yf6EhfjD8znEubC+Tm8IhPqynmwXrGNxWySTfxcJ5nWn9b58WmRbzjZNiy715",
-	"0Ym9OX6FL+KimM90x/qmz4vry9fdFwN3ZMf6ATVRNWnqSwlJIcjoVUS+ThH5Jp2q5RS6b5mAb85vV8lV",
-	"qkQvihDPGZeshhvJ8Fkm3Mcq8FOmb4C0HP/2jADDYnqZgVGYhWCIE6c7GUtKVyKmOCTgkPCNFCIDg1gf",
-	"mzTce3KovJlSwlUhsH4r3w8HXadjmWkFEAYHhKCy7czwcOX6bLiB9BtmhMZEZBLofYay/2UYLZIhyNKg",
-	"bbhUWIMNN0R3hxwNM/tww5SQ67Flbhx6bHQguSV62wbEVmDfvHGz5TUOeZNw4Z5cr+FG4AFFHMu2J5gi",
-	"v7QqDpJ6FwJvu/nXcqVugd4DobHplt0MfMqWEDPlf7Ne1AApnSoHxG5Fw0QZU3Ejtd1syFqCMLQXfLuG",
-	"cQIp716WRQRh6PCWDqAigJ/pYpMAZ8NbLV08T7G84mEJ6NJeVY4/4WLDoejmAhwMmjYPjkrx1k3tncpG",
-	"eX8YRNDaWwJWGyo2g5XAgtPctMbkbGZqoWZbqRBp8QLWT59lpcCpOvVvtQZdVTwQBlPKhMy0vW6rP/SG",
-	"Zi1BI+PIKN342+S9ylJhsPT2SKx8LKVibEHAtaIU1k/lRIyVKHbnnqm8I/lV+LEysCc+USUmCui3W51h",
-	"tzeoUNAbjQbdzrBjUpCnOxkEvEkTlfZgkiBfH7W3kGCmjGygxld9m/QoJE7kswo9/dFgOOxXyGl1B73O",
-	"sGdSk2eRGdR8QKIKbpkc+Xp/CzUqRcbEPtJ9megrqCfq4eNtwzWuptXClSNeKEdpJKMZOBu/FrH2auKb",
-	"92NirH9WAnQrk4LOtmMq84YMJPMUqd1x5J2YCKoku8fHSrn17Xckc+VQaOLILs0LWuuUxMxLS9oOMrbc",
-	"NMwRyR4fFYmE7tXRdh1pLFHVCplPHM0jOs1Dj7LnM0JCKGrUbfTWbzpaye93qeOoL8SmGSg19DWu8t2i",
-	"2XS7jSvPumff3WLUWW/Ps4qUKE6rF+TCyg4Ygwlv5j188k5G4GQ+Pnl3+7nrPdaAvOm2/czaANhBOED3",
-	"KEhBqIxJbUtutzV2CAh96jJBs/T3flfrHfAKumL95TLLxlk93l+v31NHNdbVvCXfCnrgqVIz1TPURV02",
-	"3IDFbsGzu13quM/VStuUjv0GkqKBZikQ7z0Ew2DcP3/X7o/6XfBudDGAb4MR9Dpve51uq3fRGYDxqO91",
-	"e+Xi8frcyfUeBqN+97w/HoK3PTBodb0RHLxtDUBv1u73RyOvM24PRqPB2HfNIkmtYXZ78lUI1hdEGDh5",
-	"/aI8Q1hXGnKv3o//nWkevgmy/quKV17eW9h41Ae4icgpezj1HoZdD3bbQW/QgTPYms/bAzCC3VGr2xt0",
-	"Zl3o9VrDuR/0hz1/MB91Oq2g0xqBGRz2uvPB0IOdvKy3aZup2tXeA5y/6c0HA783vuhdnA97M789HLwd",
-	"esHQ75wPh35n3h70++03udAys5CVb+xhSvsN13voeu32bNCDpTvfdKFwKAddJWe4nVavPxqNer1O2xv1",
-	"vXww+Fg8NnKJOB+fd4PWvD2YjceDQb8NusGw3T4fem/63cG7Qas3are7o/ZcFx0/ABPtRcWNjYAuK15L",
-	"XrOS4yxJ4YZhKlQeH/aHo0F7kI1eDcEuVyX3LAPasB5T0Kcrkm9wOTz/sieCbdrRlLqd9uS6/cHuHlSy",
-	"u9s1NUL+D7FCog2WaIrRHyl0UAAxQ3MEk+zue3MkqpacFGlbj+JR2RBx1PDykW/aa8PVu1yxchNtNo9q",
-	"GUdVN8XBLqLNbzks4nOJA+SLWzxWS6EFiowql35vWMzkZyzxz7q58QAGQlGV2MZKNNnNL2mXpBcwQq4M",
-	"KanW/2gh7C/dhjse//aW/wH3cEGYv+Tm2zivYHMuC9acQxxcjH9xG+4bUY/pjVGgRvtV3n6cuA33nVGj",
-	"5me0WIZoseSr4eX//R3d8c7/D1ohUZrEbbjvIea77/coIOKPrGTzHn18O77mHwi5o9fSl/5B7BB468zz",
-	"8RGCZKILfnwkqejrlxjiiejml7z8zRVIwCIB8VJ9VqczVyRcRyC5E2W+ricTvg5eA4RngsbJ+LcP/A8D",
-	"yUIWhvkVI/WmdIb9BybW86ViKZHChr6ZjcpelUUwCeDpfVtXFZEb89OiV6FGmRGr3PyuvBjGCYOqYWTK",
-	"iFHlSBc1gjgIABFZm2LIfFm6yxAV7SaTw740xAP9sZLicYdWiCrxCGW7UIqHLnQk/ibicI3c0USKR5SJ",
-	"R+bMikhE7oAqh5QVhsFKTrT8kxhiKvo1yyUZ8hLn8hKb8pJkgsJHKC+oRHN5STN5kd7OP/eQFzUaX5O0",
-	"vMWBeCT9LHYFCVUbR2XuiXuhfPGCEwjPDNVLnUSruufme4b2NEAUzEIY7Hc+0RC9iFMsmNB9+1DBt4c9",
-	"HskuzqtccCcvtNMXxFQZ45MAbuC6eJM/t686EaQ0O4qyv6ya1DyWqNzbXc/Cycsz/UQh8JcBiJY/tTz+",
-	"vxmH2zxAhUsSvwbvj+0G87qen6yP57h+rDdqfx1unz2vtwby4o5cWJRSl3dbk+ffMX/Mgr47zp7cb/SF",
-	"t6rlifUX3KbaBOV5W9Sn5stRd6z7zbp872rOvOwETsy9bFO7L1cPotuefWX/UdTSC+1lrUrtK9nHPltx",
-	"luo05FKtZ4ya14JhcjizY7IiExpu/knLizlIt7toafNSuP1kJl/XK5e8joMASfvb0WbD5oXYPFswEg4z",
-	"d3tmQp2mFCb0NDOuXJ2C6E5IBB0GH/iWRicwbbLCyql8rVan1/NavW673/J6rVbXy4/Ef3qf3gH6588k",
-	"IQH079BPMQmb+Vl+nkRnRTxvrDAv9fYU/luA28nojvodrzPoDb3RaGCQ8bfYdxvu38gsDchKXvxoeqw7",
-	"7X57NOwNS4l6O9NxKs/UIT2tINF1q7A6g2GvtZMlsd358yEPoDjfrd5vFgxwFAdGHomwx3Y0o7nquDAC",
-	"RWZ16Tz8xvuZVMKtm5s55DaCNDD4voabH1s3NUbiWnVdUA+5xlktkS+vLxYgqBMDZI+ey8uQWToUz7JY",
-	"PGiPmMsOxDYExvCHTpxAH1Fx23PWm+q/uePdJZqptkDUGpGo8qLaTYGnbTPwVPR7mMjTjaGn/JEZ/Gnc",
-	"YlthqF6BzDAPeWe6vJXW4nXY0NNNHjeiTV4MH5hDoRjuBNI0ZLSmP4Gjd8NX7iuwsITt5OgjeVChb9Eu",
-	"2zMxf71KC9vS9c2mbhxwD1AIZiGsK2Yf5Lhfqwvpq7s7P06nwmlq7qnnIQEshySxkXsnejelMfDhFOHp",
-	"YmYv6LQpTCmCEUnW+ZtPw6uTM65JVubeNhehiiorOQVna0dyqTpqleC5WhvaAka5b29rUNwxAKiAt0qB",
-	"P6H4p5IbO8MsuWI3V1ZTcZI1u5droO7cckH0rhJwocxlM1o5Cw0vRy3bmFF1BpeUNDcD9YvUicDawYTJ",
-	"m+wT5vx8c3PVbgidxJZIqGy+vvK/qk/ZRLhJzABAfVl6fryZB8DgNAwtHuYiYrxbRz1V9+nzBVvGt6ur",
-	"tCU+HC9NwRN4RCD+JMf2NrNiikgpl3V11v16/V6vmxuBqXCNkpmkw360basChJsYSkkwo4bdqsR9+mx1",
-	"nkgR+4E6KGg4UUoZ55D0pjTEgqJExvnhk7Kubk8+yb5vf6iHeDmiueGieE6nC8DgCqy3+kMvr95NHN2Q",
-	"j9YcMn8pkxb41pOjHjLE8cxacRlEkSi2L7dtcvlAIWLrrE8xC7ZRIfVGeXgNt051iNVDZ7WECTSOWByu",
-	"ccShvrCI63NOpUiACDIl6z7BfpokEKs6i1Mt0Rswy1bU/EXn+upNaSJAZywBamY2nQtpAJ05re0zI5UZ",
-	"Twrx1mNmdE0TY/XN11uv2bMtqN3yOuk1e4+PeYR3mbiPIILF86x6DNYi+XhbjbN/VYdHUof/Jmni6K2w",
-	"gzBlAPswU0IyNwpxZtwj4ABH+g24Bm2oXRF8iAmF1FnznkLigzDvhkj3meC3kvs1SR0KQ+izpvNvkjo+",
-	"wE5KOQeSe+RD6oToDjp4kZA75+9azYqvfIv5jz31dEAigHBT9gPiOFfW1TwKU1tr5v/lGJc7txtfeskq",
-	"pbS8uOI3WVHU/Hup1VbbplcbrnarWJLtDClgS8AEVRqrPMpAyrizQmEo1myIHYKbzg1XInRJ0jAQgonz",
-	"PVzGLAG7xpqiNEoCQ7Cepkk4FVeMVZB/rywH0U6ep3KzgYQhWYlgiAT6EN1DfeBOHeXC3oKIzRY44qIk",
-	"ZU4uSWrX8roWHXMtup5Mfk5nuSb89fp9rRHTAiGUe3bRW33VfhA8vrjulAzINOdTCqtt1VheVWG1ey+v",
-	"h3VCYVkLg0p+dzGju7SfEc+cOyiS5WVLuQTLEd8u2XZpewn9Y8mjrBF2UHRF1fBZbEgg/AK+mj3yLXMo",
-	"lYtSniK/PkhLoHtpyap73PGRBNCS4u6Te5hkEWwld6BaUVTJeBWfOtXBq9l383pO9ZuQtFtxX6rZh4g0",
-	"neaBnuqWzKmO4yy7CwsvayNFdZ57/gqt5MTWbcRNB7w/InNtevNgHgxbHRC0PTDyZsAbDdpB0PE84I+8",
-	"Qctvj/qzYa8lkpV9kgQCQAgo46tewmYQMHl0OBj2+v2BaMY36/k2/ZNbDAg93T3D55/yIHoaogixH3v/",
-	"TT2v3c8Ce34kKZM/yeedntsowwIyWIFWn3gPb/vDGQgu3rZ7F8POcARgdzbqj/rt8Zteu3/RanXmnXPw",
-	"ZnTxT9l925PAlO79UecuyV8ZWPxYTDyqwOuOWl5nNvL6F/N+e3w+GHid7vDiTaszG7YG/f5o0Dnv9/1W",
-	"K4PHBSZj5woI5vO1NSb+0j3rdAd6MDmPshZu2xtacuZkkAOkbKo4cuaKOzlVRSHjfU9Gm2gofTuUTre3",
-	"G5SW190E5rbh0hDQJQym+lYnAd1NY1EC9azVaw/63YZ7DxOqlh+fROJIzR20ugMf+q5O+7pvNdtNz5IS",
-	"b07pJ/z9xcfGPKpTvrcwx3d/0aY3C5P/AMjZYFiPCw7Uuw1ern+sEbzqqQPvo+yQWC3xuBh2bJZ3zlTT",
-	"LovJtWpuCNrmMzrZwkTBQdih0Cc4oPaQJUNad0HnN9V8+8qplit9zvEMa0UvfFvhXRWswNLBfoz2L+jT",
-	"sJiUu/RSKjT02HClP3gGmL+cUvQn3Bcf7Vf2IYoZPViH2S1Z+3exWyHwTX1sdZ3v0+EBWVR1bhw298GM",
-	"TqoXQbcplXHvuNFbE61KjPHxSiaK6p5GMLMNj/qBhntU9c8yDD4XAqF08GEza3Goq7CzbIjHZ0Y/bhav",
-	"0nbkyKOY7Xpu6+9zzCjiI6MpApWfg6IK9T1WDVGR3VqSQtlb80bG8h5E/qTY1WXC9WTyzNSmT+5qtWqC",
-	"md/EkDVBurVOogD3dSQ0GZTvkcp0PZk8J4npejL5ftOXuGlfM3epOBjHC77XpVe3ruJKSL+ShKV8yvwF",
-	"U5WKcvGapBSaTvPaKUpFbj5TZ301aUm5unmhnKSSsvqms5FMhfh1pCOZK+sLXDxyPZkcPFtJOkxVEbK3",
-	"UQSc39cYQwuc/6ae1/EDdC8+QPl12ZbfLrFDlyRhZ/LnU/27/BrLLx+Rv4Qhukd44fgpZSSCifMG4WDt",
-	"XCN/CZKAEuxQsKYOXYo8B3IPk9UShpGuRuwTymjDQdgP04D3wzfvDSciCVuABZSn+uJ4L5Dn10LrEbxw",
-	"Ar6fpg4fkBCKYPUlTJwlSSlsKqRjC84TWcLYAXEcIslRdWjHX6dpDBOAcSoTPgh2BBVLFDuLhKQ4oM4s",
-	"ZaJWTwK59MDACkyz6/clYD9QEbz/z22c/H3siAsiEx86HxBGlMHEmaTQeRuGMFlz1vHJCsI7OXNlZUkS",
-	"xQCvHTAjKXMSSEl4r4P2BYs0bwjW3Ip4ez1U1AEJdObAR3hhpeI0Fw7smhdsum2v3T3xBide+8brnrU6",
-	"Z51h0/O8/7hZZLsr5UBWNYpjQiWvZ3zNElhye9rBcOUsSQSd2drBZNV0LjFlEARcXn6gCjdnBvBdksbM",
-	"X7tP2E7bU7TUiewT6UoJpUdJyErkClR7l5R7ei1Vr+VDrtiFnzbPoKluUMpHWFYjElDmZG10t/aFoXLs",
-	"tQFBiFke+WH0mFmitrdEcz3fJWkxau6yoSgfH23FSrSxIrWbV11cTmC7Jr10xmNDQrVxZBsLr3fJCckv",
-	"/LJRyZ+ZHZcO3eV5l7VuCX8kQ614H3YB0AcX23mdHW8Y3K70ah5sFA/TtomHbPNEh5VjOOtYyEY74bht",
-	"OCabir9dq2Q2M8dtBrhS5CukfEsVg65WflNKQy3qE9nKgHqTZbQ9BVLvTajIvxPrH1j8nf6jruSXq8s9",
-	"LzDhxjSeLSasfsyHZbWEpTsqiC98/jYhLSr2VF7haQJex3D/yAzlXXsu9b/lZ2dV2heIOepwTaSibp7N",
-	"+pR4Uy/y+RY1k22O7O8zsNhTR+U5xACv9z2XUJ3UPpXQ791WEMrz6OyxSPse9KC962pVUmf26cQWk7dL",
-	"P+WEyvqlOcuYFMPqdppZ+RuFGLjDnpQZNe8Lm7zSTfXPrkta18lR8HCoaxHqhKflC4NOcd7JEcHb1uZ2",
-	"Nh3N2yK2ZKReXtiUxq6zWUK7SshCpNdX97JqDWeppQLeE3KQ9VpRBIWrRTa75nydhV8BadhKpesyNvYG",
-	"A0EHfKq/4j0adiOJt9ilt6fnyiSTxo0DnF9kUd13XXLK5EZL3/LxK15CELLlk8UjFNoTbRodaju2+1aM",
-	"cwP6aYLYesJ7kMIxgyCByThlwoIWXQuTTfycM3nJWCwE3UVK35SMtMmk43zk+5vx1WVDJj/+kfItf5aH",
-	"EyfkHgXSvgmRD7G8Ok5Zgx8ub1wV/55lJ4n6mzLhmySLU/USPeVt89xzV4N1jfgdt9X0mp6y7jGIkXvm",
-	"dppesyMvlFgK2k/BAmLGcT39zH985D+qsI0NNqhORZ8nJCpZoLyHpmuE3F0G7pn7L8jGHMqFPCY315IN",
-	"5kPexPg45b1PAZJX1+hKDBzRtudtUnZZu/zTdHx5rT5z5nTrvXwOgmu5Z5Evd+u8/JGwdyTFYp/Zqwf3",
-	"kosxBuFblQmZS7LgoinDn0RKSnarP+e/IwbA4SPgzNbOFZ9leRWf8aUMrt0UfsrnJaHbZAI4IqqmVPmj",
-	"fF9ZlgScS4yGoU9Bitf70DVlMComtd2DBJGUqqmVi4fIH0Ihg4nwNOqiHaJXVWXDdE6KMFWqs+mshUt4",
-	"N8oB3ch3LrRR2uQ1nOwIrCHeiUgCq7PgilBmK6lN87DTc1GzaZNM6CYImt/W03PO+X9Be+fjjBy51Ow/",
-	"a2w3eCBIDzaVXm4+CIY5YlZo6TPYlE+LAsXWGWIe9x9rhkR5LZe/2gwxYqsOP0N0568zZPsMyQb7fO0Y",
-	"A7L7JNG222f1YQcTAxRCILTDq2pvFOeC6l/ltWbJryAMyUokcpkTwPDdrbMZY8wVmvpLB1A5Cwzhrsr2",
-	"ZoEui9zH7CD0eTYQNs7zd39VqAOZjbLXm2Y6y14dqBJZ+7xKEfbhlBkH7fX7SDFD4TP7oFn4Qf13AyPe",
-	"pf7bTAUs1H5Pxw3WfVG7PKalgvi3X7eS/GYscqUM9jU99PCcftafjqZXNYCvV7EaZ7zP06ybhP5V0b4q",
-	"2mMpWmzEx79q1gNoVq0N9lWt7OH0MwrqeMIqR7HZiaZ0lm/XXjcPlxfP94spiFMUfBmlozqIwQIeRZTX",
-	"f0GnnZaiGRekWjL8WTnWNktxcS3XFXqpeSBv81CAqvPu+/dMbPCuHWDSin5erY2/rLXxnK3865bwu9P3",
-	"Up/ubLdkFRa+yFlNBv2780KXr4s52BlNpePDeZ/Lt2R932czGbWVmXBEd3Mu8F+jqzljyaub+dUe+fbc",
-	"zMb1L7dfryb8PtzLm7XnUd3Kuf78Kl3K1fInB3Mnm3cbvSrTV2X6lbuSXzXok27kzSr0BdzHGfBX1/FT",
-	"ovvqNt5FZr+Eu/j7dB9YNvkHcxO/WhGvLuLX7dyra/gpW0RJDD31s7zI7ZZI8a5BmTwjFaPxArUqO10U",
-	"XaVgPm+oCwXmv0rvZWVgrKwzxkbkJsph0enz9OkBEWUSVBacMhPt3CcB/EV1KyDZ+e8TzKBMfWPwgfFd",
-	"PsJZshNQiVecbL3SI1E+L09pUlczBNym8B4+eScjcDIfn7y7/dz1Hu35gOXrDmwkfdVDKzDW3HUUe7eN",
-	"62lmSWeZhjsMcyHrbutoa8PujbIqnhhqURdHVhw+/R8l5QEvpZxvTI20GU2FekWtdrftjdp9b2hLbxS1",
-	"StI40IXAN1QqEXWqC8UApMJbAeqotwvV1/gvJ6L89Q4J7FVpHBehaHs20EbfPA3D9bcgnxkhWii2CqjO",
-	"XDyc9tmidXZfVWU334bCF1zPcli3clvObDrNM8APqg5kQi/NcHnOGOSJ9N/QKEiktw5GQukhEluvJ5PN",
-	"ya3Xk8mzt3iJyoV/zhjmBUf/kiYyHyPT9VFKcuWjJO9KojC518NUupVOXLUYwHsYkjiCmDmybSEt++z0",
-	"VFzJuCSUnQ3lRSAKyEbRkuFxUrzIXKBaXExVCrgoI9jYtZtiwJ21w4tKZNluXefOGWu37wqeiN26HF+K",
-	"nHijl/Gl+3j7+P8CAAD//0gbDb2sKgEA",
+	"H4sIAAAAAAACA+09i3LjNpK/wtJeVTZXtky9JVelNvJjNr6bTOZsJ6nbOZcKIiGJa4rU8mFbOzX/ft14",
+	"kCAJyqJeY894k40lEQQajUaj3/hcs/z5wveoF4W108+1BQnInEY0yH0bLUg0GxHL8mMvGtnUghcC4jr/",
+	"pjY2tGloBc4icnyvdlq7plHg0AdqECtyHpzIoaExCfy5Ec2oES6o5UwcahuityPjceZYM8MinjGGdzyD",
+	"2HZAw9DwA4MYHkBQrx3V6BOZL1wK3ZtPdt8m3fGk2R1022QysHuU2gNqtmin1W507FaPkEHXbHfgNQcB",
+	"QuDhM3YF38S48ENA/xU7AU4hCmJ6VAutGZ0TnNB/BHQCTf9ykqLnhD9N/o4uVCwMeae/BR9wkC9fjkqR",
+	"N6E2DUi0PeIUrLDVeh/fk/Dfv/iBD+tz7+BPD8SNcco/5579vPDdeuhbDnFrAGpIiTWzyXyWeSf59eeG",
+	"if/UFy6b2B5R+k7iRqCzBJEMOcuRswqFNo2I4wL+gIry6OPvG1cXObrqt03abtqdXouOaWMyafbIgLYH",
+	"jTb8Mm5Ts9PoTyy72+9Yvcmg1WrYrcaAjGm/0570+iZt6ekNwFyFl2i5wFYhwO1N9RN2NprncAo4viAR",
+	"MQQ86VTJwjl5aJwQZ+R4EXX1cItv20Du0ejRD+43pnTxfnb/U2gT0Hiuh1oOuS0pfgz8yLd894PoTze9",
+	"hUsiwPt8RwxRdlcvWQ/xeLeM66PsdeUEt2dah5pcwkJWTiwIw4321PXNjWY3ja197KB/xTRYMm7neyPX",
+	"mTtREeQbBtmSQenF8zENDH9i8HdC49GJZo7HHiZML/KNQMwzu60appwEG1hl7goEVZdjyF5+z96F6T0d",
+	"+8B7ji04hoA7HdMnIMPjiEzFakxI7EYSFg5GYXw4nxwbFhiezB3vp8bRnDz91DRXYm9BpnQ18qCF4xF8",
+	"wFZdYFBFUGMldtgImyHnI75aATdF1IjB85jRo8SKg9APVmODtzHiEEge0ZFip15CJKLXqhg45689N3s5",
+	"XzGKdl42bDGLz2UdFjUmODlY7uS9sqmlHW96hlwkPaw70XRM7VwndF1ODNwgy8HwVeP36/fZzT+LokV4",
+	"enICfLFVd/yTsetP8Uv9ae6WoIXBoGIEmS1BCo0DBx7keNuaE2e9aud8T5dwEGumfUNJAPqDMmnL94CB",
+	"ezCuQVyXIQBfTbAhuipbcDnSKs4NTOc99abA30+bne4Rbjj5vbHp3NNxlZ0sQThSmF2nq0dR5UNC4Kv0",
+	"TGiWnQmbHQbVjwH1HFh5AGBTLU52JIL+NfyxjFxSibMaNoRkGa5NH55eFOXz3JMsumLeisiWTtyJ6Dzc",
+	"UhBN9g8JAoIDPh1P/WP87Ti8dxbHPpsLcY8XPqovAd+d62JxUSIX5tC4O4l3ryjUiLtfE32h41l0FDlz",
+	"GkbARNbDHTQNImTWAokOcCTZQxnm8gNV3Xy3yZvrTjw/on7+sWXRlbqFTgQRbyEiojgsnbLou+pUb8R7",
+	"XwQliO5u2GC1dWcvO9HNGhqvuUsKChW8umJ/YMeVVxYhX3dRcQD9lNgW2nBO8O6qSWHXlWcFL60/rWP9",
+	"pGIvctyquzNeoHyw3q7MD7D/XZkf8QtOHGUm+H7m2w43jaY/LEdnJLJmf6eRznwbDpN542soR0Ib/EgW",
+	"C9exmAp08s+QKxnp3BaBv6CBfE1YQ8PtzsOcRXljrp43IGygtacqYzWdLqOSbawwVYI8gVmR+6oKY8kR",
+	"t4OTHzfK1xSJNAdy5Y2oHGoVzxtxMFRh25LxJlgDDe43ePtTNQpCfgndfU4Rpehjd5vjU8NCK+IzHdsf",
+	"/xOonLOsPJ0xfmWMgWGxA2aMTEsqaUyvzR5Ac1CYHNDZjIT35JXXDFss4YK/0oiAZkXeuOAuueBm/Gsu",
+	"1mLzLSBXE+g9T3LPHK4a8wOjW4WnbgZS4kzZFUQqq96Kvb4IPrkhq0MZdlcIlex3G657lzeAbQjLC+W1",
+	"6Tkv9+iRtBIZxGMqDf8LIxsgNSdzWpMl553gX1koLfrk30TR70IU3aVp6U0MfRNDOeyM6YUAYChVc/Fl",
+	"NLy6Fp8rsbk8yLwL5vs2hlfHcNxwKjZEj8z34S0NWIXYiuKA1tbAxH/d/PbBkKCq/iXdCDV1jsDWbYHH",
+	"StNaZzkvg4B5Qwvg3gIPEEcLnkBz4uL+RWNjIIEPDcdjB6+RmonqWchzgrhc6R2sEodPYDNZCO55N1wn",
+	"jLIOKjQ3ZU8zKRkXu9W/vY0CwgMnCsxPyOjrdorS+Hnqvk53xSc+mbs1qFBd1kcSSrvxJHbXWLnlRuum",
+	"R/tWWKyKtVs/Ii4GSYTrca21saRINt8Nbefn/LrougD9gWlai70XQ89XKCKAtMCPhYMdNkPPcMTIRkiD",
+	"BxoYFNsavgXSOtACBniDTABoR7jx1IzSKeXmIITfc9+bONONVreCiM1HuWA2ki3w/gG02itv4u8PXDHA",
+	"DiCN3oFcZh9WEkm1EYDEjwOLMig9PwKuCNDkgLy+udmIISdhK3JPf/pck3F8+Bm1I4Do8fGxTsZWHbSy",
+	"OolrOUtbHM38gLcXPq3L+ZwYfy49j1s2stP7v9g0W5btPLAPlH+dNfm3K88IobfolP98In/nXxf8ywcH",
+	"UOg6TJq24jDy57B/zh0PJO5reEQCO0QPMVnCss6YPcGHHfYI76A8x6Rcyw+j8Ag2oeXGNvYTUEyrmMPQ",
+	"U2AzzBiBXCs07JhyZx6cJT40tKmLHePCupS54Gcw+syPQ1oXQC80MN/A+zNYQbYK6PD0UeBHDza+HsbA",
+	"XYnnxTycEv5ls5g5C2Ma4HqHxjjmhBpQZHrU1g4m0fXnjEQ/ALnQp+hvqzD559A49+eAPqCvX0E6D4En",
+	"GTcw40vXpcESUYfBsMS955GxPNIRpo7aABn7ABPSp/sgORRDkcQNRgpybM2xvVwqQAIe7sSCl7SzOEmJ",
+	"AwMIF/F4JGxdTbPZPjZ7x2bz1myfNlqnrX7dNM1/oD7iRCz+itMB39KLhR9yXI9REmBQwnwIIOYRlmxO",
+	"jfES9tRj3bjyYOrERnoBvHHYQHXz7oN4EVnLrF2hBtvtl3jMFDUXj7ZRHLi4AZKYwOyGOYHhwpMU9hMv",
+	"JeBjBON4HDsukuExx9exReKQPRRUcNIw22a712+YTDhA3bwWsLiGyNftT3FA8sjAu4wppwaLBi3KdjYm",
+	"iBxiGugMt+lT7dRUjD04pxlDrP/ooepehC+xaEyIG9IcLlLdvtFrDPqDTrM/KEURihSjhNk18kj7cvSs",
+	"+LMXaTNl5a9LzvwTVpAGG5/pmwuY5/IRjr0D8TBZeAYJLEfrWMg+iYnq+O/+CCALRzPgGeFoThxvBOB7",
+	"ZOEA0m1HZ0vmCXqaDAYMhJ3TJHqmmN+Qm87RJjClxzWgag2jXOUhpEQAywFHBxDw2KXDBeDggeXLHWK0",
+	"WNqcDzDYWRwcaCQ4lqPDjHQbEJsebCgvnNBgj6NdPoEk6E3pewe4ns256d7H8ol3gGFuInLPWcfeR3ok",
+	"iz0O83eUyz3iWRReA1ltr6wiHewPP9onnSO3haHCfTOJZJw9s4hknH0zCHWgfbMHeHOPvd+wPHGmWu11",
+	"afg4FxS0Lbr3Yd479/sfZM+0zAf5CNr//gcJ/Inj0kOM87Tc+yjX9MEJ6QGGeQRdce/D3IBGus/JMAbG",
+	"dcsDCMHKaGeBY08PNLM9H2/KSHtmCspI6clztwvF69a/p141ZdCmljMnrvoQXQVTdhrWeP2OJG0UnnTb",
+	"qb1HacgtsZr+FwQOWHsEY0wp2q20jcKIeDbhmaM7xTn14vnoRvaOIy3nY18PBObErpEjK4qvrIGVnejS",
+	"t5Wi/Sp3jwAm4vfuaZ51r9D7nka4kXVy9tG5okfuaYTfvXvPf9wXchIxd0/9w1tJDNXue0/VKE3U6iYb",
+	"irEEjdVomMQeYgs0WhL2hDK7+wNw1tp+xud2pOLwVkDRC3FUG7PyRBPHY4Ea8NFCdGDSf7yweRMRKIRf",
+	"9gIkU8WKII5jjIgLqYvA+BM8yPArGvwT6BO4dwfYhRopKmFx0HfDnQ0A0KSmDXFOI/xktZWn+oVSPCIN",
+	"7nOAfgNmSFaqiLAX0EWEVWNOa1MnmsXjOtA6q8pwLBwLJws5kakvqP+EJDb2jWddsDBpKNZGShn7QeA/",
+	"4hrwBWQEPJeUvGBRjOiscvEDutnsgDzucHlU89QKspagQF8O3+AYvYtz489DuluqyZmzipCBFHKPA8ee",
+	"/GS5xJnvEISsTagIwYTVZyFTDP7DZSPjEAMBdwhB1rhStqPRryQ39u7G/pAGWcvhYnHywVSDsRMFrGQY",
+	"CR4pYdRKHghuN8zCZnyQoe44nJMgOobFZFseXrN89skK/DAcc2akVCCbkMAi6GmGz1PPD52QzdCjBCML",
+	"4Ilvs70PP2ATDOidOyG+ufDd5ZQ9SxyFIXnAJw+hy7iFS5as3zHIhOH98jmmI4ulfUjqUegYTnXuopad",
+	"2FBGVm0IKzetRx/ZHnkMyEI9gtj33RGLYmzQnswYbZ0yth2Oq6gicrjfEyq9vD4/ZhVX8EOj2xGfes2G",
+	"/K3RkT82Bt0efPxw+fG40W7sEMRbHitf3EOWIqlijRgh1QPZp0IUd+lyefBIOLJFmUkAUJHU7zIF/dK+",
+	"VtF3RKb1WzLdGV2z3P/1Q+43RGfReHJQWbBgTimObtMF8K1o58f1Si/q/pRObqZAfSGfALVO+s+uJf58",
+	"rEJK/znt/W5nKI6Tie4dw/vFZEZ3KS4ohr3tfDnF3L7sb32YpfEQi7OvCTAD5mueAHf9vfr9oQrZ38zu",
+	"SGzWr47AijEap4c8gsoUeFwrnPD6ib8bIzQX36c/+RJwduKT0EetfB3EpwYJTHWes1zpvaEapXFQjQIu",
+	"Su1pk2uXTxl5P0soI4K+yipm7TfoYqKB49tFYKiX9V6honqMQbs6tw6rcLdu8xzi+btHbEAdwiPpljsk",
+	"EfBB97T+GKdVwDeP8d4b5/IPd1ZugyNNkFkBU5itrfVBAgWNxq5v3Wufcm9s4WfX8fTtuaKcPVYKjfIR",
+	"34yYS4HIUZtj75jCclFzB2UwWussY+TinCi6vQNKRGh5Ea972fQrYxkPyQqyUYevThTMBjO+XvAPpygd",
+	"bh9qfRRsH74efakYW/rqaAxDVov8V2ZfKkdKtkVJJJDukM0fPZmsGV0sUZKZt65UJ7L09pPmUhJ+u7+F",
+	"Lgsr3emyZ2J8v4m5sEDib2Imez6sDjiTj4KX67hLaZhgqdgs0v5GIoSv8ByUFtvVxyfOZf7cfgwvPD1P",
+	"w+sW3NU6KpHpgdXJ7MWyJgGPXd6bwhXG8zkJ9AiXdQHXVytAq5jSaH/ycIZkxWilhJSpyrVGdOshjxEZ",
+	"x39Q1Ufn/kcT2YrA4bHj61XYp4XDqWa9I3rF3pRBw/pNfU/1tFkeirx6yyWBvYdZ5KflV1riJ8XuvEiJ",
+	"7TC5KTji03JUTlj7wLZIJvkmDs7rhOu//rnwtJhXPhVd7s1BN3Z5/Aoe4qyYz2jN+qbbxfWl5+7Bhtuz",
+	"YX2HnKiYNPW1iCQTZPRGIi+TRF6lUTWfQveaJ/Dq7HaFXKVC9CIL8RwjZbHLECMe8GlTGfjJ0zdInI9/",
+	"2yLAMJtepkDkJiEYzON0z2NJw0cWU+xihMDuxldSiBQIFtJtApK/v6u8mVzCVSawfiXedze6TMdS0wqw",
+	"xNTuRhDZdmp4uDB9ogmX2Q2TiS58lkkg9Qwh//MwWoeHIHOBFhafSYPozrzf5Wqo2YclW4Kfx5q9seu1",
+	"kYHkmuht3SC6Avvq7ZsN82iXl01nrlI2MTfiyZkjlE2TIYV/aRQMJNXujF51ObTm1uXMfHcERtlFzMnw",
+	"MaDIi4T9TXtRAyivI2GAWK9oGCtjyi4t14sNSUs4bPQF367pAnRm7J6XRcR7c7GlQUIWwB/JYpPES5a3",
+	"WLp4Env8iocZCWf6qnL4RN7DK5uz4Si7j7csxVs21XfKG6X9oZVG2xsGt+srNpNHBgXOua6NySlHaqZm",
+	"W64QafYyVjjkWaXAkfD6Nxq9tigeCHPE6x8pboh2o9s3+2otQSXjSCnd+MfNe5GlEtHc2wN28uEFj7i2",
+	"xEauyIn1Uz4R45EVu4N2PO+If2V2rGRYoHpRYiIDfrPR6rc7vcIMOoNBr93qt9QZpOlOygTO40CkPahT",
+	"4K8PmiumoKaMlMzGEn2r8xFAHPNnhfl0B71+v1uYTqPd67T6HXU2aRaZMptfHVYFNz8d/np3xWxEiowK",
+	"/Vz2pYIvRj0WDzFlWbmmVhJXCnimHKWSjKbArPyahdqsCG/ajwqx/FkQ0B1PCjpdDSnPG1KATFOk1ocR",
+	"O1EBFEl2X74Uyq2vvi8ZmUOmicG7VC9rrVISMy0tqXNkrLh1GAFJHu8ViCDcqKPVPFI5oooVMp9xzTvh",
+	"KA09Sp6Pfd+lrEZdqbW+zLWS3u9SxVCfiU1TQDqSV7ryd7Ni090qrCSXGZULWvzCIyNm5xt8WJCp4xEZ",
+	"e1Um1Onu0NOTFCtOKw/kzMlOIizqDs3Mp0/m8YAcT4bH7+4+t80vVUcWt/cVxh+CLMFt9UcGux4Bz21R",
+	"hBmmC7KXz+QN2SatcH354YY1Do2//mcdTqkfj4x3MhMXcRUkDycWPHtPQUDlviD2owvff2R1sc9lWq/6",
+	"2ArHP9YrTFIvmCgiFcES+Tag1o6JKyRmKTCvFqjWiHpduea5mwQ3uz9wh/fsZYtMFwgiKTr8+/V7LA/O",
+	"GsuS5RxvGWb3XD2doqN4WhUNt2S6XoTwc+sgWU71+6NWcVb9NStZKVRTBd98svv2sHv2rtkddNvk3eCi",
+	"Ry/tATVbl51Wu9G5aPXIcNA12518hXzpXIMuevDqWXfYJ5cd0mu0zQHtXTZ6pDNudruDgdkaNnsgyg2t",
+	"mloJqtFProsGwlhe+EyKS4s0pWnQspxS7eP74f8m7BU1Pe3/iuSV1jBngmxoEa/u+CfR04n51G+btN20",
+	"O70WHdPGZNLskQFtDxogz7bGbWp2Gv2JZXf7Has3GbRaDbvVGJAx7Xfak17fpK20drkqgIoC3eYTnZx3",
+	"Jr2e1RledC7O+p2x1ez3Lvum3bdaZ334DwzY7TbPU6KN1GpdlqKo5ZQq6LxtNpvjXofmLraT1dApX3SR",
+	"gVJrNTqwGoNOp9U0YUXTxcC1+HKUUsTZ8KxtNwCw8XDY63WbpG33m82zvnnebffe9RqdQbPZHjQnsrL6",
+	"DpCor5yuaDuydnolek3qqmPqdskyZcqr97v9Qa/ZS1avAmHnS6+bmgU90vpiwufLrpfYVba/0cr3dNxR",
+	"pbq1DA+y/c4uWBS0u95dPIz+d3FCOiXiduw5/4qp4dhor5k4IFqgBJZfiaK4ykla1yN7lJe2DLG8uPJ1",
+	"fQG8ajdIFq7bTfZRJQmwaIvZ2W276VWOWXiuQEqy2FUljzPGBbKIyte3P9LoAlsc8VtdT7kDASHLSnRr",
+	"xZqsZ3zVU9IBhJCPCpUUi5w0QIifwd/h8I9L/EMe6NSPrBmKb8O0TM8Zr8pzRj37YvgbfDpnRafOlSo8",
+	"0ngEugD8951SiOcXZzpz4f94Gl79z5/OPXb+386jw+qvwGfUB/CPY/vsDy/X8975cDm8xg++fx9ec4fB",
+	"r0wNwtaJeecDJcGNrGryAVYPn/62oN4N6+a3tMbPRxKQaUAWM/FZuKA++u5yTkBzRgDR2I9/iOON2Rxv",
+	"hn/8in/Ql82r3/zuOeJNbvH7B+Ykes/VA8pYLerJqmxUPsXzbXry0JSlU7j14SRrOqlQS0VLN38KU43i",
+	"RhGFmlQaUUo5ycpNQCM28VlqKlsyi9cnU0hF2gL5ss8U8nD+9cjJ4x7IIxTk4fJ2LicPWc2J/Q2YBxHI",
+	"I+DkMU/II7HYzf25f09Ezaek+o0n6ETSP7puQtavWhNKoZdFSi8LlV6ChFBwhdKqUWFKL3FCL9yk++8N",
+	"6EWsxkuilkvPZo+4MUnPIKloY4j0RKbkW+wFw2bmp1AedRysos6NOkNzZDshGbvU3swJc8R6Ya46GoSb",
+	"9iEijHfrA0puByzYYvitffIWnCJi0PVVgnX2Jj7XnzpwTIaJv03/smhS0fdSuJy8moST1qD6GfaiNbPJ",
+	"fPZzw8R/6gt3lQUocxPkS7D+6K5pr2r5SfrYxvSjvTb8ZZh9NrzDm/DbSVJiEUydX+Dtb7NC64hj21Ut",
+	"XnP3pHajr6yq5jfWd6im6ghlOxX1uf2yV411s12X6q7qzkvcjGzvJUrtpljdCW9TlNl1+rgRzffMlg6k",
+	"y2qZ2gvRY7dmnLliFClVyx0j9jVDGF/OxBeYRcJRLf0k6UVdpLt1uLR6891mNJOe64WbbIe27XD525Bi",
+	"Q/lBrPoWlKzKxNyeiFAn6IsDOKRwVZN5lrUbvLE0ok+o0sgsrTIpLJ+v2Gi0Oh2z0Wk3uw2z02i0zdTv",
+	"//P7+J6E//7FD0Aate6dn0F5qqcBC2mmoBbwtLGAPNfbc/CvGFw/jfag2zJbvU7fHAx6yjT+skDj8l/8",
+	"cWz7j/x2S9Vi3Wp2m4N+p5/LRlx7Hic8cICGJwUg2rXiWK1ev9NYS5JYbfz5NY0SOVuvqHES8bAXA0Ya",
+	"brGBOprMuWi4UKJhxlXnuXvFe8tZ0pXKDYhCIA1wAQP1GhQ/Vio1SnZe8VwQD5HjPM4ci9/RzIYIjQVx",
+	"9CGCaa01TYfsWRJwSPVhgYlDrCT6Bx8aMGXLCdmV1klvov/6mhe0SKTqom0rhNvy23jLomubanQt63c3",
+	"4bWl8bX4SI1wVa7qLSBUnkBqLAu/GJ5fvauxOpT0dJsGx0iRF68IN0LKlht6BMDDivYEBO8WT24MDw5X",
+	"gO9wR4W8Kjwvzyzw9eJcohVd35Z1Y5AH4rhojKpKZr/ydb+mPAurqN1Zi3jEjKaqTj1xfRKlI3FouO4U",
+	"3o/CBbHoCISm6VhftaosFmtO536wTN98frwqifFyykLcW2UiFKFzOaPgeGlwLBVXrRAhWEmhzUCU2vZW",
+	"Rv7tYwAR1VeoYsgY/4hjY+0xc6bY8vJxIhi0Yvf8DJSda27BXpcCLoS4rIZkJ/Hv+dBsHTKKxuAck0Yx",
+	"UL4YGnOyNDwfmFC8wCPb+OX29mPziPEkUGwYy8bzFf+KPnkTZiZRoxzljfCpezMNgPFi19VYmLOAYbeG",
+	"eIrDjamBBzYP4hf3hXN4EC45g2fgmJPFJ762d4kUkwVKmKyLu+736/fy3CwdTIRr5MQkGfYjZVsRBV0H",
+	"oYdRghoaXStSHIZFaxaOk9gPoeHYR8Y8DiPEELemHLEDRZCM8cMnIV3dHX/ifd/9UA3wfNg2vLwABRSd",
+	"NY9kudIeevXx3Y0hG+JqTWgEUhE/QUH1RNDdyEE4k1ZIgyAx4o0CXG3jx4fjouFG9sl2wapZcL6RX17F",
+	"rFNcYvEQXfcBVVwsBnIc5tRnEnF1zIk8EAKKqaB1WF04+wMsy8OKSY4kRZdAlpyo6YvG9cfz3EagxpAP",
+	"KJFZNy64AHRqNFbvjJindQnAUVcSQtcoUE7f9Lw16x3dgdrOn5PQ7suXNIw9PzmMq836s6ohWJLkl7ti",
+	"MsEbO9wTO/xfoAdDqsIG3saE6aMJE+IJYJhlazw4xCAGtxsgBz0SWhF9WvggLBtL7AnoH4TGpBufm88Y",
+	"vgXdQzOYLebu1g0Y3LBAQQPlH34LHhwYzMCsSMObBv698VfJZtlXVDF/3JBP2z4a1+q8H7JYpMy6mCyi",
+	"cmuJ/O8Ocalx++hrH1m5vJ2DM34VFVnOvxFbbTR1fBUDK4ISu4FKBdGMRGxWEqo0yoDTOHAR12VnNvVg",
+	"qnXjFplIOPNj12aE6aU6XIIsNnaFM0VwFLTJLtHaN2L3qBWAfy8kB9aO+1NRbPBd139kwRAByL0OHHHC",
+	"4R4awoS9AhCdLLDHQ4nTHD+ShNbydhbt8yy6vrn5JR6nnBCoptKKSYJgzD25za46a98JHF+dd3IEJJzz",
+	"OYbV1HIss8iwmp3D82GZNZnnwqSQxJ5NW8/pM+yZAc+QqnlLfgTzFV9N2XpqOwT/0SSLVgg7yJqiKtgs",
+	"SrIkv4KtZoOk0nSUwm0wz02/+pCaQPfckVXV3fHBt6kmj9/CKi9JBFvOHChOFFEXX8SnjmTwavJdvYNU",
+	"/MYo7Y5dCqv2wSJNR2mgp7gKdCTjOPPmwszLUkgRnaeWv0wrvrFlG3adA/bn81ybzsSe2P1Gi9hNkwzM",
+	"MTEHvaZtt0yTWAOz17Cag+6432mwjGzow2YDuDAynnpBNKZo2UXXYa/f6XZ7rBkq66ma/qmWDQg9WT/D",
+	"52/cEQ0C0NyJfur8X2yazW4S2POTH0f8J/68hRlBubEID1YIi0/Mp8tuf0zsi8tm56Lf6g8IbY8HAFFz",
+	"eN5pdi8ajdakdUbOBxd/4903TT6Y4L0/ydwl/mtEpj9lE48K47UHDbM1HpjdiwkMctbrma12/+K80Rr3",
+	"G71ud9BrnXW7VqORjIcEk6ATy9uIs3XhW7Paaavdk4uJOEpa1JpmX5Mzx4McYElGAiOnNXbxqCibpLxv",
+	"8mgTOUpXP0qr3VlvlIbZLhsG5hcCLc2oPZJXV7HRa/GC1Xk9bXSavW4bGDacRuL4wSJB6FKr9RrtnkWt",
+	"mkz7emjUm3VTk/evbuln7P3Zx8o+qlKjOLPH139Rxzczm38HwOnG0LoLdtS7bryU/2gjeMVTgz7MEyex",
+	"OOK9bNixWsM6YU3rHCbXorlCaOU+Ot5CBQFj4kLowrNDfciSQq3rgPOHaL765BTHlfRzbCGtyINv5Xgf",
+	"M1JgzrG/cDavWnSkESnX6SVXTQlLRzN78JhE1mwU4iXvG8Ij7cqgLS+icGcdJleBbd7FetXOy/pYaTrf",
+	"pMMdoqho3Nht7oManVQtgq4slXHjuNE7FaxCjPH+6kKyEqZKMLMOjuqBhhtcXZBkGHzOBELJ4MN60mJX",
+	"930n2RBftox+LCevnDqy51VMtJ676nqOGkW8ZzBZoPI2IIpQ330VSmXZrTkq5L3Vb3ks707oj5NdVSTA",
+	"dLZMbfpUe3x8rJOxhQ7zOolXFoNkw72MhCZl5hukMsHb2yQxwevfbvoSivYVc5eyi7G/4HtZX3blKS6I",
+	"9IUkLKVb5jtMVcrSxVuSkqsazSunKGWxuSXPejFpSSm7OVBOUo5ZvepsJJUhvox0JPVkPcDtKnhR5K6z",
+	"lbjBVBQhu5zPifHn0vOoZhy04rYsEHXYB8q/zpr825WHPv4gOuU/n8jf+dcF//LBARS4QDve1LDiMPLn",
+	"QGPnsF5L4xoeodUThKcQA/fCGctzQLvkI7wzlyWX8WrSTPVDVN6PjDkMPSVTyr36zL1nc/8143q+h8HN",
+	"LnaMC+JSFqyOJXVmfhzSugB6oYH5htdpNshi4Toco8Jph6+HMRrjPC/mCR/wL5vFzFkY0wB2nR0a4zhi",
+	"tXoCitRDbe1gEl1/zkj0Q8iC9/+2CpN/Dg12C2ZgUQMj21mtxxuY8aXr0mCJqMPNStx7vnN5+UyYOvGW",
+	"Bhn7ABMvLSmD9hmKJG5gGgJbc2wvlwqQEFBjQix4STuLk5Q4vJp6i2itaTbbx2bv2Gzemu3TRuu01a+b",
+	"pvmPWhLZXuN0wKsaLTAyyRbxAIJgUJ4GxDzCks0phqh7/mPduPJg6sRGegG8cdiMMfHug3gRWcvaM7LT",
+	"6hStxCOLG++ZpCXg7HtJywr4OVRZV0rtvZoC3/whsndmrU3zaIpqSt6RpRUloY2RtJHd6o+HgvOrBEAM",
+	"AkniP5QeE3lU9xZrLnc9n9rCqa+jVuSdSCuhYm20QK1nW2f3MOhuhM95enRAiDYGb6PB9TqZIendZrpZ",
+	"4jO145zrnXu9tNVL8BEPuMI+9AQg3RercZ04ORRsF3pV3RtZl9oq8uBtnumw4IzTrgVvtBaMq5bjpqwE",
+	"3LVIaVMz3bDSk40njRBWRN3rYv03wTTE0X7DWymj3iZ5bc8NKTWUkGXhsVOQTP8a/liV8vM15rYLT7hV",
+	"RWiNICsf47KADJG7jsO3mOVfR6RZxh7z20rVgeHB5vEZwsa27ez/SD1oxbnDEWMIFxtLSC3fzdJXXNYL",
+	"f76CzSQqkv59eLghj0oziUEG2dQ7ITqp7JuQ790VAEqz6fQRSZu6e5yNq2sVEmg26UQXmbdOP/m0yuoF",
+	"OvOQZIPr1tpZ6RuZSLjd+suU8v4ZVS9LBttXJ61q6sjYOcQNEFWC1NKDQSY6r2WOwLaVsZ1sR/VijBV5",
+	"qVcXOqax7m7mowGKpyzJvqjRijMcpl+sg/cMHSS9FhhB5haVcgOdJXPxC0MqslLuZpDS3uBEZk2e6y97",
+	"ZYheSMIW6/T2/F65SaixdIHTOzuK2tcVzowrWvJCk9890DLcaPZsCQkB9o0UjXaljq2viiE2KIgYIGvc",
+	"YA+cOEA/CmiAgRoshIg9QJGN/ZwiGWPmGaHXHMFvckLazU3LwCgVY/jx6oinQIJwC4p/ko2DOY+OzeUb",
+	"F37x+C15Qhr89eq2JqLgkxwlVoWTp337wfREvBSeYNs0A70mh60pUTy1Rt2sm0K6R5sVRr/BTy1+d8aM",
+	"zf2ETGGbIqwnn/HHL/ijCN4okUFlQjqa8nISKPZQrymBd1cYcP93Gg1xlAvuLFfPkhLxIW2ifBxh7yPi",
+	"8Ft6ZD0GBLRpmmXMLmmXfhoNr67FZ0ROu9rLZ8S+5joLf7ld5eUPfvQODVD4aqfauFdIxkDRlyIfMqVk",
+	"hkWVhj+xxJQwns8JXkWP+DfYAhi4Amil+Yi7LK3lM7ziIbZlQai4L/1wFU1Arxhbk6v/kb+aLUkFTilG",
+	"jiF9IdmbjMJlCNJ8NrXtgQSOD4cD31opebAsIsdFmxvaG2XpDtarqLWhmihZsGooc+q05UuwG2GGPko1",
+	"F/icVfJgq0t/1hF7Z+4HtLgL8P5tXWHtMA0+PWOVm8poQjZxqPptCRQJmIc11nY+TKbDj5rNd43uHg/o",
+	"dmdb6XD7gSHMYLtCUp+CpnRbZGas3SGq039fO2SeVnT53naIEmG1+x0iO3/bIat3SLLYZ0tDWZD1N4mU",
+	"3T6LD2uIGCQTCCENXkV5I7sXRP8iuzVJgSWYWcrSudQNoNjulsmOUfYKUPAMtiffBQpxF2m7nKDzJPch",
+	"cYduJwN5ild//VcZO+A5KRu9qSa1bNSBKJS1yashsCU6ihR3e/U+gNE77pZ9hEkQQvV3bSXqpfrbkQhb",
+	"qPyejB6s+qI0eYxyZfHvXjaTfDUSuWAGm4oecnlAbROf9sZX5QAvl7EqPt7tOGsZ0b8x2jdGuy9G6ylR",
+	"8m+cdQecVXKDTVlrCP1zb/q63DTLLxUWaPleRBxPBvTc0yUstS1UOYfVeIxYrJTkitgKCzhjr7w+soGK",
+	"JVe1mB7G7o1FyziLNXi5PPmGY3ELjsy3h8DZGw9+VTz463HR70fUPRxX5Xt5U34aPZ18duwqnoVCaEsS",
+	"IcKdj6s5z+3T1cX2fgYxInpDvwoDER1gheW9UOLyO3SCSCoaIyFVouHPwlFRTsXZc1jWPQ/VACedxZcU",
+	"nSHfvqW3xFuxg03L+nnT3r5b7W0bMeDNxPbN8XvOT9eWW5K6NV/F952M/s159fKXcO3M513oeHfevPzd",
+	"g9+2rzuZbWEn7NF9lxL8S3TdJSh5c9u9ySOvz22nXKp193I54bfhrivnnnt106X880W66IpFpXbmnlNv",
+	"jHtjpm/M9IW75t446LNuuXIWWt0dl/LF79YVl6DzzQ335oZ7bW641yG6vgz3WznfPIDbLRn8zeX2HOW9",
+	"udvWodmv4Wb7Ns2uGuPoztxrb9rXm2vtzQz25lJ7ThYRFBOeWEl9jtWSSPbma57EzRmj8kKoZXbyih5R",
+	"CmS7pc5cd/QiBcbCwmhRp6wNq5HBl0WWcQqfXxBWrktUYxBioh770O430S0bSY9/oWOzOznoU4TWUcdL",
+	"ku6JKACA05YnvcOKOaep9eKiMBtlCvPpk3k8IMeT4fG7u89t84u+LkX+8i3dlF700jKIJXYNgd5V63qS",
+	"SNJJxYs1ljlT/WHlakvB7lxIFc8sNavSyO+/OPln6OcXPFf6qLREh05oylTPbDTbTXPQ7Jp9XZkNVjMv",
+	"XtjyWpqSinns1pRMUSrO8LAGong7UwsYfzlml7GsUUipSI3D7ChSnrWl0DeJXXf5GugzmYgkipUEKito",
+	"7I77rOA665+qvJvXwfAZ1pNaKiuxzXd2OEorEe2UHfDCMmECyzZrkBZ0ekWrwIFeuRhBGIKaS+1wQ++f",
+	"rCiUeAHhSDwyhhF8Q/Hov25++2Cw/oUEEAe8HmFy/1R14zR2lzFAr1Q2AZ53bH5bW5px3AMrli9NL9xS",
+	"U0nvH/gudRSkQ72CwkoGJ/txBwWPoMPyokc42rYml0DUSHsjh43JAddINUXmih8JmmC9Bg9ymXJ3lvsW",
+	"cYEOHqjrL+ZYSYm3zZTrOj05cbHdzA+j0z6/JlIMUkpaPMyfkxcwfAQ1K9yK0mCsyPzRut2UpSMqHV4U",
+	"IuTX61rnWlW6fZexDK7X5fCK1UpTehleYZHP/wdd0usc+zsBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
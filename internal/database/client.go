@@ -23,6 +23,11 @@ type Client interface {
 	DatasetENSNamehash
 	DatasetMastodonHandle
 	DatasetBlueskyProfile
+	DatasetBlueskyGraph
+	DatasetActivityPubKey
+	DeadLetter
+	StreamOutbox
+	Webhook
 
 	LoadCheckpoint(ctx context.Context, id string, network network.Network, worker string) (*engine.Checkpoint, error)
 	LoadCheckpoints(ctx context.Context, id string, network network.Network, worker string) ([]*engine.Checkpoint, error)
@@ -32,7 +37,16 @@ type Client interface {
 	FindActivity(ctx context.Context, query model.ActivityQuery) (*activityx.Activity, *int, error)
 	FindActivities(ctx context.Context, query model.ActivitiesQuery) ([]*activityx.Activity, error)
 	FindActivitiesMetadata(ctx context.Context, query model.ActivitiesMetadataQuery) ([]*activityx.Activity, error)
+	SearchActivities(ctx context.Context, query model.ActivitiesSearchQuery) ([]*activityx.Activity, error)
 	DeleteExpiredActivities(ctx context.Context, network network.Network, timestamp time.Time) error
+	DeleteActivities(ctx context.Context, network network.Network, ids []string, since time.Time) error
+}
+
+// TaskSaver is implemented by the workers that keep a state of their own built from the tasks, such as the edges of a social graph.
+// The tasks are transformed concurrently, so the state is saved in task order in the transaction that saves the activities of the tasks.
+type TaskSaver interface {
+	// SaveTasks saves the state of the tasks with the client bound to the transaction, the tasks that failed to transform are excluded.
+	SaveTasks(ctx context.Context, client Client, tasks []engine.Task) error
 }
 
 type Session interface {
@@ -53,6 +67,8 @@ type DatasetMirrorPost interface {
 
 type DatasetFarcasterProfile interface {
 	LoadDatasetFarcasterProfile(ctx context.Context, fid int64) (*model.Profile, error)
+	LoadDatasetFarcasterProfileByUsername(ctx context.Context, username string) (*model.Profile, error)
+	LoadDatasetFarcasterProfilesByAddress(ctx context.Context, address string) ([]*model.Profile, error)
 	SaveDatasetFarcasterProfile(ctx context.Context, profile *model.Profile) error
 }
 
@@ -71,6 +87,43 @@ type DatasetBlueskyProfile interface {
 	SaveDatasetBlueskyProfiles(ctx context.Context, profiles []*model.BlueskyProfile) error
 }
 
+type DatasetBlueskyGraph interface {
+	LoadDatasetBlueskyGraph(ctx context.Context, uri string) (*model.BlueskyGraph, error)
+	LoadDatasetBlueskyGraphs(ctx context.Context, query model.QueryBlueskyGraphs) ([]*model.BlueskyGraph, error)
+	SaveDatasetBlueskyGraph(ctx context.Context, graph *model.BlueskyGraph) error
+	DeleteDatasetBlueskyGraph(ctx context.Context, uri string) error
+}
+
+type DatasetActivityPubKey interface {
+	LoadDatasetActivityPubKey(ctx context.Context, id string) (*model.ActivityPubKey, error)
+	SaveDatasetActivityPubKey(ctx context.Context, key *model.ActivityPubKey) error
+}
+
+type DeadLetter interface {
+	SaveDeadLetters(ctx context.Context, deadLetters []*model.DeadLetter) error
+	FindDeadLetters(ctx context.Context, query model.DeadLettersQuery) ([]*model.DeadLetter, error)
+	DeleteDeadLetter(ctx context.Context, deadLetter *model.DeadLetter) error
+}
+
+type StreamOutbox interface {
+	SaveStreamOutbox(ctx context.Context, outbox *model.StreamOutbox) error
+	// LockStreamOutboxes locks the earliest outboxes that are not locked by others, it must be called in a transaction.
+	LockStreamOutboxes(ctx context.Context, limit int) ([]*model.StreamOutbox, error)
+	DeleteStreamOutboxes(ctx context.Context, ids []uint64) error
+}
+
+type Webhook interface {
+	LoadWebhook(ctx context.Context, id string) (*model.Webhook, error)
+	FindWebhooks(ctx context.Context, query model.WebhooksQuery) ([]*model.Webhook, error)
+	SaveWebhook(ctx context.Context, webhook *model.Webhook) error
+	DeleteWebhook(ctx context.Context, id string) error
+	SaveWebhookDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error
+	UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	// LockWebhookDeliveries locks the earliest pending deliveries that are due and not locked by others, it must be called in a transaction.
+	LockWebhookDeliveries(ctx context.Context, limit int) ([]*model.WebhookDelivery, error)
+	FindWebhookDeliveries(ctx context.Context, query model.WebhookDeliveriesQuery) ([]*model.WebhookDelivery, error)
+}
+
 var _ goose.Logger = (*SugaredLogger)(nil)
 
 type SugaredLogger struct {
@@ -2,12 +2,24 @@ package dialer
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/rss3-network/node/v2/config"
 	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/dialer/mysql"
 	"github.com/rss3-network/node/v2/internal/database/dialer/postgres"
+	"github.com/rss3-network/node/v2/internal/database/dialer/sqlite"
 )
 
 func Dial(ctx context.Context, config *config.Database) (database.Client, error) {
-	return postgres.Dial(ctx, config.URI, true)
+	switch config.Driver {
+	case database.DriverPostgreSQL, "":
+		return postgres.Dial(ctx, config.URI, true)
+	case database.DriverMySQL:
+		return mysql.Dial(ctx, config.URI, true)
+	case database.DriverSQLite:
+		return sqlite.Dial(ctx, config.URI)
+	default:
+		return nil, fmt.Errorf("unsupported database driver %s", config.Driver)
+	}
 }
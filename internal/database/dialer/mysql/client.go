@@ -0,0 +1,1126 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/ethereum/go-ethereum/common"
+	mysqlx "github.com/go-sql-driver/mysql"
+	"github.com/pressly/goose/v3"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/dialer/postgres/table"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/engine"
+	mirror_model "github.com/rss3-network/node/v2/internal/engine/worker/decentralized/contract/mirror/model"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	networkx "github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"moul.io/zapgorm2"
+)
+
+var _ database.Client = (*client)(nil)
+
+const (
+	// DefaultDeadlockRetryDelay is the maximum delay before retrying a transaction that has been rolled back by a deadlock.
+	DefaultDeadlockRetryDelay = time.Second
+
+	// errorNumberDeadlock is the error number of MySQL when a transaction is rolled back by a deadlock.
+	// https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html#error_er_lock_deadlock
+	errorNumberDeadlock = 1213
+)
+
+//go:embed migration/*.sql
+var migrationFS embed.FS
+
+type client struct {
+	partition bool
+	database  *gorm.DB
+	// transaction is true if the client is bound to a transaction,
+	// statements of a transaction run on a single connection and must not be executed concurrently.
+	transaction bool
+	// pool is the connection pool the client was dialed with, MySQL commits the open transaction implicitly
+	// before a DDL statement, so partition tables are created on a connection outside the transaction.
+	pool *gorm.DB
+}
+
+// maxGoroutines returns the number of goroutines allowed to execute statements concurrently.
+func (c *client) maxGoroutines(n int) int {
+	if c.transaction {
+		return 1
+	}
+
+	return n
+}
+
+// Migrate migrates the database.
+func (c *client) Migrate(ctx context.Context) error {
+	goose.SetBaseFS(migrationFS)
+	goose.SetTableName("versions")
+	goose.SetLogger(&database.SugaredLogger{Logger: zap.L().Sugar()})
+
+	if err := goose.SetDialect(new(mysql.Dialector).Name()); err != nil {
+		return fmt.Errorf("set migration dialect: %w", err)
+	}
+
+	connector, err := c.database.DB()
+	if err != nil {
+		return fmt.Errorf("get database connector: %w", err)
+	}
+
+	return goose.UpContext(ctx, connector, "migration")
+}
+
+// WithTransaction executes a transaction, the transaction is retried if it is rolled back by a deadlock.
+func (c *client) WithTransaction(ctx context.Context, transactionFunction func(ctx context.Context, client database.Client) error, transactionOptions ...*sql.TxOptions) error {
+	transactionFunc := func() error {
+		transaction, err := c.Begin(ctx, transactionOptions...)
+		if err != nil {
+			return fmt.Errorf("begin transaction: %w", err)
+		}
+
+		if err := transactionFunction(ctx, transaction); err != nil {
+			_ = transaction.Rollback()
+
+			return fmt.Errorf("execute transaction: %w", err)
+		}
+
+		if err := transaction.Commit(); err != nil {
+			return fmt.Errorf("commit transaction: %w", err)
+		}
+
+		return nil
+	}
+
+	retryIfFunc := func(err error) bool {
+		var mysqlError *mysqlx.MySQLError
+
+		return errors.As(err, &mysqlError) && mysqlError.Number == errorNumberDeadlock
+	}
+
+	onRetryFunc := func(n uint, err error) {
+		zap.L().Error("retrying failed transaction", zap.Uint("retry_attempt", n), zap.Error(err))
+	}
+
+	return retry.Do(
+		transactionFunc,
+		retry.RetryIf(retryIfFunc), retry.MaxJitter(DefaultDeadlockRetryDelay), retry.DelayType(retry.RandomDelay), retry.OnRetry(onRetryFunc), retry.Attempts(0),
+	)
+}
+
+// Begin begins a transaction.
+func (c *client) Begin(ctx context.Context, transactionOptions ...*sql.TxOptions) (database.Client, error) {
+	transaction := c.database.WithContext(ctx).Begin(transactionOptions...)
+	if err := transaction.Error; err != nil {
+		return nil, err
+	}
+
+	return &client{
+		partition:   c.partition,
+		database:    transaction,
+		transaction: true,
+		pool:        c.pool,
+	}, nil
+}
+
+// Rollback rolls back a transaction.
+func (c *client) Rollback() error {
+	return c.database.Rollback().Error
+}
+
+// Commit commits a transaction.
+func (c *client) Commit() error {
+	return c.database.Commit().Error
+}
+
+func (c *client) LoadCheckpoint(ctx context.Context, id string, network networkx.Network, worker string) (*engine.Checkpoint, error) {
+	var value table.Checkpoint
+
+	zap.L().Debug("attempting to load checkpoint from database",
+		zap.String("id", id),
+		zap.String("network", network.String()),
+		zap.String("worker", worker))
+
+	if err := c.database.WithContext(ctx).
+		Where("id = ? AND network = ? AND worker = ?", id, network, worker).
+		First(&value).
+		Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		zap.L().Info("checkpoint not found, initializing default checkpoint",
+			zap.String("id", id),
+			zap.String("network", network.String()),
+			zap.String("worker", worker))
+
+		// Initialize a default checkpoint.
+		value = table.Checkpoint{
+			ID:        id,
+			Network:   network,
+			Worker:    worker,
+			State:     json.RawMessage("{}"),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+	}
+
+	return value.Export()
+}
+
+func (c *client) LoadCheckpoints(ctx context.Context, id string, network networkx.Network, worker string) ([]*engine.Checkpoint, error) {
+	databaseStatement := c.database.WithContext(ctx)
+
+	var checkpoints []*table.Checkpoint
+
+	if id != "" {
+		databaseStatement = databaseStatement.Where("id = ?", id)
+	}
+
+	if network != networkx.Unknown {
+		databaseStatement = databaseStatement.Where("network = ?", network)
+	}
+
+	if worker != "" {
+		databaseStatement = databaseStatement.Where("worker = ?", worker)
+	}
+
+	if err := databaseStatement.Find(&checkpoints).Error; err != nil {
+		return nil, err
+	}
+
+	if len(checkpoints) == 0 {
+		zap.L().Info("no checkpoints found with given criteria",
+			zap.String("id", id),
+			zap.String("network", network.String()),
+			zap.String("worker", worker))
+
+		return nil, nil
+	}
+
+	result := make([]*engine.Checkpoint, 0, len(checkpoints))
+
+	for _, checkpoint := range checkpoints {
+		data, err := checkpoint.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, data)
+	}
+
+	return result, nil
+}
+
+func (c *client) SaveCheckpoint(ctx context.Context, checkpoint *engine.Checkpoint) error {
+	zap.L().Debug("saving checkpoint",
+		zap.Any("checkpoint", checkpoint))
+
+	spanStartOptions := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("checkpoint.id", checkpoint.ID),
+		),
+	}
+
+	ctx, span := otel.Tracer("").Start(ctx, "Database saveCheckpoint", spanStartOptions...)
+	defer span.End()
+
+	clauses := []clause.Expression{
+		clause.OnConflict{
+			Columns: []clause.Column{{Name: "id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"state":       string(checkpoint.State),
+				"updated_at":  time.Now(),
+				"index_count": gorm.Expr("checkpoints.index_count + ?", checkpoint.IndexCount),
+			}),
+		},
+	}
+
+	var value table.Checkpoint
+	if err := value.Import(checkpoint); err != nil {
+		return err
+	}
+
+	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
+}
+
+// SaveActivities saves activities and indexes to the database.
+func (c *client) SaveActivities(ctx context.Context, activities []*activityx.Activity, lowPriority bool) error {
+	zap.L().Debug("saving activities",
+		zap.Int("count", len(activities)),
+		zap.Bool("low_priority", lowPriority))
+
+	spanStartOptions := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int("activities", len(activities)),
+		),
+	}
+
+	ctx, span := otel.Tracer("").Start(ctx, "Database saveActivities", spanStartOptions...)
+	defer span.End()
+
+	if c.partition {
+		return c.saveActivitiesPartitioned(ctx, activities, lowPriority)
+	}
+
+	return fmt.Errorf("not implemented")
+}
+
+// LoadDatasetMirrorPost loads a mirror post with origin content digest.
+func (c *client) LoadDatasetMirrorPost(ctx context.Context, originContentDigest string) (*mirror_model.DatasetMirrorPost, error) {
+	var value table.DatasetMirrorPost
+
+	if err := c.database.WithContext(ctx).
+		Where("origin_content_digest = ?", originContentDigest).
+		First(&value).
+		Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		// Initialize a default post.
+		value = table.DatasetMirrorPost{
+			OriginContentDigest: originContentDigest,
+		}
+	}
+
+	return value.Export()
+}
+
+// SaveDatasetMirrorPost saves a mirror post's tx id and origin content digest to the database.
+func (c *client) SaveDatasetMirrorPost(ctx context.Context, post *mirror_model.DatasetMirrorPost) error {
+	clauses := []clause.Expression{
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			UpdateAll: true,
+		},
+	}
+
+	var value table.DatasetMirrorPost
+	if err := value.Import(post); err != nil {
+		return err
+	}
+
+	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
+}
+
+// FindActivity finds an Activity by id.
+func (c *client) FindActivity(ctx context.Context, query model.ActivityQuery) (*activityx.Activity, *int, error) {
+	if c.partition {
+		return c.findActivityPartitioned(ctx, query)
+	}
+
+	return nil, nil, fmt.Errorf("not implemented")
+}
+
+// FindActivities finds Activities.
+func (c *client) FindActivities(ctx context.Context, query model.ActivitiesQuery) ([]*activityx.Activity, error) {
+	if c.partition {
+		return c.findActivitiesPartitioned(ctx, query)
+	}
+
+	return nil, fmt.Errorf("not implemented")
+}
+
+// FindActivitiesMetadata finds Activities by metadata.
+func (c *client) FindActivitiesMetadata(ctx context.Context, query model.ActivitiesMetadataQuery) ([]*activityx.Activity, error) {
+	if c.partition {
+		return c.findActivitiesMetadataPartitioned(ctx, query)
+	}
+
+	return nil, fmt.Errorf("not implemented")
+}
+
+// SearchActivities finds Activities by the keyword in their content.
+func (c *client) SearchActivities(ctx context.Context, query model.ActivitiesSearchQuery) ([]*activityx.Activity, error) {
+	if c.partition {
+		return c.searchActivitiesPartitioned(ctx, query)
+	}
+
+	return nil, fmt.Errorf("not implemented")
+}
+
+// DeleteExpiredActivities deletes expired activities.
+func (c *client) DeleteExpiredActivities(ctx context.Context, network networkx.Network, timestamp time.Time) error {
+	if c.partition {
+		return c.deleteExpiredActivitiesPartitioned(ctx, network, timestamp)
+	}
+
+	return fmt.Errorf("not implemented")
+}
+
+// DeleteActivities deletes activities and their indexes by ids, which were stored since the timestamp.
+func (c *client) DeleteActivities(ctx context.Context, network networkx.Network, ids []string, since time.Time) error {
+	if c.partition {
+		return c.deleteActivitiesPartitioned(ctx, network, ids, since)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := c.database.WithContext(ctx).
+		Where("network = ? AND id IN ? AND timestamp >= ?", network, ids, since.UTC()).
+		Delete(&table.Index{}).
+		Error; err != nil {
+		return fmt.Errorf("delete indexes: %w", err)
+	}
+
+	if err := c.database.WithContext(ctx).
+		Where("network = ? AND id IN ? AND timestamp >= ?", network, ids, since.UTC()).
+		Delete(&table.ActivitySearch{}).
+		Error; err != nil {
+		return fmt.Errorf("delete searches: %w", err)
+	}
+
+	if err := c.database.WithContext(ctx).
+		Where("network = ? AND id IN ? AND timestamp >= ?", network, ids, since.UTC()).
+		Delete(&table.Activity{}).
+		Error; err != nil {
+		return fmt.Errorf("delete activities: %w", err)
+	}
+
+	return nil
+}
+
+// LoadDatasetFarcasterProfile loads a profile.
+func (c *client) LoadDatasetFarcasterProfile(ctx context.Context, fid int64) (*model.Profile, error) {
+	var value DatasetFarcasterProfile
+
+	if err := c.database.WithContext(ctx).
+		Where("fid = ?", fid).
+		First(&value).
+		Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		// Initialize a default profile.
+		value = DatasetFarcasterProfile{
+			Fid: fid,
+		}
+	}
+
+	return value.Export()
+}
+
+// LoadDatasetFarcasterProfileByUsername loads the profile of a username, nil is returned if it is not found.
+func (c *client) LoadDatasetFarcasterProfileByUsername(ctx context.Context, username string) (*model.Profile, error) {
+	var value DatasetFarcasterProfile
+
+	if err := c.database.WithContext(ctx).
+		Where("username = ?", username).
+		Order("fid").
+		First(&value).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// LoadDatasetFarcasterProfilesByAddress loads the profiles that have verified an address.
+func (c *client) LoadDatasetFarcasterProfilesByAddress(ctx context.Context, address string) ([]*model.Profile, error) {
+	verifications := c.database.
+		Table(table.DatasetFarcasterVerification{}.TableName()).
+		Select("fid").
+		Where("address = ?", address)
+
+	var values []*DatasetFarcasterProfile
+
+	if err := c.database.WithContext(ctx).
+		Where("fid IN (?)", verifications).
+		Order("fid").
+		Find(&values).
+		Error; err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*model.Profile, 0, len(values))
+
+	for _, value := range values {
+		profile, err := value.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+// SaveDatasetFarcasterProfile saves a profile, and replaces the addresses verified by the profile.
+func (c *client) SaveDatasetFarcasterProfile(ctx context.Context, profile *model.Profile) error {
+	clauses := []clause.Expression{
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "fid"}},
+			UpdateAll: true,
+		},
+	}
+
+	var value DatasetFarcasterProfile
+	if err := value.Import(profile); err != nil {
+		return err
+	}
+
+	verifications := lo.Map(lo.Uniq(profile.EthAddresses), func(address string, _ int) *table.DatasetFarcasterVerification {
+		return &table.DatasetFarcasterVerification{
+			Fid:     profile.Fid,
+			Address: address,
+		}
+	})
+
+	return c.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clauses...).Create(&value).Error; err != nil {
+			return fmt.Errorf("save profile: %w", err)
+		}
+
+		if err := tx.Where("fid = ?", profile.Fid).Delete(&table.DatasetFarcasterVerification{}).Error; err != nil {
+			return fmt.Errorf("delete verifications: %w", err)
+		}
+
+		if len(verifications) == 0 {
+			return nil
+		}
+
+		if err := tx.Create(verifications).Error; err != nil {
+			return fmt.Errorf("save verifications: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// LoadDatasetENSNamehash accepts an ENS namehash, and response with a record containing its original name string
+func (c *client) LoadDatasetENSNamehash(ctx context.Context, hash common.Hash) (*model.ENSNamehash, error) {
+	var value table.DatasetENSNamehash
+
+	if err := c.database.WithContext(ctx).
+		Where("hash = ?", hash).
+		First(&value).
+		Error; err != nil {
+		// No such ENS
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// SaveDatasetENSNamehash save an ENS namehash into database for further queries
+func (c *client) SaveDatasetENSNamehash(ctx context.Context, namehash *model.ENSNamehash) error {
+	clauses := []clause.Expression{
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "hash"}},
+			UpdateAll: true,
+		},
+	}
+
+	var value table.DatasetENSNamehash
+	if err := value.Import(namehash); err != nil {
+		return err
+	}
+
+	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
+}
+
+// LoadDatasetActivityPubKey loads the key pair of the ActivityPub actor, it returns nil if the key pair has not been created.
+func (c *client) LoadDatasetActivityPubKey(ctx context.Context, id string) (*model.ActivityPubKey, error) {
+	var value table.DatasetActivityPubKey
+
+	if err := c.database.WithContext(ctx).
+		Where("id = ?", id).
+		First(&value).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// SaveDatasetActivityPubKey saves the key pair of the ActivityPub actor.
+func (c *client) SaveDatasetActivityPubKey(ctx context.Context, key *model.ActivityPubKey) error {
+	clauses := []clause.Expression{
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			UpdateAll: true,
+		},
+	}
+
+	var value table.DatasetActivityPubKey
+	if err := value.Import(key); err != nil {
+		return err
+	}
+
+	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
+}
+
+func (c *client) SaveRecentMastodonHandles(ctx context.Context, handles []*model.MastodonHandle) error {
+	values := make([]table.DatasetMastodonUpdateHandle, 0, len(handles))
+
+	for _, handle := range handles {
+		var value table.DatasetMastodonUpdateHandle
+		if err := value.Import(handle); err != nil {
+			return err
+		}
+
+		values = append(values, value)
+	}
+
+	onConflictClause := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "handle"}},
+		UpdateAll: true,
+	}
+
+	return c.database.WithContext(ctx).Clauses(onConflictClause).CreateInBatches(&values, math.MaxUint8).Error
+}
+
+func (c *client) GetUpdatedMastodonHandles(ctx context.Context, query model.QueryMastodonHandles) ([]*model.MastodonHandle, error) {
+	databaseStatement := c.database.WithContext(ctx).Table(table.DatasetMastodonUpdateHandle{}.TableName())
+
+	if query.Cursor != nil {
+		var handleCursor *table.DatasetMastodonUpdateHandle
+
+		if err := c.database.WithContext(ctx).First(&handleCursor, "handle = ?", query.Cursor).Error; err != nil {
+			return nil, fmt.Errorf("get handle cursor: %w", err)
+		}
+
+		databaseStatement = databaseStatement.Where("updated_at < ? OR (updated_at = ? AND created_at < ?)", handleCursor.UpdatedAt, handleCursor.UpdatedAt, handleCursor.CreatedAt)
+	}
+
+	if query.Since != nil {
+		databaseStatement = databaseStatement.Where("updated_at > ?", time.UnixMilli(int64(*query.Since)))
+	}
+
+	if query.Limit != nil {
+		databaseStatement = databaseStatement.Limit(*query.Limit)
+	}
+
+	databaseStatement = databaseStatement.Order("updated_at DESC, created_at DESC")
+
+	var handles []*table.DatasetMastodonUpdateHandle
+
+	if err := databaseStatement.Find(&handles).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.MastodonHandle, 0, len(handles))
+
+	for _, handle := range handles {
+		value, err := handle.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+func (c *client) SaveDatasetBlueskyProfiles(ctx context.Context, profiles []*model.BlueskyProfile) error {
+	values := make([]table.DatasetBlueskyProfile, 0, len(profiles))
+
+	for _, profile := range profiles {
+		var value table.DatasetBlueskyProfile
+		if err := value.Import(profile); err != nil {
+			return err
+		}
+
+		values = append(values, value)
+	}
+
+	onConflictClause := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "did"}},
+		UpdateAll: true,
+	}
+
+	return c.database.WithContext(ctx).Clauses(onConflictClause).CreateInBatches(&values, math.MaxUint8).Error
+}
+
+func (c *client) LoadDatasetBlueskyProfiles(ctx context.Context, query model.QueryBlueskyProfiles) ([]*model.BlueskyProfile, error) {
+	databaseStatement := c.database.WithContext(ctx).Table(table.DatasetBlueskyProfile{}.TableName())
+
+	if query.Cursor != nil {
+		var cursor *table.DatasetBlueskyProfile
+
+		if err := c.database.WithContext(ctx).First(&cursor, "handle = ?", query.Cursor).Error; err != nil {
+			return nil, fmt.Errorf("get handle cursor: %w", err)
+		}
+
+		databaseStatement = databaseStatement.Where("updated_at < ? OR (updated_at = ? AND created_at < ?)", cursor.UpdatedAt, cursor.UpdatedAt, cursor.CreatedAt)
+	}
+
+	if query.Since != nil {
+		databaseStatement = databaseStatement.Where("updated_at > ?", time.UnixMilli(int64(*query.Since)))
+	}
+
+	if query.Limit != nil {
+		databaseStatement = databaseStatement.Limit(*query.Limit)
+	}
+
+	if query.Handles != nil {
+		databaseStatement = databaseStatement.Where("handle IN ?", query.Handles)
+	}
+
+	databaseStatement = databaseStatement.Order("updated_at DESC, created_at DESC")
+
+	var profiles []*table.DatasetBlueskyProfile
+
+	if err := databaseStatement.Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.BlueskyProfile, 0, len(profiles))
+
+	for _, profile := range profiles {
+		value, err := profile.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// LoadDatasetBlueskyGraph loads the edge of the social graph created by the record, it returns nil if the edge does not exist.
+func (c *client) LoadDatasetBlueskyGraph(ctx context.Context, uri string) (*model.BlueskyGraph, error) {
+	var value table.DatasetBlueskyGraph
+
+	if err := c.database.WithContext(ctx).
+		Where("uri = ?", uri).
+		First(&value).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// LoadDatasetBlueskyGraphs loads the edges of the social graph owned by or pointing to an account, the latest first.
+func (c *client) LoadDatasetBlueskyGraphs(ctx context.Context, query model.QueryBlueskyGraphs) ([]*model.BlueskyGraph, error) {
+	databaseStatement := c.database.WithContext(ctx).Table(table.DatasetBlueskyGraph{}.TableName())
+
+	if query.Cursor != nil {
+		var cursor *table.DatasetBlueskyGraph
+
+		if err := c.database.WithContext(ctx).First(&cursor, "uri = ?", query.Cursor).Error; err != nil {
+			return nil, fmt.Errorf("get graph cursor: %w", err)
+		}
+
+		databaseStatement = databaseStatement.Where("created_at < ? OR (created_at = ? AND uri < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.URI)
+	}
+
+	if query.DID != nil {
+		databaseStatement = databaseStatement.Where("did = ?", query.DID)
+	}
+
+	if query.Subject != nil {
+		databaseStatement = databaseStatement.Where("subject = ?", query.Subject)
+	}
+
+	if query.Kind != "" {
+		databaseStatement = databaseStatement.Where("kind = ?", query.Kind)
+	}
+
+	if query.Limit != nil {
+		databaseStatement = databaseStatement.Limit(*query.Limit)
+	}
+
+	var graphs []*table.DatasetBlueskyGraph
+
+	if err := databaseStatement.Order("created_at DESC, uri DESC").Find(&graphs).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.BlueskyGraph, 0, len(graphs))
+
+	for _, graph := range graphs {
+		value, err := graph.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// SaveDatasetBlueskyGraph saves the edge of the social graph created by a record.
+func (c *client) SaveDatasetBlueskyGraph(ctx context.Context, graph *model.BlueskyGraph) error {
+	clauses := []clause.Expression{
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "uri"}},
+			UpdateAll: true,
+		},
+	}
+
+	var value table.DatasetBlueskyGraph
+	if err := value.Import(graph); err != nil {
+		return err
+	}
+
+	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
+}
+
+// DeleteDatasetBlueskyGraph deletes the edge of the social graph created by a deleted record.
+func (c *client) DeleteDatasetBlueskyGraph(ctx context.Context, uri string) error {
+	return c.database.WithContext(ctx).Where("uri = ?", uri).Delete(&table.DatasetBlueskyGraph{}).Error
+}
+
+// SaveDeadLetters saves the dead letters, the attempts are increased if the task has already failed before.
+func (c *client) SaveDeadLetters(ctx context.Context, deadLetters []*model.DeadLetter) error {
+	values := make([]table.DeadLetter, 0, len(deadLetters))
+
+	for _, deadLetter := range deadLetters {
+		var value table.DeadLetter
+		if err := value.Import(deadLetter); err != nil {
+			return err
+		}
+
+		values = append(values, value)
+	}
+
+	onConflictClause := clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}, {Name: "network"}, {Name: "worker"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"error":      gorm.Expr("VALUES(`error`)"),
+			"payload":    gorm.Expr("VALUES(`payload`)"),
+			"attempts":   gorm.Expr("`dead_letters`.`attempts` + 1"),
+			"updated_at": gorm.Expr("VALUES(`updated_at`)"),
+		}),
+	}
+
+	return c.database.WithContext(ctx).Clauses(onConflictClause).CreateInBatches(&values, math.MaxUint8).Error
+}
+
+// FindDeadLetters finds the dead letters, ordered from the latest to the earliest.
+func (c *client) FindDeadLetters(ctx context.Context, query model.DeadLettersQuery) ([]*model.DeadLetter, error) {
+	databaseStatement := c.database.WithContext(ctx).Table(table.DeadLetter{}.TableName())
+
+	if query.Cursor != nil {
+		var cursor *table.DeadLetter
+
+		if err := c.database.WithContext(ctx).First(&cursor, "id = ? AND network = ? AND worker = ?", query.Cursor.ID, query.Cursor.Network, query.Cursor.Worker).Error; err != nil {
+			return nil, fmt.Errorf("get dead letter cursor: %w", err)
+		}
+
+		databaseStatement = databaseStatement.Where("(created_at, id, network, worker) < (?, ?, ?, ?)", cursor.CreatedAt, cursor.ID, cursor.Network, cursor.Worker)
+	}
+
+	if query.Network != nil {
+		databaseStatement = databaseStatement.Where("network = ?", query.Network)
+	}
+
+	if query.Worker != nil {
+		databaseStatement = databaseStatement.Where("worker = ?", query.Worker)
+	}
+
+	if query.Limit > 0 {
+		databaseStatement = databaseStatement.Limit(query.Limit)
+	}
+
+	var deadLetters []*table.DeadLetter
+
+	if err := databaseStatement.Order("created_at DESC, id DESC, network DESC, worker DESC").Find(&deadLetters).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.DeadLetter, 0, len(deadLetters))
+
+	for _, deadLetter := range deadLetters {
+		value, err := deadLetter.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// DeleteDeadLetter deletes the dead letter after the task has been replayed successfully.
+func (c *client) DeleteDeadLetter(ctx context.Context, deadLetter *model.DeadLetter) error {
+	return c.database.WithContext(ctx).
+		Where("id = ? AND network = ? AND worker = ?", deadLetter.ID, deadLetter.Network, deadLetter.Worker).
+		Delete(&table.DeadLetter{}).Error
+}
+
+// SaveStreamOutbox saves a batch of activities to be published to the stream by the relay.
+func (c *client) SaveStreamOutbox(ctx context.Context, outbox *model.StreamOutbox) error {
+	var value table.StreamOutbox
+	if err := value.Import(outbox); err != nil {
+		return err
+	}
+
+	if err := c.database.WithContext(ctx).Create(&value).Error; err != nil {
+		return err
+	}
+
+	outbox.ID = value.ID
+
+	return nil
+}
+
+// LockStreamOutboxes locks the earliest outboxes, the outboxes locked by other relays are skipped.
+func (c *client) LockStreamOutboxes(ctx context.Context, limit int) ([]*model.StreamOutbox, error) {
+	var outboxes []*table.StreamOutbox
+
+	if err := c.database.WithContext(ctx).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+		Order("id ASC").
+		Limit(limit).
+		Find(&outboxes).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.StreamOutbox, 0, len(outboxes))
+
+	for _, outbox := range outboxes {
+		value, err := outbox.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// DeleteStreamOutboxes deletes the outboxes that have been published.
+func (c *client) DeleteStreamOutboxes(ctx context.Context, ids []uint64) error {
+	return c.database.WithContext(ctx).Where("id IN ?", ids).Delete(&table.StreamOutbox{}).Error
+}
+
+// LoadWebhook loads the webhook, it returns nil if the webhook is not found.
+func (c *client) LoadWebhook(ctx context.Context, id string) (*model.Webhook, error) {
+	var value table.Webhook
+
+	if err := c.database.WithContext(ctx).Where("id = ?", id).First(&value).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// FindWebhooks finds the webhooks, ordered from the earliest created.
+func (c *client) FindWebhooks(ctx context.Context, query model.WebhooksQuery) ([]*model.Webhook, error) {
+	databaseStatement := c.database.WithContext(ctx).Table(table.Webhook{}.TableName())
+
+	if query.Enabled != nil {
+		databaseStatement = databaseStatement.Where("enabled = ?", *query.Enabled)
+	}
+
+	var values []*table.Webhook
+
+	if err := databaseStatement.Order("created_at ASC").Find(&values).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Webhook, 0, len(values))
+
+	for _, value := range values {
+		webhook, err := value.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, webhook)
+	}
+
+	return result, nil
+}
+
+// SaveWebhook creates the webhook or updates it if it already exists.
+func (c *client) SaveWebhook(ctx context.Context, webhook *model.Webhook) error {
+	var value table.Webhook
+	if err := value.Import(webhook); err != nil {
+		return err
+	}
+
+	onConflictClause := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		UpdateAll: true,
+	}
+
+	if err := c.database.WithContext(ctx).Clauses(onConflictClause).Create(&value).Error; err != nil {
+		return err
+	}
+
+	webhook.CreatedAt, webhook.UpdatedAt = value.CreatedAt, value.UpdatedAt
+
+	return nil
+}
+
+// DeleteWebhook deletes the webhook and its delivery logs.
+func (c *client) DeleteWebhook(ctx context.Context, id string) error {
+	return c.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", id).Delete(&table.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", id).Delete(&table.Webhook{}).Error
+	})
+}
+
+// SaveWebhookDeliveries creates the deliveries, the ids are set to the created deliveries.
+func (c *client) SaveWebhookDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	values := make([]table.WebhookDelivery, 0, len(deliveries))
+
+	for _, delivery := range deliveries {
+		var value table.WebhookDelivery
+		if err := value.Import(delivery); err != nil {
+			return err
+		}
+
+		values = append(values, value)
+	}
+
+	if err := c.database.WithContext(ctx).CreateInBatches(&values, math.MaxUint8).Error; err != nil {
+		return err
+	}
+
+	for index := range values {
+		deliveries[index].ID = values[index].ID
+	}
+
+	return nil
+}
+
+// UpdateWebhookDelivery updates the result of the last attempt of the delivery.
+func (c *client) UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	return c.database.WithContext(ctx).
+		Model(&table.WebhookDelivery{}).
+		Where("id = ?", delivery.ID).
+		Updates(map[string]any{
+			"status":          string(delivery.Status),
+			"attempts":        delivery.Attempts,
+			"response_status": delivery.ResponseStatus,
+			"error":           delivery.Error,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"updated_at":      time.Now(),
+		}).Error
+}
+
+// LockWebhookDeliveries locks the earliest pending deliveries that are due, the deliveries locked by other dispatchers are skipped.
+func (c *client) LockWebhookDeliveries(ctx context.Context, limit int) ([]*model.WebhookDelivery, error) {
+	var values []*table.WebhookDelivery
+
+	if err := c.database.WithContext(ctx).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+		Where("status = ? AND next_attempt_at <= ?", model.WebhookDeliveryStatusPending, time.Now()).
+		Order("id ASC").
+		Limit(limit).
+		Find(&values).Error; err != nil {
+		return nil, err
+	}
+
+	return exportWebhookDeliveries(values)
+}
+
+// FindWebhookDeliveries finds the delivery logs of a webhook, ordered from the latest, the activities are not loaded.
+func (c *client) FindWebhookDeliveries(ctx context.Context, query model.WebhookDeliveriesQuery) ([]*model.WebhookDelivery, error) {
+	databaseStatement := c.database.WithContext(ctx).
+		Table(table.WebhookDelivery{}.TableName()).
+		Omit("activities").
+		Where("webhook_id = ?", query.WebhookID)
+
+	if query.Cursor != nil {
+		databaseStatement = databaseStatement.Where("id < ?", *query.Cursor)
+	}
+
+	var values []*table.WebhookDelivery
+
+	if err := databaseStatement.Order("id DESC").Limit(query.Limit).Find(&values).Error; err != nil {
+		return nil, err
+	}
+
+	return exportWebhookDeliveries(values)
+}
+
+func exportWebhookDeliveries(values []*table.WebhookDelivery) ([]*model.WebhookDelivery, error) {
+	result := make([]*model.WebhookDelivery, 0, len(values))
+
+	for _, value := range values {
+		delivery, err := value.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, delivery)
+	}
+
+	return result, nil
+}
+
+// Dial dials a database, the data source name is in the format of go-sql-driver/mysql,
+// for example user:password@tcp(localhost:3306)/database.
+func Dial(ctx context.Context, dataSourceName string, partition bool) (database.Client, error) {
+	// Timestamps must be parsed into time.Time.
+	mysqlConfig, err := mysqlx.ParseDSN(dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("parse data source name: %w", err)
+	}
+
+	mysqlConfig.ParseTime = true
+
+	instance := client{
+		partition: partition,
+	}
+
+	logger := zapgorm2.New(zap.L())
+	logger.SetAsDefault()
+
+	config := gorm.Config{
+		Logger:                 logger,
+		SkipDefaultTransaction: true,
+	}
+
+	if instance.database, err = gorm.Open(mysql.Open(mysqlConfig.FormatDSN()), &config); err != nil {
+		return nil, err
+	}
+
+	instance.pool = instance.database
+
+	if instance.partition {
+		instance.loadPartitionTables(ctx)
+	}
+
+	return &instance, nil
+}
@@ -0,0 +1,1288 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/rss3-network/node/v2/internal/database/dialer/postgres/table"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The partition tables share the layout of PostgreSQL, one activities table per network and quarter and one indexes table per quarter.
+// The index, from and to columns are reserved words of MySQL and must be quoted in raw statements.
+
+var indexesTables sync.Map
+
+var activitiesTables sync.Map
+
+var searchTables sync.Map
+
+// createPartitionTable creates a partition table.
+func (c *client) createPartitionTable(ctx context.Context, name, template string) error {
+	var tables *sync.Map
+
+	switch template {
+	case (*table.Index).TableName(nil):
+		tables = &indexesTables
+	case (*table.Activity).TableName(nil):
+		tables = &activitiesTables
+	case (*table.ActivitySearch).TableName(nil):
+		tables = &searchTables
+	default:
+		return fmt.Errorf("unsupported partition template %s", template)
+	}
+
+	if _, exists := tables.Load(name); exists {
+		return nil
+	}
+
+	statement := fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` LIKE `%s`", name, template)
+
+	zap.L().Debug("creating partition table",
+		zap.String("statement", statement))
+
+	// The statement runs on the connection pool rather than the transaction of the client,
+	// otherwise MySQL would commit the pending statements of the transaction implicitly.
+	if err := c.pool.WithContext(ctx).Exec(statement).Error; err != nil {
+		return err
+	}
+
+	tables.Store(name, struct{}{})
+
+	return nil
+}
+
+// findPartitionTableExists check if a partition table exists.
+func (c *client) findPartitionTableExists(ctx context.Context, name string) (bool, error) {
+	var exists bool
+
+	if err := c.database.WithContext(ctx).
+		Raw("SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?)", name).
+		Scan(&exists).Error; err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// findIndexesPartitionTables finds partition table names of indexes in the past year.
+func (c *client) findIndexesPartitionTables(_ context.Context, index table.Index) []string {
+	partitionedNames := make([]string, 0)
+
+	for i := 0; i <= 4; i++ {
+		if index.Timestamp.Unix() < time.Now().AddDate(-1, 0, 0).Unix() {
+			break
+		}
+
+		if _, exists := indexesTables.Load(index.PartitionName()); exists {
+			partitionedNames = append(partitionedNames, index.PartitionName())
+		}
+
+		year := index.Timestamp.Year()
+		month := index.Timestamp.Month()
+
+		index.Timestamp = time.Date(lo.Ternary(month < 3, year-1, year), lo.Ternary(month < 3, month+9, month-3), index.Timestamp.Day(), 23, 59, 59, 1e9-1, time.Local)
+	}
+
+	return partitionedNames
+}
+
+// findActivitiesPartitionTables finds activities partition tables.
+func (c *client) findActivitiesPartitionTables(_ context.Context, query model.ActivitiesMetadataQuery) []string {
+	if query.Network == nil {
+		return nil
+	}
+
+	timestamp := time.Now()
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 && *query.EndTimestamp < uint64(time.Now().Unix()) {
+		timestamp = time.Unix(int64(lo.FromPtr(query.EndTimestamp)), 0)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp < uint64(timestamp.Unix()) {
+		timestamp = time.Unix(int64(query.Cursor.Timestamp), 0)
+	}
+
+	partitionedNames := make([]string, 0)
+
+	for i := 0; i <= 4; i++ {
+		activityTable := table.Activity{
+			Network:   lo.FromPtr(query.Network),
+			Timestamp: timestamp,
+		}
+
+		if timestamp.Unix() < time.Now().AddDate(-1, 0, 0).Unix() {
+			break
+		}
+
+		if _, exists := activitiesTables.Load(activityTable.PartitionName(nil)); exists {
+			partitionedNames = append(partitionedNames, activityTable.PartitionName(nil))
+		}
+
+		year := timestamp.Year()
+		month := timestamp.Month()
+
+		timestamp = time.Date(lo.Ternary(month < 3, year-1, year), lo.Ternary(month < 3, month+9, month-3), timestamp.Day(), 23, 59, 59, 1e9-1, time.Local)
+	}
+
+	return partitionedNames
+}
+
+// loadPartitionTables loads partition tables.
+func (c *client) loadPartitionTables(ctx context.Context) {
+	for template, tables := range map[string]*sync.Map{
+		(*table.Index).TableName(nil):          &indexesTables,
+		(*table.Activity).TableName(nil):       &activitiesTables,
+		(*table.ActivitySearch).TableName(nil): &searchTables,
+	} {
+		var result []string
+
+		if err := c.database.WithContext(ctx).
+			Table("information_schema.tables").
+			Where("table_schema = DATABASE() AND table_name LIKE ?", fmt.Sprintf("%s\\_%%", template)).
+			Pluck("table_name", &result).Error; err != nil {
+			zap.L().Error("failed to load partition tables", zap.String("template", template), zap.Error(err))
+
+			continue
+		}
+
+		for _, tableName := range result {
+			tables.Store(tableName, struct{}{})
+		}
+	}
+}
+
+// saveActivitiesPartitioned saves Activities in partitioned tables.
+func (c *client) saveActivitiesPartitioned(ctx context.Context, activities []*activityx.Activity, lowPriority bool) error {
+	partitions := make(map[string][]*activityx.Activity)
+
+	// Group activities by partition name.
+	for _, a := range activities {
+		var activityTable table.Activity
+
+		name := activityTable.PartitionName(a)
+
+		partitions[name] = append(partitions[name], a)
+	}
+
+	errorGroup, ctx := errgroup.WithContext(ctx)
+	errorGroup.SetLimit(c.maxGoroutines(-1))
+
+	// Save activities and indexes in parallel.
+	for name, activities := range partitions {
+		name, activities := name, activities
+
+		errorGroup.Go(func() error {
+			tableActivities := make(table.Activities, 0)
+
+			if err := tableActivities.Import(activities); err != nil {
+				return err
+			}
+
+			if len(tableActivities) == 0 {
+				return nil
+			}
+
+			// #nosec
+			if err := c.createPartitionTable(ctx, name, tableActivities[0].TableName()); err != nil {
+				return err
+			}
+
+			activityIDs := lo.Map(tableActivities, func(item *table.Activity, _ int) string {
+				return item.ID
+			})
+
+			// MySQL does not support conditional upserts, so activities saved by high priority workers are skipped
+			// before inserting, instead of being filtered in the ON DUPLICATE KEY UPDATE clause.
+			if lowPriority {
+				var platformActivityIDs []string
+
+				if err := c.database.WithContext(ctx).
+					Table(name).
+					Where("id IN ? AND platform IS NOT NULL AND platform <> ''", activityIDs).
+					Pluck("id", &platformActivityIDs).Error; err != nil {
+					return fmt.Errorf("find activities with platform: %w", err)
+				}
+
+				tableActivities = lo.Filter(tableActivities, func(item *table.Activity, _ int) bool {
+					return !lo.Contains(platformActivityIDs, item.ID)
+				})
+			}
+
+			if len(tableActivities) > 0 {
+				onConflict := clause.OnConflict{
+					Columns:   []clause.Column{{Name: "id"}},
+					UpdateAll: true,
+				}
+
+				if err := c.database.WithContext(ctx).
+					Table(name).
+					Clauses(onConflict).
+					CreateInBatches(tableActivities, math.MaxUint8).
+					Error; err != nil {
+					return err
+				}
+			}
+
+			// Rebuild the indexes from the saved activities, including the ones skipped above.
+			var affectedActivities table.Activities
+
+			if err := c.database.WithContext(ctx).Table(name).Where("id IN ?", activityIDs).Find(&affectedActivities).Error; err != nil {
+				return err
+			}
+
+			zap.L().Debug("successfully saved activities",
+				zap.String("partition_name", name),
+				zap.Int("affected_count", len(affectedActivities)))
+
+			if err := c.saveIndexesPartitioned(ctx, lo.Must(affectedActivities.Export())); err != nil {
+				return err
+			}
+
+			return c.saveSearchesPartitioned(ctx, lo.Must(affectedActivities.Export()))
+		})
+	}
+
+	return errorGroup.Wait()
+}
+
+// findActivityPartitioned finds an activity by id.
+func (c *client) findActivityPartitioned(ctx context.Context, query model.ActivityQuery) (*activityx.Activity, *int, error) {
+	matchedActivity, err := c.findIndexPartitioned(ctx, query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("first matchedActivity: %w", err)
+	}
+
+	if matchedActivity == nil {
+		return nil, nil, nil
+	}
+
+	activity := &table.Activity{
+		ID:        matchedActivity.ID,
+		Network:   matchedActivity.Network,
+		Timestamp: matchedActivity.Timestamp,
+	}
+
+	if err := c.database.WithContext(ctx).Table(activity.PartitionName(nil)).Where("id = ?", matchedActivity.ID).Limit(1).Find(&activity).Error; err != nil {
+		return nil, nil, fmt.Errorf("find activity: %w", err)
+	}
+
+	result, err := activity.Export(matchedActivity)
+	if err != nil {
+		return nil, nil, fmt.Errorf("export activity: %w", err)
+	}
+
+	page := math.Ceil(float64(len(activity.Actions)) / float64(query.ActionLimit))
+
+	return result, lo.ToPtr(int(page)), nil
+}
+
+// findActivitiesMetadataPartitioned finds activities metadata.
+func (c *client) findActivitiesMetadataPartitioned(ctx context.Context, query model.ActivitiesMetadataQuery) ([]*activityx.Activity, error) {
+	partitionedNames := c.findActivitiesPartitionTables(ctx, query)
+
+	if len(partitionedNames) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errorGroup, errorContext := errgroup.WithContext(ctx)
+	activities := make([]table.Activities, len(partitionedNames))
+	resultChan := make(chan int, len(partitionedNames))
+	errorChan := make(chan error)
+	stopChan := make(chan struct{})
+
+	var mutex sync.RWMutex
+
+	for partitionedIndex, partitionedName := range partitionedNames {
+		partitionedIndex, partitionedName := partitionedIndex, partitionedName
+
+		errorGroup.Go(func() error {
+			var result table.Activities
+
+			if err := c.buildFindActivitiesStatement(errorContext, partitionedName, query).Find(&result).Error; err != nil {
+				return fmt.Errorf("failed to find activities metadata: %w", err)
+			}
+
+			mutex.Lock()
+			activities[partitionedIndex] = result
+			mutex.Unlock()
+
+			select {
+			case <-stopChan:
+				return nil
+			case resultChan <- partitionedIndex:
+				return nil
+			}
+		})
+	}
+
+	go func() {
+		defer close(errorChan)
+
+		errorChan <- errorGroup.Wait()
+	}()
+
+	// The partitions are ordered from the latest to the earliest,
+	// so the result is complete once the earliest partitions needed to reach the limit have returned.
+	for {
+		select {
+		case err := <-errorChan:
+			if err != nil {
+				return nil, fmt.Errorf("failed to wait result: %w", err)
+			}
+		case <-resultChan:
+			result := make(table.Activities, 0, query.Limit)
+			flag := true
+
+			mutex.RLock()
+
+			for _, data := range activities {
+				if data == nil {
+					flag = false
+
+					break
+				}
+
+				result = append(result, data...)
+
+				if len(result) >= query.Limit {
+					close(stopChan)
+					mutex.RUnlock()
+
+					data := result[:query.Limit]
+
+					return data.Export()
+				}
+			}
+
+			mutex.RUnlock()
+
+			if flag {
+				return result.Export()
+			}
+		}
+	}
+}
+
+// findActivitiesPartitioned finds activities.
+func (c *client) findActivitiesPartitioned(ctx context.Context, query model.ActivitiesQuery) ([]*activityx.Activity, error) {
+	indexes, err := c.findIndexesPartitioned(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("find indexes: %w", err)
+	}
+
+	partition := lo.GroupBy(indexes, func(query *table.Index) string {
+		activity := table.Activity{
+			ID:        query.ID,
+			Network:   query.Network,
+			Timestamp: query.Timestamp,
+		}
+
+		return activity.PartitionName(nil)
+	})
+
+	var (
+		result = make([]*activityx.Activity, 0)
+		locker sync.Mutex
+	)
+
+	errorGroup, errorCtx := errgroup.WithContext(ctx)
+
+	for tableName, index := range partition {
+		tableName, index := tableName, index
+
+		ids := lo.Map(index, func(index *table.Index, _ int) string {
+			return index.ID
+		})
+
+		errorGroup.Go(func() error {
+			tableActivities := make(table.Activities, 0)
+
+			if err := c.database.WithContext(errorCtx).Table(tableName).Where("id IN ?", lo.Uniq(ids)).Find(&tableActivities).Error; err != nil {
+				return err
+			}
+
+			locker.Lock()
+			defer locker.Unlock()
+
+			activities, err := tableActivities.ExportByIndexes(index)
+			if err != nil {
+				return err
+			}
+
+			result = append(result, activities...)
+
+			return nil
+		})
+	}
+
+	if err := errorGroup.Wait(); err != nil {
+		return nil, err
+	}
+
+	lo.ForEach(result, func(activity *activityx.Activity, i int) {
+		result[i].Actions = lo.Slice(activity.Actions, 0, query.ActionLimit)
+	})
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Timestamp > result[j].Timestamp
+	})
+
+	return result, nil
+}
+
+// saveIndexesPartitioned saves indexes in partitioned tables.
+func (c *client) saveIndexesPartitioned(ctx context.Context, activities []*activityx.Activity) error {
+	indexes := make(table.Indexes, 0)
+
+	if err := indexes.Import(activities); err != nil {
+		return err
+	}
+
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	// #nosec
+	if err := c.createPartitionTable(ctx, indexes[0].PartitionName(), indexes[0].TableName()); err != nil {
+		return fmt.Errorf("create partition table: %w", err)
+	}
+
+	// Delete indexes with the same activity id and network.
+	pkIndexes := make(map[string][]string)
+
+	for _, index := range indexes {
+		if _, ok := pkIndexes[index.ID+index.Network.String()]; ok {
+			continue
+		}
+
+		pkIndexes[index.ID+index.Network.String()] = []string{
+			index.ID,
+			index.Network.String(),
+		}
+	}
+
+	conditions := lo.MapToSlice(pkIndexes, func(_ string, value []string) []string {
+		return value
+	})
+
+	errorPool := pool.New().WithContext(ctx).WithMaxGoroutines(c.maxGoroutines(10)).WithCancelOnError().WithFirstError()
+
+	for _, condition := range lo.Chunk(conditions, math.MaxUint8) {
+		condition := condition
+
+		errorPool.Go(func(ctx context.Context) error {
+			return c.database.WithContext(ctx).
+				Table(indexes[0].PartitionName()).
+				Where("(id, network) IN ?", condition).
+				Delete(&table.Index{}).
+				Error
+		})
+	}
+
+	if err := errorPool.Wait(); err != nil {
+		return fmt.Errorf("failed to delete indexes: %w", err)
+	}
+
+	// Save indexes, the primary key of MySQL tables is used as the conflict target.
+	onConflict := clause.OnConflict{
+		UpdateAll: true,
+	}
+
+	errorPool = pool.New().WithContext(ctx).WithMaxGoroutines(c.maxGoroutines(10)).WithCancelOnError().WithFirstError()
+
+	for _, index := range lo.Chunk(indexes, math.MaxUint8) {
+		index := index
+
+		errorPool.Go(func(ctx context.Context) error {
+			return c.database.WithContext(ctx).
+				Table(indexes[0].PartitionName()).
+				Clauses(onConflict).
+				Create(index).
+				Error
+		})
+	}
+
+	if err := errorPool.Wait(); err != nil {
+		return fmt.Errorf("failed to save indexes: %w", err)
+	}
+
+	return nil
+}
+
+// findIndexPartitioned finds an index by id.
+func (c *client) findIndexPartitioned(ctx context.Context, query model.ActivityQuery) (*table.Index, error) {
+	index := table.Index{
+		Timestamp: time.Now(),
+	}
+
+	tables := c.findIndexesPartitionTables(ctx, index)
+
+	if len(tables) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errorGroup, errorContext := errgroup.WithContext(ctx)
+	resultChan := make(chan *table.Index, len(tables))
+	errorChan := make(chan error)
+	stopChan := make(chan struct{})
+
+	for _, tableName := range tables {
+		tableName := tableName
+
+		errorGroup.Go(func() error {
+			var result table.Index
+
+			if err := c.buildFindIndexStatement(errorContext, tableName, query).Limit(1).Find(&result).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("find first index: %w", err)
+			}
+
+			select {
+			case <-stopChan:
+				return nil
+			case resultChan <- &result:
+				return nil
+			}
+		})
+	}
+
+	go func() {
+		defer close(errorChan)
+
+		errorChan <- errorGroup.Wait()
+	}()
+
+	count := 0
+
+	for {
+		select {
+		case err := <-errorChan:
+			if err != nil {
+				return nil, fmt.Errorf("failed to wait result: %w", err)
+			}
+		case data := <-resultChan:
+			count++
+
+			if data != nil && lo.IsNotEmpty(data.ID) {
+				close(stopChan)
+
+				return data, nil
+			}
+
+			if count == len(tables) {
+				return nil, nil
+			}
+		}
+	}
+}
+
+// findIndexesPartitioned finds indexes.
+func (c *client) findIndexesPartitioned(ctx context.Context, query model.ActivitiesQuery) ([]*table.Index, error) {
+	index := table.Index{
+		Timestamp: time.Now(),
+	}
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 && *query.EndTimestamp < uint64(index.Timestamp.Unix()) {
+		index.Timestamp = time.Unix(int64(lo.FromPtr(query.EndTimestamp)), 0)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp < uint64(index.Timestamp.Unix()) {
+		index.Timestamp = time.Unix(int64(query.Cursor.Timestamp), 0)
+	}
+
+	partitionedNames := c.findIndexesPartitionTables(ctx, index)
+
+	if len(partitionedNames) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errorGroup, errorContext := errgroup.WithContext(ctx)
+	indexes := make([][]*table.Index, len(partitionedNames))
+	resultChan := make(chan int, len(partitionedNames))
+	errorChan := make(chan error)
+	stopChan := make(chan struct{})
+
+	var mutex sync.RWMutex
+
+	for partitionedIndex, partitionedName := range partitionedNames {
+		partitionedIndex, partitionedName := partitionedIndex, partitionedName
+
+		errorGroup.Go(func() error {
+			var result []*table.Index
+
+			if err := c.buildFindIndexesStatement(errorContext, partitionedName, query).Find(&result).Error; err != nil {
+				return fmt.Errorf("failed to find indexes: %w", err)
+			}
+
+			mutex.Lock()
+			indexes[partitionedIndex] = result
+			mutex.Unlock()
+
+			select {
+			case <-stopChan:
+				return nil
+			case resultChan <- partitionedIndex:
+				return nil
+			}
+		})
+	}
+
+	go func() {
+		defer close(errorChan)
+
+		errorChan <- errorGroup.Wait()
+	}()
+
+	for {
+		select {
+		case err := <-errorChan:
+			if err != nil {
+				return nil, fmt.Errorf("failed to wait result: %w", err)
+			}
+		case <-resultChan:
+			result := make([]*table.Index, 0, query.Limit)
+			flag := true
+
+			mutex.RLock()
+
+			for _, data := range indexes {
+				if data == nil {
+					flag = false
+
+					break
+				}
+
+				result = append(result, data...)
+
+				if len(result) >= query.Limit {
+					close(stopChan)
+					mutex.RUnlock()
+
+					return result[:query.Limit], nil
+				}
+			}
+
+			mutex.RUnlock()
+
+			if flag {
+				return result, nil
+			}
+		}
+	}
+}
+
+// deleteExpiredActivitiesPartitioned deletes expired activities.
+func (c *client) deleteExpiredActivitiesPartitioned(ctx context.Context, network network.Network, timestamp time.Time) error {
+	var (
+		batchSize              = 1000
+		dropActivitiesTableMap = make(map[string]struct{}, 0)
+		checkTablesTimestamp   = []time.Time{timestamp}
+	)
+
+	for i := 1; i <= 4; i++ {
+		dropActivitiesTableMap[c.buildActivitiesTableNames(network, timestamp.AddDate(0, -3*i, 0))] = struct{}{}
+
+		checkTablesTimestamp = append(checkTablesTimestamp, timestamp.AddDate(0, -3*i, 0))
+	}
+
+	for _, checkTimestamp := range checkTablesTimestamp {
+		activityTable := c.buildActivitiesTableNames(network, checkTimestamp)
+
+		indexTable := c.buildIndexesTableNames(checkTimestamp)
+
+		indexTableExists, err := c.findPartitionTableExists(ctx, indexTable)
+		if err != nil {
+			return fmt.Errorf("find partition table exists: %w", err)
+		}
+
+		if !indexTableExists {
+			continue
+		}
+
+		_, dropActivity := dropActivitiesTableMap[activityTable]
+
+		zap.L().Info("beginning to delete expired activities",
+			zap.String("activity_table", activityTable),
+			zap.String("index_table", indexTable))
+
+		for {
+			done, err := c.batchDeleteExpiredActivities(ctx, network, timestamp, batchSize, &indexTable, lo.Ternary(dropActivity, nil, &activityTable))
+			if err != nil {
+				return fmt.Errorf("batch delete expired activities: %w", err)
+			}
+
+			if done {
+				break
+			}
+		}
+
+		if err := c.deleteExpiredSearchesPartitioned(ctx, network, timestamp, c.buildSearchTableNames(checkTimestamp)); err != nil {
+			return fmt.Errorf("delete expired searches: %w", err)
+		}
+
+		if dropActivity {
+			if err := c.database.WithContext(ctx).Exec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`", activityTable)).Error; err != nil {
+				return fmt.Errorf("drop table: %w", err)
+			}
+
+			activitiesTables.Delete(activityTable)
+		}
+	}
+
+	return nil
+}
+
+func (c *client) batchDeleteExpiredActivities(ctx context.Context, network network.Network, timestamp time.Time, batchSize int, indexTable *string, activityTable *string) (bool, error) {
+	databaseTransaction := c.database.WithContext(ctx).Begin()
+	defer func() {
+		_ = databaseTransaction.Rollback().Error
+	}()
+
+	var transactionIDs []string
+
+	if err := databaseTransaction.Table(*indexTable).Where("network = ? AND timestamp < ?", network.String(), timestamp).
+		Limit(batchSize).
+		Pluck("id", &transactionIDs).Error; err != nil {
+		return false, fmt.Errorf("find expired activities: %w", err)
+	}
+
+	if len(transactionIDs) == 0 {
+		return true, nil
+	}
+
+	if err := databaseTransaction.Table(*indexTable).Where("id IN ?", transactionIDs).Delete(&table.Index{}).Error; err != nil {
+		return false, fmt.Errorf("delete expired indexes: %w", err)
+	}
+
+	if activityTable != nil {
+		if err := databaseTransaction.Table(*activityTable).Where("id IN ?", transactionIDs).Delete(&table.Activity{}).Error; err != nil {
+			return false, fmt.Errorf("delete expired activities: %w", err)
+		}
+	}
+
+	if err := databaseTransaction.Commit().Error; err != nil {
+		return false, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return false, nil
+}
+
+// deleteActivitiesPartitioned deletes activities and indexes by ids from the partitioned tables since the timestamp.
+func (c *client) deleteActivitiesPartitioned(ctx context.Context, network network.Network, ids []string, since time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Iterate through every quarter from the timestamp to now, the activities may have been stored in any of them.
+	quarterStart := time.Date(since.Year(), since.Month()-(since.Month()-1)%3, 1, 0, 0, 0, 0, since.Location())
+
+	for timestamp := quarterStart; !timestamp.After(time.Now()); timestamp = timestamp.AddDate(0, 3, 0) {
+		indexTable := c.buildIndexesTableNames(timestamp)
+
+		indexTableExists, err := c.findPartitionTableExists(ctx, indexTable)
+		if err != nil {
+			return fmt.Errorf("find partition table exists: %w", err)
+		}
+
+		if indexTableExists {
+			if err := c.database.WithContext(ctx).Table(indexTable).Where("network = ? AND id IN ?", network, ids).Delete(&table.Index{}).Error; err != nil {
+				return fmt.Errorf("delete indexes: %w", err)
+			}
+		}
+
+		if _, exists := searchTables.Load(c.buildSearchTableNames(timestamp)); exists {
+			if err := c.database.WithContext(ctx).Table(c.buildSearchTableNames(timestamp)).Where("network = ? AND id IN ?", network, ids).Delete(&table.ActivitySearch{}).Error; err != nil {
+				return fmt.Errorf("delete searches: %w", err)
+			}
+		}
+
+		activityTable := c.buildActivitiesTableNames(network, timestamp)
+
+		activityTableExists, err := c.findPartitionTableExists(ctx, activityTable)
+		if err != nil {
+			return fmt.Errorf("find partition table exists: %w", err)
+		}
+
+		if activityTableExists {
+			if err := c.database.WithContext(ctx).Table(activityTable).Where("id IN ?", ids).Delete(&table.Activity{}).Error; err != nil {
+				return fmt.Errorf("delete activities: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// buildFindIndexStatement builds the query index statement.
+func (c *client) buildFindIndexStatement(ctx context.Context, partitionedName string, query model.ActivityQuery) *gorm.DB {
+	databaseStatement := c.database.WithContext(ctx).Table(partitionedName)
+
+	if query.ID != nil {
+		databaseStatement = databaseStatement.Where("id = ?", lo.FromPtr(query.ID))
+	}
+
+	if query.Network != nil {
+		databaseStatement = databaseStatement.Where("network = ?", lo.FromPtr(query.Network))
+	}
+
+	if query.Owner != nil {
+		databaseStatement = databaseStatement.Where("owner = ?", lo.FromPtr(query.Owner))
+	}
+
+	return databaseStatement
+}
+
+// buildFindIndexesStatement builds the query indexes statement.
+func (c *client) buildFindIndexesStatement(ctx context.Context, partition string, query model.ActivitiesQuery) *gorm.DB {
+	databaseStatement := c.database.WithContext(ctx).Table(partition)
+
+	if query.Distinct != nil && lo.FromPtr(query.Distinct) {
+		databaseStatement = databaseStatement.Select("DISTINCT id, timestamp, `index`, network")
+	}
+
+	if query.Owner != nil {
+		databaseStatement = databaseStatement.Where("owner = ?", query.Owner)
+	}
+
+	if len(query.Owners) > 0 {
+		databaseStatement = databaseStatement.Where("owner IN ?", query.Owners)
+	}
+
+	if query.Status != nil {
+		databaseStatement = databaseStatement.Where("status = ?", query.Status)
+	}
+
+	if query.Direction != nil {
+		databaseStatement = databaseStatement.Where("direction = ?", query.Direction)
+	}
+
+	if query.StartTimestamp != nil && *query.StartTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp >= ?", time.Unix(int64(*query.StartTimestamp), 0))
+	}
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp <= ?", time.Unix(int64(*query.EndTimestamp), 0))
+	}
+
+	if query.Platform != "" {
+		databaseStatement = databaseStatement.Where("platform = ?", query.Platform)
+	}
+
+	if len(query.Platforms) > 0 {
+		databaseStatement = databaseStatement.Where("platform IN ?", query.Platforms)
+	}
+
+	if len(query.Tags) > 0 {
+		databaseStatement = databaseStatement.Where("tag IN ?", query.Tags)
+	}
+
+	if len(query.Types) > 0 {
+		databaseStatement = databaseStatement.Where("type IN ?", query.Types)
+	}
+
+	if len(query.Network) > 0 {
+		databaseStatement = databaseStatement.Where("network IN ?", query.Network)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp < ? OR (timestamp = ? AND `index` < ?)", time.Unix(int64(query.Cursor.Timestamp), 0), time.Unix(int64(query.Cursor.Timestamp), 0), query.Cursor.Index)
+	}
+
+	return databaseStatement.Order("timestamp DESC, `index` DESC").Limit(query.Limit)
+}
+
+// buildFindActivitiesStatement builds the query activities statement.
+func (c *client) buildFindActivitiesStatement(ctx context.Context, partitionedName string, query model.ActivitiesMetadataQuery) *gorm.DB {
+	databaseStatement := c.database.WithContext(ctx).Table(partitionedName)
+
+	if query.Platform != nil {
+		databaseStatement = databaseStatement.Where("platform = ?", query.Platform)
+	}
+
+	if query.Tag != nil {
+		databaseStatement = databaseStatement.Where("tag = ?", query.Tag)
+	}
+
+	if query.Type != nil {
+		databaseStatement = databaseStatement.Where("type = ?", query.Type)
+	}
+
+	if query.StartTimestamp != nil && *query.StartTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp >= ?", time.Unix(int64(*query.StartTimestamp), 0))
+	}
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp <= ?", time.Unix(int64(*query.EndTimestamp), 0))
+	}
+
+	if query.Accounts != nil {
+		databaseStatement = databaseStatement.Where("`from` IN ? OR `to` IN ?", query.Accounts, query.Accounts)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp < ? OR (timestamp = ? AND `index` < ?)", time.Unix(int64(query.Cursor.Timestamp), 0), time.Unix(int64(query.Cursor.Timestamp), 0), query.Cursor.Index)
+	}
+
+	if query.Metadata != nil {
+		metadataSQL, err := json.Marshal([]map[string]interface{}{
+			{
+				"metadata": query.Metadata,
+			},
+		})
+		if err != nil {
+			zap.L().Error("failed to marshal metadata", zap.Error(err), zap.Any("metadata", query.Metadata))
+		} else {
+			databaseStatement = databaseStatement.Where("JSON_CONTAINS(actions, ?)", string(metadataSQL))
+		}
+	}
+
+	return databaseStatement.Order("timestamp DESC, `index` DESC").Limit(query.Limit)
+}
+
+// saveSearchesPartitioned replaces the search documents of the activities in partitioned tables,
+// the activities must be in the same quarter.
+func (c *client) saveSearchesPartitioned(ctx context.Context, activities []*activityx.Activity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	var searches table.ActivitySearches
+
+	if err := searches.Import(activities); err != nil {
+		return err
+	}
+
+	partitionedName := c.buildSearchTableNames(time.Unix(int64(activities[0].Timestamp), 0))
+
+	// The table is created even if there is no search document, so the stale documents of updated activities are deleted.
+	// #nosec
+	if err := c.createPartitionTable(ctx, partitionedName, (*table.ActivitySearch).TableName(nil)); err != nil {
+		return fmt.Errorf("create partition table: %w", err)
+	}
+
+	networkActivities := lo.GroupBy(activities, func(activity *activityx.Activity) network.Network {
+		return activity.Network
+	})
+
+	for activityNetwork, activities := range networkActivities {
+		for _, activities := range lo.Chunk(activities, math.MaxUint8) {
+			activityIDs := lo.Map(activities, func(activity *activityx.Activity, _ int) string {
+				return activity.ID
+			})
+
+			if err := c.database.WithContext(ctx).
+				Table(partitionedName).
+				Where("network = ? AND id IN ?", activityNetwork, activityIDs).
+				Delete(&table.ActivitySearch{}).
+				Error; err != nil {
+				return fmt.Errorf("delete searches: %w", err)
+			}
+		}
+	}
+
+	if len(searches) == 0 {
+		return nil
+	}
+
+	// Save searches, the primary key of MySQL tables is used as the conflict target.
+	onConflict := clause.OnConflict{
+		UpdateAll: true,
+	}
+
+	if err := c.database.WithContext(ctx).
+		Table(partitionedName).
+		Clauses(onConflict).
+		CreateInBatches(searches, math.MaxUint8).
+		Error; err != nil {
+		return fmt.Errorf("save searches: %w", err)
+	}
+
+	return nil
+}
+
+// searchActivitiesPartitioned searches activities by keyword, the partitions are searched from the latest to the earliest until the limit is reached.
+func (c *client) searchActivitiesPartitioned(ctx context.Context, query model.ActivitiesSearchQuery) ([]*activityx.Activity, error) {
+	keyword := c.buildSearchKeyword(query.Keyword)
+
+	if keyword == "" {
+		return nil, nil
+	}
+
+	timestamp := time.Now()
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 && *query.EndTimestamp < uint64(timestamp.Unix()) {
+		timestamp = time.Unix(int64(lo.FromPtr(query.EndTimestamp)), 0)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp < uint64(timestamp.Unix()) {
+		timestamp = time.Unix(int64(query.Cursor.Timestamp), 0)
+	}
+
+	indexes := make([]*table.Index, 0, query.Limit)
+
+	for i := 0; i <= 4 && len(indexes) < query.Limit; i++ {
+		if timestamp.Unix() < time.Now().AddDate(-1, 0, 0).Unix() {
+			break
+		}
+
+		partitionedName := c.buildSearchTableNames(timestamp)
+
+		if _, exists := searchTables.Load(partitionedName); exists {
+			var searches []*table.ActivitySearch
+
+			if err := c.buildSearchActivitiesStatement(ctx, partitionedName, keyword, query, query.Limit-len(indexes)).Find(&searches).Error; err != nil {
+				return nil, fmt.Errorf("search activities: %w", err)
+			}
+
+			for _, search := range searches {
+				indexes = append(indexes, search.Export())
+			}
+		}
+
+		year := timestamp.Year()
+		month := timestamp.Month()
+
+		timestamp = time.Date(lo.Ternary(month < 3, year-1, year), lo.Ternary(month < 3, month+9, month-3), timestamp.Day(), 23, 59, 59, 1e9-1, time.Local)
+	}
+
+	partition := lo.GroupBy(indexes, func(index *table.Index) string {
+		activity := table.Activity{
+			Network:   index.Network,
+			Timestamp: index.Timestamp,
+		}
+
+		return activity.PartitionName(nil)
+	})
+
+	result := make([]*activityx.Activity, 0, len(indexes))
+
+	for tableName, index := range partition {
+		ids := lo.Map(index, func(index *table.Index, _ int) string {
+			return index.ID
+		})
+
+		tableActivities := make(table.Activities, 0)
+
+		if err := c.database.WithContext(ctx).Table(tableName).Where("id IN ?", lo.Uniq(ids)).Find(&tableActivities).Error; err != nil {
+			return nil, fmt.Errorf("find activities: %w", err)
+		}
+
+		activities, err := tableActivities.ExportByIndexes(index)
+		if err != nil {
+			return nil, fmt.Errorf("export activities: %w", err)
+		}
+
+		result = append(result, activities...)
+	}
+
+	lo.ForEach(result, func(activity *activityx.Activity, i int) {
+		result[i].Actions = lo.Slice(activity.Actions, 0, query.ActionLimit)
+	})
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Timestamp == result[j].Timestamp {
+			return result[i].Index > result[j].Index
+		}
+
+		return result[i].Timestamp > result[j].Timestamp
+	})
+
+	return result, nil
+}
+
+// deleteExpiredSearchesPartitioned deletes expired search documents from the partition table.
+func (c *client) deleteExpiredSearchesPartitioned(ctx context.Context, network network.Network, timestamp time.Time, partitionedName string) error {
+	if _, exists := searchTables.Load(partitionedName); !exists {
+		return nil
+	}
+
+	return c.database.WithContext(ctx).
+		Table(partitionedName).
+		Where("network = ? AND timestamp < ?", network, timestamp).
+		Delete(&table.ActivitySearch{}).
+		Error
+}
+
+// buildSearchKeyword translates the keyword in the web search syntax of Postgres into the boolean mode query, in which
+// every word or "quoted phrase" is required, the terms joined by or are alternatives and the -prefixed terms are excluded.
+// The operators of the boolean mode are removed from the terms so that they can not change the meaning of the query.
+func (c *client) buildSearchKeyword(keyword string) string {
+	var (
+		groups   [][]string
+		excluded []string
+		or       bool
+	)
+
+	for _, term := range splitSearchTerms(keyword) {
+		if !term.quoted && strings.EqualFold(term.value, "or") {
+			or = len(groups) > 0
+
+			continue
+		}
+
+		negated := !term.quoted && strings.HasPrefix(term.value, "-")
+
+		value := strings.Join(strings.FieldsFunc(term.value, func(r rune) bool {
+			return unicode.IsSpace(r) || strings.ContainsRune(searchOperators, r)
+		}), " ")
+
+		if value == "" {
+			continue
+		}
+
+		// The words split by the operators are searched as a phrase.
+		if strings.Contains(value, " ") {
+			value = fmt.Sprintf(`"%s"`, value)
+		}
+
+		switch {
+		case negated:
+			excluded = append(excluded, fmt.Sprintf("-%s", value))
+		case or:
+			groups[len(groups)-1] = append(groups[len(groups)-1], value)
+		default:
+			groups = append(groups, []string{value})
+		}
+
+		or = false
+	}
+
+	// A query of excluded terms only matches nothing in the boolean mode.
+	if len(groups) == 0 {
+		return ""
+	}
+
+	words := make([]string, 0, len(groups)+len(excluded))
+
+	for _, group := range groups {
+		if len(group) == 1 {
+			words = append(words, fmt.Sprintf("+%s", group[0]))
+		} else {
+			words = append(words, fmt.Sprintf("+(%s)", strings.Join(group, " ")))
+		}
+	}
+
+	return strings.Join(append(words, excluded...), " ")
+}
+
+// searchOperators are the operators of the boolean mode.
+const searchOperators = `+-<>()~*"@`
+
+type searchTerm struct {
+	value  string
+	quoted bool
+}
+
+// splitSearchTerms splits the keyword into words and quoted phrases, an unclosed quote extends to the end of the keyword.
+func splitSearchTerms(keyword string) []searchTerm {
+	var (
+		terms   []searchTerm
+		builder strings.Builder
+		quoted  bool
+	)
+
+	flush := func() {
+		if builder.Len() > 0 {
+			terms = append(terms, searchTerm{value: builder.String(), quoted: quoted})
+		}
+
+		builder.Reset()
+	}
+
+	for _, r := range keyword {
+		switch {
+		case r == '"':
+			flush()
+
+			quoted = !quoted
+		case unicode.IsSpace(r) && !quoted:
+			flush()
+		default:
+			builder.WriteRune(r)
+		}
+	}
+
+	flush()
+
+	return terms
+}
+
+// buildSearchActivitiesStatement builds the search activities statement.
+func (c *client) buildSearchActivitiesStatement(ctx context.Context, partitionedName, keyword string, query model.ActivitiesSearchQuery, limit int) *gorm.DB {
+	databaseStatement := c.database.WithContext(ctx).Table(partitionedName).
+		Where("MATCH (document) AGAINST (? IN BOOLEAN MODE)", keyword)
+
+	if query.Status != nil {
+		databaseStatement = databaseStatement.Where("status = ?", query.Status)
+	}
+
+	if query.StartTimestamp != nil && *query.StartTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp >= ?", time.Unix(int64(*query.StartTimestamp), 0))
+	}
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp <= ?", time.Unix(int64(*query.EndTimestamp), 0))
+	}
+
+	if len(query.Platforms) > 0 {
+		databaseStatement = databaseStatement.Where("platform IN ?", query.Platforms)
+	}
+
+	if len(query.Tags) > 0 {
+		databaseStatement = databaseStatement.Where("tag IN ?", query.Tags)
+	}
+
+	if len(query.Types) > 0 {
+		databaseStatement = databaseStatement.Where("type IN ?", query.Types)
+	}
+
+	if len(query.Network) > 0 {
+		databaseStatement = databaseStatement.Where("network IN ?", query.Network)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp < ? OR (timestamp = ? AND `index` < ?)", time.Unix(int64(query.Cursor.Timestamp), 0), time.Unix(int64(query.Cursor.Timestamp), 0), query.Cursor.Index)
+	}
+
+	return databaseStatement.Order("timestamp DESC, `index` DESC").Limit(limit)
+}
+
+// buildSearchTableNames builds the search table names.
+func (c *client) buildSearchTableNames(timestamp time.Time) string {
+	return fmt.Sprintf("%s_%d_q%d", (*table.ActivitySearch).TableName(nil), timestamp.Year(), int(timestamp.Month()-1)/3+1)
+}
+
+// buildActivitiesTableNames builds the activities table names.
+func (c *client) buildActivitiesTableNames(network network.Network, timestamp time.Time) string {
+	return fmt.Sprintf("%s_%s_%d_q%d", (*table.Activity).TableName(nil), network, timestamp.Year(), int(timestamp.Month()-1)/3+1)
+}
+
+// buildIndexesTableNames builds the indexes table names.
+func (c *client) buildIndexesTableNames(timestamp time.Time) string {
+	return fmt.Sprintf("%s_%d_q%d", (*table.Index).TableName(nil), timestamp.Year(), int(timestamp.Month()-1)/3+1)
+}
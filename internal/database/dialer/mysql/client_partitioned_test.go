@@ -0,0 +1,68 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSearchKeyword(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		keyword string
+		want    string
+	}{
+		{
+			name:    "Words",
+			keyword: "information  layer",
+			want:    "+information +layer",
+		},
+		{
+			name:    "Operators",
+			keyword: "+open* (information) ~layer> @2",
+			want:    "+open +information +layer +2",
+		},
+		{
+			name:    "Operators Inside Words",
+			keyword: "open-information foo@bar",
+			want:    `+"open information" +"foo bar"`,
+		},
+		{
+			name:    "Quoted Phrase",
+			keyword: `"open information" layer`,
+			want:    `+"open information" +layer`,
+		},
+		{
+			name:    "Or",
+			keyword: "open or information layer",
+			want:    "+(open information) +layer",
+		},
+		{
+			name:    "Excluded",
+			keyword: "information -layer",
+			want:    "+information -layer",
+		},
+		{
+			name:    "Excluded Only",
+			keyword: "-layer or",
+			want:    "",
+		},
+		{
+			name:    "Unclosed Quote",
+			keyword: `information "open layer`,
+			want:    `+information +"open layer"`,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testcase.want, (&client{}).buildSearchKeyword(testcase.keyword))
+		})
+	}
+}
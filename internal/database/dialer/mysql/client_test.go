@@ -0,0 +1,502 @@
+package mysql_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/ory/dockertest/v3"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/dialer"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/schema/worker/decentralized"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name                        string
+		driver                      database.Driver
+		partition                   *bool
+		coreWorkerActivityCreated   []*activityx.Activity
+		otherWorkerActivityCreated  []*activityx.Activity
+		coreWorkerActivityUpdated   []*activityx.Activity
+		otherWorkerActivityUpdated  []*activityx.Activity
+		activityUpdatedLowPriority  []*activityx.Activity
+		activityUpdatedHighPriority []*activityx.Activity
+	}{
+		{
+			name:      "mysql",
+			driver:    database.DriverMySQL,
+			partition: lo.ToPtr(true),
+			coreWorkerActivityCreated: []*activityx.Activity{
+				{
+					ID:      "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+					Network: network.Ethereum,
+					From:    "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+					To:      "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+					Type:    typex.TransactionTransfer,
+					Actions: []*activityx.Action{
+						{
+							Type: typex.TransactionTransfer,
+							From: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+							To:   "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+						},
+					},
+					Timestamp: uint64(time.Now().Unix()),
+				},
+				{
+					ID:      "0xedc029f7c7acce7b72939f8bfff44c5fdc7e64e3e2ba650d195799db8fec4c90",
+					Network: network.Ethereum,
+					From:    "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+					To:      "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+					Type:    typex.TransactionTransfer,
+					Actions: []*activityx.Action{
+						{
+							Type: typex.TransactionTransfer,
+							From: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+							To:   "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+						},
+					},
+					Timestamp: uint64(time.Now().Add(-3 * 31 * 24 * time.Hour).Unix()),
+				},
+			},
+			otherWorkerActivityCreated: []*activityx.Activity{
+				{
+					ID:       "0xc5549085b553588eb6fe13878c761f3447a87df1e28ebdc9af1ff30340b764ab",
+					Network:  network.Ethereum,
+					From:     "0xd66570eDCdAde24eDda902B28F2172e9Ef8395dD",
+					To:       "0x5B93D80DA1a359340d1F339FB574bDC56763f995",
+					Platform: decentralized.PlatformUniswap.String(),
+					Type:     typex.ExchangeSwap,
+					Actions: []*activityx.Action{
+						{
+							Type: typex.ExchangeSwap,
+							From: "0xd66570eDCdAde24eDda902B28F2172e9Ef8395dD",
+							To:   "0x5B93D80DA1a359340d1F339FB574bDC56763f995",
+						},
+					},
+					Timestamp: uint64(time.Now().Add(-3 * 31 * 24 * time.Hour).Unix()),
+				},
+			},
+			coreWorkerActivityUpdated: []*activityx.Activity{
+				{
+					ID:      "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+					Network: network.Ethereum,
+					From:    "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+					To:      "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+					Type:    typex.TransactionTransfer,
+					Actions: []*activityx.Action{
+						{
+							Type: typex.TransactionTransfer,
+							From: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+							To:   "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+						},
+						{
+							Type: typex.TransactionTransfer,
+							From: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+							To:   "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+						},
+					},
+					Timestamp: uint64(time.Now().Unix()),
+				},
+			},
+			otherWorkerActivityUpdated: []*activityx.Activity{
+				{
+					ID:       "0xc5549085b553588eb6fe13878c761f3447a87df1e28ebdc9af1ff30340b764ab",
+					Network:  network.Ethereum,
+					From:     "0xd66570eDCdAde24eDda902B28F2172e9Ef8395dD",
+					To:       "0x5B93D80DA1a359340d1F339FB574bDC56763f995",
+					Platform: decentralized.PlatformUniswap.String(),
+					Type:     typex.ExchangeLiquidity,
+					Actions: []*activityx.Action{
+						{
+							Type: typex.ExchangeLiquidity,
+							From: "0xd66570eDCdAde24eDda902B28F2172e9Ef8395dD",
+							To:   "0x5B93D80DA1a359340d1F339FB574bDC56763f995",
+						},
+					},
+					Timestamp: uint64(time.Now().Add(-3 * 31 * 24 * time.Hour).Unix()),
+				},
+			},
+			activityUpdatedLowPriority: []*activityx.Activity{
+				{
+					ID:      "0xc5549085b553588eb6fe13878c761f3447a87df1e28ebdc9af1ff30340b764ab",
+					Network: network.Ethereum,
+					From:    "0xd66570eDCdAde24eDda902B28F2172e9Ef8395dD",
+					To:      "0x5B93D80DA1a359340d1F339FB574bDC56763f995",
+					Type:    typex.TransactionApproval,
+					Actions: []*activityx.Action{
+						{
+							Type: typex.TransactionTransfer,
+							From: "0x69Ed24795649c23F9C13BFE317432fe0e679f1F6",
+							To:   "0x5B93D80DA1a359340d1F339FB574bDC56763f995",
+						},
+						{
+							Type: typex.TransactionTransfer,
+							From: "0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640",
+							To:   "0x2cC846fFf0b08FB3bFfaD71f53a60B4b6E6d6482",
+						},
+					},
+					Timestamp: uint64(time.Now().Add(-3 * 31 * 24 * time.Hour).Unix()),
+				},
+			},
+			activityUpdatedHighPriority: []*activityx.Activity{
+				{
+					ID:       "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+					Network:  network.Ethereum,
+					From:     "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+					To:       "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+					Platform: decentralized.Platform1Inch.String(),
+					Type:     typex.ExchangeSwap,
+					Actions: []*activityx.Action{
+						{
+							Type: typex.ExchangeSwap,
+							From: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+							To:   "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+						},
+					},
+					Timestamp: uint64(time.Now().Unix()),
+				},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			pool, container, dataSourceName, err := createContainer(context.Background(), testcase.driver, *testcase.partition)
+			require.NoError(t, err)
+
+			t.Cleanup(func() {
+				require.NoError(t, pool.Purge(container))
+			})
+
+			// Dial the database.
+			client, err := dialer.Dial(context.Background(), &config.Database{
+				Driver: testcase.driver,
+				URI:    dataSourceName,
+			})
+
+			require.NoError(t, err)
+			require.NotNil(t, client)
+
+			// Migrate the database.
+			require.NoError(t, client.Migrate(context.Background()))
+
+			// Begin a transaction.
+			require.NoError(t, client.SaveActivities(context.Background(), testcase.coreWorkerActivityCreated, true))
+			require.NoError(t, client.SaveActivities(context.Background(), testcase.otherWorkerActivityCreated, false))
+
+			// Query first activity
+			for _, activity := range append(testcase.coreWorkerActivityCreated, testcase.otherWorkerActivityCreated...) {
+				data, page, err := client.FindActivity(context.Background(), model.ActivityQuery{ID: lo.ToPtr(activity.ID), ActionLimit: 10})
+				require.NoError(t, err)
+				require.NotNil(t, data)
+				require.Greater(t, lo.FromPtr(page), 0)
+				require.Equal(t, data.ID, activity.ID)
+				require.Equal(t, data.From, activity.From)
+				require.Equal(t, data.To, activity.To)
+				require.Equal(t, data.Platform, activity.Platform)
+			}
+
+			// Query activities by account.
+			accounts := make(map[string]int)
+
+			for _, activity := range append(testcase.coreWorkerActivityCreated, testcase.otherWorkerActivityCreated...) {
+				accounts[activity.From]++
+				accounts[activity.To]++
+			}
+
+			for account, count := range accounts {
+				activities, err := client.FindActivities(context.Background(), model.ActivitiesQuery{Owner: &account, Limit: 100})
+				require.NoError(t, err)
+				require.Len(t, activities, count)
+			}
+
+			// Update activities.
+			require.NoError(t, client.SaveActivities(context.Background(), testcase.coreWorkerActivityUpdated, true))
+			require.NoError(t, client.SaveActivities(context.Background(), testcase.otherWorkerActivityUpdated, false))
+
+			// Query updated activities.
+			for _, activity := range append(testcase.coreWorkerActivityUpdated, testcase.otherWorkerActivityUpdated...) {
+				data, page, err := client.FindActivity(context.Background(), model.ActivityQuery{ID: lo.ToPtr(activity.ID), ActionLimit: 10})
+				require.NoError(t, err)
+				require.NotNil(t, data)
+				require.Greater(t, lo.FromPtr(page), 0)
+				require.Equal(t, data.ID, activity.ID)
+				require.Equal(t, data.From, activity.From)
+				require.Equal(t, data.To, activity.To)
+				require.Equal(t, data.Platform, activity.Platform)
+				require.Equal(t, data.Type, activity.Type)
+			}
+
+			// Update activities with low priority.
+			require.NoError(t, client.SaveActivities(context.Background(), testcase.activityUpdatedLowPriority, true))
+
+			// Query updated activities with low priority.
+			for _, activity := range testcase.activityUpdatedLowPriority {
+				data, page, err := client.FindActivity(context.Background(), model.ActivityQuery{ID: lo.ToPtr(activity.ID), ActionLimit: 10})
+				require.NoError(t, err)
+				require.NotNil(t, data)
+				require.Greater(t, lo.FromPtr(page), 0)
+				require.NotEqual(t, data.Platform, activity.Platform)
+				require.NotEqual(t, data.Type, activity.Type)
+			}
+
+			// Check if the activities are not updated.
+			shouldNotExist := []string{
+				"0x69Ed24795649c23F9C13BFE317432fe0e679f1F6",
+				"0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640",
+				"0x2cC846fFf0b08FB3bFfaD71f53a60B4b6E6d6482",
+			}
+
+			for _, address := range shouldNotExist {
+				addressTemp := address
+				activities, err := client.FindActivities(context.Background(), model.ActivitiesQuery{Owner: &addressTemp, Limit: 100})
+				require.NoError(t, err)
+				require.Len(t, activities, 0)
+			}
+
+			// Update activities with high priority.
+			require.NoError(t, client.SaveActivities(context.Background(), testcase.activityUpdatedHighPriority, false))
+
+			// Query updated activities with low priority.
+			for _, activity := range testcase.activityUpdatedHighPriority {
+				data, page, err := client.FindActivity(context.Background(), model.ActivityQuery{ID: lo.ToPtr(activity.ID), ActionLimit: 10})
+				require.NoError(t, err)
+				require.NotNil(t, data)
+				require.Greater(t, lo.FromPtr(page), 0)
+				require.Equal(t, data.Platform, activity.Platform)
+				require.Equal(t, data.Type, activity.Type)
+			}
+
+			// Delete activities of orphaned blocks.
+			orphanedActivityIDs := lo.Map(testcase.otherWorkerActivityCreated, func(activity *activityx.Activity, _ int) string {
+				return activity.ID
+			})
+
+			require.NoError(t, client.DeleteActivities(context.Background(), network.Ethereum, orphanedActivityIDs, time.Now().Add(-4*31*24*time.Hour)))
+
+			// Query deleted activities.
+			for _, id := range orphanedActivityIDs {
+				data, _, err := client.FindActivity(context.Background(), model.ActivityQuery{ID: lo.ToPtr(id), ActionLimit: 10})
+				require.NoError(t, err)
+				require.Nil(t, data)
+			}
+
+			// Search activities by the words of their content.
+			post := activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000002",
+				Network:  network.Farcaster,
+				From:     "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+				To:       "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+				Platform: decentralized.PlatformFarcaster.String(),
+				Type:     typex.SocialPost,
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialPost,
+						From:     "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+						To:       "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+						Platform: decentralized.PlatformFarcaster.String(),
+						Metadata: &metadata.SocialPost{
+							Handle: "vitalik.eth",
+							Body:   "Open Information Layer",
+						},
+					},
+				},
+				Timestamp: uint64(time.Now().Unix()),
+			}
+
+			require.NoError(t, client.SaveActivities(context.Background(), []*activityx.Activity{&post}, false))
+
+			activities, err := client.SearchActivities(context.Background(), model.ActivitiesSearchQuery{Keyword: "information layer", Limit: 10, ActionLimit: 10})
+			require.NoError(t, err)
+			require.Len(t, activities, 1)
+			require.Equal(t, post.ID, activities[0].ID)
+
+			activities, err = client.SearchActivities(context.Background(), model.ActivitiesSearchQuery{Keyword: "information swap", Limit: 10, ActionLimit: 10})
+			require.NoError(t, err)
+			require.Len(t, activities, 0)
+
+			// Save dead letters, the attempts are increased when the task fails again.
+			deadLetter := model.DeadLetter{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000001",
+				Network:  network.Ethereum,
+				Worker:   "core",
+				Error:    "unsupported transaction",
+				Payload:  json.RawMessage(`{}`),
+				Attempts: 1,
+			}
+
+			require.NoError(t, client.SaveDeadLetters(context.Background(), []*model.DeadLetter{&deadLetter}))
+			require.NoError(t, client.SaveDeadLetters(context.Background(), []*model.DeadLetter{&deadLetter}))
+
+			deadLetters, err := client.FindDeadLetters(context.Background(), model.DeadLettersQuery{Network: lo.ToPtr(network.Ethereum), Limit: 10})
+			require.NoError(t, err)
+			require.Len(t, deadLetters, 1)
+			require.Equal(t, 2, deadLetters[0].Attempts)
+
+			// The dead letters of the same task are paginated by their primary keys.
+			otherDeadLetter := deadLetter
+			otherDeadLetter.Worker = "uniswap"
+
+			require.NoError(t, client.SaveDeadLetters(context.Background(), []*model.DeadLetter{&otherDeadLetter}))
+
+			deadLetters, err = client.FindDeadLetters(context.Background(), model.DeadLettersQuery{Network: lo.ToPtr(network.Ethereum), Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, deadLetters, 1)
+
+			deadLetters, err = client.FindDeadLetters(context.Background(), model.DeadLettersQuery{
+				Network: lo.ToPtr(network.Ethereum),
+				Cursor:  &model.DeadLetterCursor{ID: deadLetters[0].ID, Network: deadLetters[0].Network, Worker: deadLetters[0].Worker},
+				Limit:   1,
+			})
+			require.NoError(t, err)
+			require.Len(t, deadLetters, 1)
+
+			_, err = client.FindDeadLetters(context.Background(), model.DeadLettersQuery{
+				Cursor: &model.DeadLetterCursor{ID: deadLetter.ID, Network: network.Ethereum, Worker: "unknown"},
+				Limit:  1,
+			})
+			require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+			require.NoError(t, client.DeleteDeadLetter(context.Background(), &otherDeadLetter))
+
+			// Delete the dead letter once it has been replayed.
+			require.NoError(t, client.DeleteDeadLetter(context.Background(), &deadLetter))
+
+			deadLetters, err = client.FindDeadLetters(context.Background(), model.DeadLettersQuery{Network: lo.ToPtr(network.Ethereum), Limit: 10})
+			require.NoError(t, err)
+			require.Empty(t, deadLetters)
+
+			// Save a stream outbox and relay it in a transaction.
+			require.NoError(t, client.SaveStreamOutbox(context.Background(), &model.StreamOutbox{Activities: testcase.coreWorkerActivityCreated}))
+
+			err = client.WithTransaction(context.Background(), func(ctx context.Context, client database.Client) error {
+				outboxes, err := client.LockStreamOutboxes(ctx, 10)
+				require.NoError(t, err)
+				require.Len(t, outboxes, 1)
+				require.Len(t, outboxes[0].Activities, len(testcase.coreWorkerActivityCreated))
+
+				return client.DeleteStreamOutboxes(ctx, []uint64{outboxes[0].ID})
+			})
+			require.NoError(t, err)
+
+			// Save a webhook and a delivery of the created activities, and record the result of the attempt.
+			webhook := model.Webhook{
+				ID:      "webhook",
+				URL:     "https://example.com/webhook",
+				Secret:  "secret",
+				Filter:  model.WebhookFilter{Network: []network.Network{network.Ethereum}},
+				Enabled: true,
+			}
+
+			require.NoError(t, client.SaveWebhook(context.Background(), &webhook))
+
+			webhooks, err := client.FindWebhooks(context.Background(), model.WebhooksQuery{Enabled: lo.ToPtr(true)})
+			require.NoError(t, err)
+			require.Len(t, webhooks, 1)
+			require.Equal(t, webhook.Filter, webhooks[0].Filter)
+
+			delivery := model.WebhookDelivery{
+				WebhookID:     webhook.ID,
+				Activities:    testcase.coreWorkerActivityCreated,
+				Status:        model.WebhookDeliveryStatusPending,
+				NextAttemptAt: time.Now().Add(-time.Second),
+			}
+
+			require.NoError(t, client.SaveWebhookDeliveries(context.Background(), []*model.WebhookDelivery{&delivery}))
+			require.NotZero(t, delivery.ID)
+
+			err = client.WithTransaction(context.Background(), func(ctx context.Context, client database.Client) error {
+				deliveries, err := client.LockWebhookDeliveries(ctx, 10)
+				require.NoError(t, err)
+				require.Len(t, deliveries, 1)
+				require.Len(t, deliveries[0].Activities, len(testcase.coreWorkerActivityCreated))
+
+				deliveries[0].Status = model.WebhookDeliveryStatusDelivered
+				deliveries[0].Attempts++
+				deliveries[0].ResponseStatus = 200
+
+				return client.UpdateWebhookDelivery(ctx, deliveries[0])
+			})
+			require.NoError(t, err)
+
+			deliveries, err := client.FindWebhookDeliveries(context.Background(), model.WebhookDeliveriesQuery{WebhookID: webhook.ID, Limit: 10})
+			require.NoError(t, err)
+			require.Len(t, deliveries, 1)
+			require.Equal(t, model.WebhookDeliveryStatusDelivered, deliveries[0].Status)
+			require.Empty(t, deliveries[0].Activities)
+
+			// Delete the webhook along with its delivery logs.
+			require.NoError(t, client.DeleteWebhook(context.Background(), webhook.ID))
+
+			loadedWebhook, err := client.LoadWebhook(context.Background(), webhook.ID)
+			require.NoError(t, err)
+			require.Nil(t, loadedWebhook)
+		})
+	}
+}
+
+func createContainer(_ context.Context, driver database.Driver, _ bool) (pool *dockertest.Pool, container *dockertest.Resource, dataSourceName string, err error) {
+	switch driver {
+	case database.DriverMySQL:
+		if pool, err = dockertest.NewPool(""); err != nil {
+			return nil, nil, "", fmt.Errorf("create docker pool: %w", err)
+		}
+
+		container, err = pool.Run("mysql", "8.0", []string{
+			"MYSQL_ROOT_PASSWORD=password",
+			"MYSQL_DATABASE=test",
+		})
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("create mysql container: %w", err)
+		}
+
+		dataSourceName = formatContainerURI(container)
+
+		// Wait until the server accepts connections.
+		if err := pool.Retry(func() error {
+			connector, err := sql.Open("mysql", dataSourceName)
+			if err != nil {
+				return err
+			}
+
+			defer lo.Try(connector.Close)
+
+			return connector.Ping()
+		}); err != nil {
+			return nil, nil, "", fmt.Errorf("wait for mysql container: %w", err)
+		}
+
+		return pool, container, dataSourceName, nil
+	default:
+		return nil, nil, "", fmt.Errorf("unsupported driver: %s", driver)
+	}
+}
+
+func formatContainerURI(container *dockertest.Resource) string {
+	return fmt.Sprintf(
+		"root:password@tcp(%s:%s)/%s",
+		"127.0.0.1",
+		container.GetPort("3306/tcp"),
+		"test",
+	)
+}
@@ -0,0 +1,149 @@
+-- +goose Up
+-- JSON documents are stored in longtext columns, because MySQL refuses to convert binary parameters to the json type.
+CREATE TABLE IF NOT EXISTS `indexes`
+(
+    `id`         varchar(255) NOT NULL,
+    `owner`      varchar(255) NOT NULL,
+    `network`    varchar(64)  NOT NULL,
+    `index`      int          NOT NULL,
+    `platform`   varchar(64),
+    `tag`        varchar(64)  NOT NULL,
+    `type`       varchar(64)  NOT NULL,
+    `status`     bool         NOT NULL,
+    `direction`  int          NOT NULL,
+    `timestamp`  datetime(6)  NOT NULL,
+    `created_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_indexes` PRIMARY KEY (`id`, `network`, `owner`),
+    INDEX `idx_indexes_platform` (`platform`, `timestamp` DESC, `index` DESC),
+    INDEX `idx_indexes_filter` (`tag`, `timestamp` DESC, `index` DESC, `type`),
+    INDEX `idx_indexes_network` (`network`, `timestamp` DESC, `index` DESC),
+    INDEX `idx_indexes_owner` (`owner`, `timestamp` DESC, `index` DESC, `direction`),
+    INDEX `idx_indexes_timestamp` (`timestamp` DESC, `index` DESC)
+);
+
+CREATE TABLE IF NOT EXISTS `activities`
+(
+    `id`            varchar(255) NOT NULL,
+    `network`       varchar(64)  NOT NULL,
+    `platform`      varchar(64),
+    `index`         int          NOT NULL,
+    `from`          varchar(255) NOT NULL,
+    `to`            varchar(255) NOT NULL,
+    `tag`           varchar(64)  NOT NULL,
+    `type`          varchar(64)  NOT NULL,
+    `status`        bool         NOT NULL,
+    `actions`       longtext     NOT NULL,
+    `total_actions` bigint       NOT NULL,
+    `fee`           longtext,
+    `calldata`      longtext,
+    `timestamp`     datetime(6)  NOT NULL,
+    `created_at`    datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at`    datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_activities` PRIMARY KEY (`id`),
+    INDEX `idx_activities_platform` (`platform`),
+    INDEX `idx_activities_timestamp` (`timestamp` DESC),
+    INDEX `idx_activities_tag_type` (`tag`, `type`),
+    INDEX `idx_activities_total_actions` (`total_actions`)
+);
+
+CREATE TABLE IF NOT EXISTS `checkpoints`
+(
+    `id`          varchar(255) NOT NULL,
+    `network`     varchar(64)  NOT NULL,
+    `worker`      varchar(64)  NOT NULL,
+    `state`       longtext     NOT NULL,
+    `index_count` bigint       NOT NULL DEFAULT 0,
+    `created_at`  datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at`  datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_checkpoints` PRIMARY KEY (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `dataset_mirror_posts`
+(
+    `id`                    varchar(255) NOT NULL,
+    `origin_content_digest` varchar(255) NOT NULL,
+
+    CONSTRAINT `pk_dataset_mirror_posts` PRIMARY KEY (`id`),
+    INDEX `idx_origin_content_digest` (`origin_content_digest`)
+);
+
+CREATE TABLE IF NOT EXISTS `dataset_farcaster_profiles`
+(
+    `fid`             bigint NOT NULL,
+    `username`        varchar(255),
+    `custody_address` varchar(255),
+    `eth_addresses`   longtext,
+
+    CONSTRAINT `pk_dataset_farcaster_profiles` PRIMARY KEY (`fid`)
+);
+
+CREATE TABLE IF NOT EXISTS `dataset_ens_namehashes`
+(
+    `hash` binary(32) NOT NULL,
+    `name` varchar(255) NOT NULL,
+
+    CONSTRAINT `pk_dataset_ens_namehashs` PRIMARY KEY (`hash`),
+    INDEX `idx_ensnamehash_name` (`name`)
+);
+
+CREATE TABLE IF NOT EXISTS `dataset_mastodon_update_handles`
+(
+    `handle`     varchar(255) NOT NULL,
+    `created_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_dataset_mastodon_update_handles` PRIMARY KEY (`handle`),
+    INDEX `idx_mastodon_update_handles_time_at` (`updated_at` DESC, `created_at` DESC)
+);
+
+CREATE TABLE IF NOT EXISTS `dataset_bluesky_profiles`
+(
+    `did`        varchar(255) NOT NULL,
+    `handle`     varchar(255),
+    `created_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_dataset_bluesky_profiles` PRIMARY KEY (`did`),
+    INDEX `idx_dataset_bluesky_profiles_cursor` (`updated_at` DESC, `created_at` DESC),
+    INDEX `idx_dataset_bluesky_profiles_handle` (`handle`)
+);
+
+CREATE TABLE IF NOT EXISTS `dead_letters`
+(
+    `id`         varchar(255) NOT NULL,
+    `network`    varchar(64)  NOT NULL,
+    `worker`     varchar(64)  NOT NULL,
+    `error`      text         NOT NULL,
+    `payload`    longtext     NOT NULL,
+    `attempts`   int          NOT NULL DEFAULT 1,
+    `created_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_dead_letters` PRIMARY KEY (`id`, `network`, `worker`),
+    INDEX `idx_dead_letters_cursor` (`created_at` DESC, `id` DESC)
+);
+
+CREATE TABLE IF NOT EXISTS `stream_outbox`
+(
+    `id`         bigint unsigned NOT NULL AUTO_INCREMENT,
+    `activities` longtext        NOT NULL,
+    `created_at` datetime(6)     NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_stream_outbox` PRIMARY KEY (`id`)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS `stream_outbox`;
+DROP TABLE IF EXISTS `dead_letters`;
+DROP TABLE IF EXISTS `dataset_bluesky_profiles`;
+DROP TABLE IF EXISTS `dataset_mastodon_update_handles`;
+DROP TABLE IF EXISTS `dataset_ens_namehashes`;
+DROP TABLE IF EXISTS `dataset_farcaster_profiles`;
+DROP TABLE IF EXISTS `dataset_mirror_posts`;
+DROP TABLE IF EXISTS `checkpoints`;
+DROP TABLE IF EXISTS `activities`;
+DROP TABLE IF EXISTS `indexes`;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `activity_search`
+(
+    `id`         varchar(255) NOT NULL,
+    `network`    varchar(64)  NOT NULL,
+    `platform`   varchar(64),
+    `index`      int          NOT NULL,
+    `tag`        varchar(64)  NOT NULL,
+    `type`       varchar(64)  NOT NULL,
+    `status`     bool         NOT NULL,
+    `document`   mediumtext   NOT NULL,
+    `timestamp`  datetime(6)  NOT NULL,
+    `created_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_activity_search` PRIMARY KEY (`id`, `network`),
+    FULLTEXT INDEX `idx_activity_search_document` (`document`),
+    INDEX `idx_activity_search_timestamp` (`timestamp` DESC, `index` DESC)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS `activity_search`;
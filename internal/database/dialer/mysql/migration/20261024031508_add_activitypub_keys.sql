@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `dataset_activitypub_keys`
+(
+    `id`          varchar(255) NOT NULL,
+    `private_key` text         NOT NULL,
+    `created_at`  datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at`  datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_dataset_activitypub_keys` PRIMARY KEY (`id`)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS `dataset_activitypub_keys`;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `dataset_bluesky_graphs`
+(
+    `uri`        varchar(512) NOT NULL,
+    `did`        varchar(255) NOT NULL,
+    `subject`    varchar(255) NOT NULL,
+    `kind`       varchar(64)  NOT NULL,
+    `list`       varchar(512) NOT NULL DEFAULT '',
+    `created_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_dataset_bluesky_graphs` PRIMARY KEY (`uri`),
+    INDEX `idx_dataset_bluesky_graphs_did` (`did`, `kind`, `created_at` DESC, `uri` DESC),
+    INDEX `idx_dataset_bluesky_graphs_subject` (`subject`, `kind`, `created_at` DESC, `uri` DESC)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS `dataset_bluesky_graphs`;
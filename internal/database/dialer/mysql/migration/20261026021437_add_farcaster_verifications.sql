@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `dataset_farcaster_verifications`
+(
+    `fid`     bigint       NOT NULL,
+    `address` varchar(255) NOT NULL,
+
+    CONSTRAINT `pk_dataset_farcaster_verifications` PRIMARY KEY (`address`, `fid`),
+    INDEX `idx_dataset_farcaster_verifications_fid` (`fid`)
+);
+
+INSERT IGNORE INTO `dataset_farcaster_verifications` (`fid`, `address`)
+SELECT `dataset_farcaster_profiles`.`fid`, `addresses`.`address`
+FROM `dataset_farcaster_profiles`,
+     JSON_TABLE(`dataset_farcaster_profiles`.`eth_addresses`, '$[*]' COLUMNS (`address` varchar(255) PATH '$')) AS `addresses`
+WHERE JSON_VALID(`dataset_farcaster_profiles`.`eth_addresses`);
+
+-- +goose Down
+DROP TABLE IF EXISTS `dataset_farcaster_verifications`;
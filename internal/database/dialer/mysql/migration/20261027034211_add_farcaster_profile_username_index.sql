@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX `idx_dataset_farcaster_profiles_username` ON `dataset_farcaster_profiles` (`username`);
+
+-- +goose Down
+DROP INDEX `idx_dataset_farcaster_profiles_username` ON `dataset_farcaster_profiles`;
@@ -0,0 +1,36 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `webhooks`
+(
+    `id`         varchar(255) NOT NULL,
+    `url`        text         NOT NULL,
+    `secret`     varchar(255) NOT NULL,
+    `filter`     longtext     NOT NULL,
+    `enabled`    boolean      NOT NULL DEFAULT TRUE,
+    `failures`   int          NOT NULL DEFAULT 0,
+    `created_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at` datetime(6)  NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_webhooks` PRIMARY KEY (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `webhook_deliveries`
+(
+    `id`              bigint unsigned NOT NULL AUTO_INCREMENT,
+    `webhook_id`      varchar(255)    NOT NULL,
+    `activities`      longtext        NOT NULL,
+    `status`          varchar(32)     NOT NULL,
+    `attempts`        int             NOT NULL DEFAULT 0,
+    `response_status` int             NOT NULL DEFAULT 0,
+    `error`           text            NOT NULL,
+    `next_attempt_at` datetime(6)     NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `created_at`      datetime(6)     NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    `updated_at`      datetime(6)     NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+
+    CONSTRAINT `pk_webhook_deliveries` PRIMARY KEY (`id`),
+    INDEX `idx_webhook_deliveries_status_next_attempt_at` (`status`, `next_attempt_at`),
+    INDEX `idx_webhook_deliveries_webhook_id_id` (`webhook_id`, `id`)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS `webhook_deliveries`;
+DROP TABLE IF EXISTS `webhooks`;
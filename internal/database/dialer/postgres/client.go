@@ -21,6 +21,7 @@ import (
 	mirror_model "github.com/rss3-network/node/v2/internal/engine/worker/decentralized/contract/mirror/model"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
 	networkx "github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -43,6 +44,18 @@ var migrationFS embed.FS
 type client struct {
 	partition bool
 	database  *gorm.DB
+	// transaction is true if the client is bound to a transaction,
+	// statements of a transaction run on a single connection and must not be executed concurrently.
+	transaction bool
+}
+
+// maxGoroutines returns the number of goroutines allowed to execute statements concurrently.
+func (c *client) maxGoroutines(n int) int {
+	if c.transaction {
+		return 1
+	}
+
+	return n
 }
 
 // Migrate migrates the database.
@@ -107,8 +120,9 @@ func (c *client) Begin(ctx context.Context, transactionOptions ...*sql.TxOptions
 	}
 
 	return &client{
-		partition: c.partition,
-		database:  transaction,
+		partition:   c.partition,
+		database:    transaction,
+		transaction: true,
 	}, nil
 }
 
@@ -340,6 +354,15 @@ func (c *client) FindActivitiesMetadata(ctx context.Context, query model.Activit
 	return nil, fmt.Errorf("not implemented")
 }
 
+// SearchActivities finds Activities by the keyword in their content.
+func (c *client) SearchActivities(ctx context.Context, query model.ActivitiesSearchQuery) ([]*activityx.Activity, error) {
+	if c.partition {
+		return c.searchActivitiesPartitioned(ctx, query)
+	}
+
+	return nil, fmt.Errorf("not implemented")
+}
+
 // DeleteExpiredActivities deletes expired activities.
 func (c *client) DeleteExpiredActivities(ctx context.Context, network networkx.Network, timestamp time.Time) error {
 	if c.partition {
@@ -349,6 +372,40 @@ func (c *client) DeleteExpiredActivities(ctx context.Context, network networkx.N
 	return fmt.Errorf("not implemented")
 }
 
+// DeleteActivities deletes activities and their indexes by ids, which were stored since the timestamp.
+func (c *client) DeleteActivities(ctx context.Context, network networkx.Network, ids []string, since time.Time) error {
+	if c.partition {
+		return c.deleteActivitiesPartitioned(ctx, network, ids, since)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := c.database.WithContext(ctx).
+		Where("network = ? AND id IN ? AND timestamp >= ?", network, ids, since.UTC()).
+		Delete(&table.Index{}).
+		Error; err != nil {
+		return fmt.Errorf("delete indexes: %w", err)
+	}
+
+	if err := c.database.WithContext(ctx).
+		Where("network = ? AND id IN ? AND timestamp >= ?", network, ids, since.UTC()).
+		Delete(&table.ActivitySearch{}).
+		Error; err != nil {
+		return fmt.Errorf("delete searches: %w", err)
+	}
+
+	if err := c.database.WithContext(ctx).
+		Where("network = ? AND id IN ? AND timestamp >= ?", network, ids, since.UTC()).
+		Delete(&table.Activity{}).
+		Error; err != nil {
+		return fmt.Errorf("delete activities: %w", err)
+	}
+
+	return nil
+}
+
 // LoadDatasetFarcasterProfile loads a profile.
 func (c *client) LoadDatasetFarcasterProfile(ctx context.Context, fid int64) (*model.Profile, error) {
 	var value table.DatasetFarcasterProfile
@@ -370,7 +427,57 @@ func (c *client) LoadDatasetFarcasterProfile(ctx context.Context, fid int64) (*m
 	return value.Export()
 }
 
-// SaveDatasetFarcasterProfile saves a profile.
+// LoadDatasetFarcasterProfileByUsername loads the profile of a username, nil is returned if it is not found.
+func (c *client) LoadDatasetFarcasterProfileByUsername(ctx context.Context, username string) (*model.Profile, error) {
+	var value table.DatasetFarcasterProfile
+
+	if err := c.database.WithContext(ctx).
+		Where("username = ?", username).
+		Order("fid").
+		First(&value).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// LoadDatasetFarcasterProfilesByAddress loads the profiles that have verified an address.
+func (c *client) LoadDatasetFarcasterProfilesByAddress(ctx context.Context, address string) ([]*model.Profile, error) {
+	verifications := c.database.
+		Table(table.DatasetFarcasterVerification{}.TableName()).
+		Select("fid").
+		Where("address = ?", address)
+
+	var values []*table.DatasetFarcasterProfile
+
+	if err := c.database.WithContext(ctx).
+		Where("fid IN (?)", verifications).
+		Order("fid").
+		Find(&values).
+		Error; err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*model.Profile, 0, len(values))
+
+	for _, value := range values {
+		profile, err := value.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+// SaveDatasetFarcasterProfile saves a profile, and replaces the addresses verified by the profile.
 func (c *client) SaveDatasetFarcasterProfile(ctx context.Context, profile *model.Profile) error {
 	clauses := []clause.Expression{
 		clause.OnConflict{
@@ -384,7 +491,32 @@ func (c *client) SaveDatasetFarcasterProfile(ctx context.Context, profile *model
 		return err
 	}
 
-	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
+	verifications := lo.Map(lo.Uniq(profile.EthAddresses), func(address string, _ int) *table.DatasetFarcasterVerification {
+		return &table.DatasetFarcasterVerification{
+			Fid:     profile.Fid,
+			Address: address,
+		}
+	})
+
+	return c.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clauses...).Create(&value).Error; err != nil {
+			return fmt.Errorf("save profile: %w", err)
+		}
+
+		if err := tx.Where("fid = ?", profile.Fid).Delete(&table.DatasetFarcasterVerification{}).Error; err != nil {
+			return fmt.Errorf("delete verifications: %w", err)
+		}
+
+		if len(verifications) == 0 {
+			return nil
+		}
+
+		if err := tx.Create(verifications).Error; err != nil {
+			return fmt.Errorf("save verifications: %w", err)
+		}
+
+		return nil
+	})
 }
 
 // LoadDatasetENSNamehash accepts an ENS namehash, and response with a record containing its original name string
@@ -419,6 +551,41 @@ func (c *client) SaveDatasetENSNamehash(ctx context.Context, namehash *model.ENS
 	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
 }
 
+// LoadDatasetActivityPubKey loads the key pair of the ActivityPub actor, it returns nil if the key pair has not been created.
+func (c *client) LoadDatasetActivityPubKey(ctx context.Context, id string) (*model.ActivityPubKey, error) {
+	var value table.DatasetActivityPubKey
+
+	if err := c.database.WithContext(ctx).
+		Where("id = ?", id).
+		First(&value).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// SaveDatasetActivityPubKey saves the key pair of the ActivityPub actor.
+func (c *client) SaveDatasetActivityPubKey(ctx context.Context, key *model.ActivityPubKey) error {
+	clauses := []clause.Expression{
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			UpdateAll: true,
+		},
+	}
+
+	var value table.DatasetActivityPubKey
+	if err := value.Import(key); err != nil {
+		return err
+	}
+
+	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
+}
+
 func (c *client) SaveRecentMastodonHandles(ctx context.Context, handles []*model.MastodonHandle) error {
 	// build the mastodon update handle table
 	values := make([]table.DatasetMastodonUpdateHandle, 0, len(handles))
@@ -561,6 +728,387 @@ func (c *client) LoadDatasetBlueskyProfiles(ctx context.Context, query model.Que
 	return result, nil
 }
 
+// LoadDatasetBlueskyGraph loads the edge of the social graph created by the record, it returns nil if the edge does not exist.
+func (c *client) LoadDatasetBlueskyGraph(ctx context.Context, uri string) (*model.BlueskyGraph, error) {
+	var value table.DatasetBlueskyGraph
+
+	if err := c.database.WithContext(ctx).
+		Where("uri = ?", uri).
+		First(&value).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// LoadDatasetBlueskyGraphs loads the edges of the social graph owned by or pointing to an account, the latest first.
+func (c *client) LoadDatasetBlueskyGraphs(ctx context.Context, query model.QueryBlueskyGraphs) ([]*model.BlueskyGraph, error) {
+	databaseStatement := c.database.WithContext(ctx).Table(table.DatasetBlueskyGraph{}.TableName())
+
+	if query.Cursor != nil {
+		var cursor *table.DatasetBlueskyGraph
+
+		if err := c.database.WithContext(ctx).First(&cursor, "uri = ?", query.Cursor).Error; err != nil {
+			return nil, fmt.Errorf("get graph cursor: %w", err)
+		}
+
+		databaseStatement = databaseStatement.Where("created_at < ? OR (created_at = ? AND uri < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.URI)
+	}
+
+	if query.DID != nil {
+		databaseStatement = databaseStatement.Where("did = ?", query.DID)
+	}
+
+	if query.Subject != nil {
+		databaseStatement = databaseStatement.Where("subject = ?", query.Subject)
+	}
+
+	if query.Kind != "" {
+		databaseStatement = databaseStatement.Where("kind = ?", query.Kind)
+	}
+
+	if query.Limit != nil {
+		databaseStatement = databaseStatement.Limit(*query.Limit)
+	}
+
+	var graphs []*table.DatasetBlueskyGraph
+
+	if err := databaseStatement.Order("created_at DESC, uri DESC").Find(&graphs).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.BlueskyGraph, 0, len(graphs))
+
+	for _, graph := range graphs {
+		value, err := graph.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// SaveDatasetBlueskyGraph saves the edge of the social graph created by a record.
+func (c *client) SaveDatasetBlueskyGraph(ctx context.Context, graph *model.BlueskyGraph) error {
+	clauses := []clause.Expression{
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "uri"}},
+			UpdateAll: true,
+		},
+	}
+
+	var value table.DatasetBlueskyGraph
+	if err := value.Import(graph); err != nil {
+		return err
+	}
+
+	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
+}
+
+// DeleteDatasetBlueskyGraph deletes the edge of the social graph created by a deleted record.
+func (c *client) DeleteDatasetBlueskyGraph(ctx context.Context, uri string) error {
+	return c.database.WithContext(ctx).Where("uri = ?", uri).Delete(&table.DatasetBlueskyGraph{}).Error
+}
+
+// SaveDeadLetters saves the dead letters, the attempts are increased if the task has already failed before.
+func (c *client) SaveDeadLetters(ctx context.Context, deadLetters []*model.DeadLetter) error {
+	values := make([]table.DeadLetter, 0, len(deadLetters))
+
+	for _, deadLetter := range deadLetters {
+		var value table.DeadLetter
+		if err := value.Import(deadLetter); err != nil {
+			return err
+		}
+
+		values = append(values, value)
+	}
+
+	onConflictClause := clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}, {Name: "network"}, {Name: "worker"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"error":      gorm.Expr("EXCLUDED.error"),
+			"payload":    gorm.Expr("EXCLUDED.payload"),
+			"attempts":   gorm.Expr("dead_letters.attempts + 1"),
+			"updated_at": gorm.Expr("EXCLUDED.updated_at"),
+		}),
+	}
+
+	return c.database.WithContext(ctx).Clauses(onConflictClause).CreateInBatches(&values, math.MaxUint8).Error
+}
+
+// FindDeadLetters finds the dead letters, ordered from the latest to the earliest.
+func (c *client) FindDeadLetters(ctx context.Context, query model.DeadLettersQuery) ([]*model.DeadLetter, error) {
+	databaseStatement := c.database.WithContext(ctx).Table(table.DeadLetter{}.TableName())
+
+	if query.Cursor != nil {
+		var cursor *table.DeadLetter
+
+		if err := c.database.WithContext(ctx).First(&cursor, "id = ? AND network = ? AND worker = ?", query.Cursor.ID, query.Cursor.Network, query.Cursor.Worker).Error; err != nil {
+			return nil, fmt.Errorf("get dead letter cursor: %w", err)
+		}
+
+		databaseStatement = databaseStatement.Where("(created_at, id, network, worker) < (?, ?, ?, ?)", cursor.CreatedAt, cursor.ID, cursor.Network, cursor.Worker)
+	}
+
+	if query.Network != nil {
+		databaseStatement = databaseStatement.Where("network = ?", query.Network)
+	}
+
+	if query.Worker != nil {
+		databaseStatement = databaseStatement.Where("worker = ?", query.Worker)
+	}
+
+	if query.Limit > 0 {
+		databaseStatement = databaseStatement.Limit(query.Limit)
+	}
+
+	var deadLetters []*table.DeadLetter
+
+	if err := databaseStatement.Order("created_at DESC, id DESC, network DESC, worker DESC").Find(&deadLetters).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.DeadLetter, 0, len(deadLetters))
+
+	for _, deadLetter := range deadLetters {
+		value, err := deadLetter.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// DeleteDeadLetter deletes the dead letter after the task has been replayed successfully.
+func (c *client) DeleteDeadLetter(ctx context.Context, deadLetter *model.DeadLetter) error {
+	return c.database.WithContext(ctx).
+		Where("id = ? AND network = ? AND worker = ?", deadLetter.ID, deadLetter.Network, deadLetter.Worker).
+		Delete(&table.DeadLetter{}).Error
+}
+
+// SaveStreamOutbox saves a batch of activities to be published to the stream by the relay.
+func (c *client) SaveStreamOutbox(ctx context.Context, outbox *model.StreamOutbox) error {
+	var value table.StreamOutbox
+	if err := value.Import(outbox); err != nil {
+		return err
+	}
+
+	if err := c.database.WithContext(ctx).Create(&value).Error; err != nil {
+		return err
+	}
+
+	outbox.ID = value.ID
+
+	return nil
+}
+
+// LockStreamOutboxes locks the earliest outboxes, the outboxes locked by other relays are skipped.
+func (c *client) LockStreamOutboxes(ctx context.Context, limit int) ([]*model.StreamOutbox, error) {
+	var outboxes []*table.StreamOutbox
+
+	if err := c.database.WithContext(ctx).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+		Order("id ASC").
+		Limit(limit).
+		Find(&outboxes).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.StreamOutbox, 0, len(outboxes))
+
+	for _, outbox := range outboxes {
+		value, err := outbox.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// DeleteStreamOutboxes deletes the outboxes that have been published.
+func (c *client) DeleteStreamOutboxes(ctx context.Context, ids []uint64) error {
+	return c.database.WithContext(ctx).Where("id IN ?", ids).Delete(&table.StreamOutbox{}).Error
+}
+
+// LoadWebhook loads the webhook, it returns nil if the webhook is not found.
+func (c *client) LoadWebhook(ctx context.Context, id string) (*model.Webhook, error) {
+	var value table.Webhook
+
+	if err := c.database.WithContext(ctx).Where("id = ?", id).First(&value).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// FindWebhooks finds the webhooks, ordered from the earliest created.
+func (c *client) FindWebhooks(ctx context.Context, query model.WebhooksQuery) ([]*model.Webhook, error) {
+	databaseStatement := c.database.WithContext(ctx).Table(table.Webhook{}.TableName())
+
+	if query.Enabled != nil {
+		databaseStatement = databaseStatement.Where("enabled = ?", *query.Enabled)
+	}
+
+	var values []*table.Webhook
+
+	if err := databaseStatement.Order("created_at ASC").Find(&values).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Webhook, 0, len(values))
+
+	for _, value := range values {
+		webhook, err := value.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, webhook)
+	}
+
+	return result, nil
+}
+
+// SaveWebhook creates the webhook or updates it if it already exists.
+func (c *client) SaveWebhook(ctx context.Context, webhook *model.Webhook) error {
+	var value table.Webhook
+	if err := value.Import(webhook); err != nil {
+		return err
+	}
+
+	onConflictClause := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		UpdateAll: true,
+	}
+
+	if err := c.database.WithContext(ctx).Clauses(onConflictClause).Create(&value).Error; err != nil {
+		return err
+	}
+
+	webhook.CreatedAt, webhook.UpdatedAt = value.CreatedAt, value.UpdatedAt
+
+	return nil
+}
+
+// DeleteWebhook deletes the webhook and its delivery logs.
+func (c *client) DeleteWebhook(ctx context.Context, id string) error {
+	return c.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", id).Delete(&table.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", id).Delete(&table.Webhook{}).Error
+	})
+}
+
+// SaveWebhookDeliveries creates the deliveries, the ids are set to the created deliveries.
+func (c *client) SaveWebhookDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	values := make([]table.WebhookDelivery, 0, len(deliveries))
+
+	for _, delivery := range deliveries {
+		var value table.WebhookDelivery
+		if err := value.Import(delivery); err != nil {
+			return err
+		}
+
+		values = append(values, value)
+	}
+
+	if err := c.database.WithContext(ctx).CreateInBatches(&values, math.MaxUint8).Error; err != nil {
+		return err
+	}
+
+	for index := range values {
+		deliveries[index].ID = values[index].ID
+	}
+
+	return nil
+}
+
+// UpdateWebhookDelivery updates the result of the last attempt of the delivery.
+func (c *client) UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	return c.database.WithContext(ctx).
+		Model(&table.WebhookDelivery{}).
+		Where("id = ?", delivery.ID).
+		Updates(map[string]any{
+			"status":          string(delivery.Status),
+			"attempts":        delivery.Attempts,
+			"response_status": delivery.ResponseStatus,
+			"error":           delivery.Error,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"updated_at":      time.Now(),
+		}).Error
+}
+
+// LockWebhookDeliveries locks the earliest pending deliveries that are due, the deliveries locked by other dispatchers are skipped.
+func (c *client) LockWebhookDeliveries(ctx context.Context, limit int) ([]*model.WebhookDelivery, error) {
+	var values []*table.WebhookDelivery
+
+	if err := c.database.WithContext(ctx).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+		Where("status = ? AND next_attempt_at <= ?", model.WebhookDeliveryStatusPending, time.Now()).
+		Order("id ASC").
+		Limit(limit).
+		Find(&values).Error; err != nil {
+		return nil, err
+	}
+
+	return exportWebhookDeliveries(values)
+}
+
+// FindWebhookDeliveries finds the delivery logs of a webhook, ordered from the latest, the activities are not loaded.
+func (c *client) FindWebhookDeliveries(ctx context.Context, query model.WebhookDeliveriesQuery) ([]*model.WebhookDelivery, error) {
+	databaseStatement := c.database.WithContext(ctx).
+		Table(table.WebhookDelivery{}.TableName()).
+		Omit("activities").
+		Where("webhook_id = ?", query.WebhookID)
+
+	if query.Cursor != nil {
+		databaseStatement = databaseStatement.Where("id < ?", *query.Cursor)
+	}
+
+	var values []*table.WebhookDelivery
+
+	if err := databaseStatement.Order("id DESC").Limit(query.Limit).Find(&values).Error; err != nil {
+		return nil, err
+	}
+
+	return exportWebhookDeliveries(values)
+}
+
+func exportWebhookDeliveries(values []*table.WebhookDelivery) ([]*model.WebhookDelivery, error) {
+	result := make([]*model.WebhookDelivery, 0, len(values))
+
+	for _, value := range values {
+		delivery, err := value.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, delivery)
+	}
+
+	return result, nil
+}
+
 // Dial dials a database.
 func Dial(ctx context.Context, dataSourceName string, partition bool) (database.Client, error) {
 	var err error
@@ -26,6 +26,8 @@ var indexesTables sync.Map
 
 var activitiesTables sync.Map
 
+var searchTables sync.Map
+
 // createPartitionTable creates a partition table.
 func (c *client) createPartitionTable(ctx context.Context, name, template string) error {
 	statement := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS "%s" (LIKE "%s" INCLUDING ALL);`, name, template)
@@ -46,6 +48,10 @@ func (c *client) createPartitionTable(ctx context.Context, name, template string
 		activitiesTables.Store(name, struct{}{})
 	}
 
+	if template == (*table.ActivitySearch).TableName(nil) {
+		searchTables.Store(name, struct{}{})
+	}
+
 	zap.L().Debug("successfully created partition table",
 		zap.String("statement", statement))
 
@@ -180,6 +186,14 @@ func (c *client) loadPartitionTables(ctx context.Context) {
 	for _, tableName := range result {
 		activitiesTables.Store(tableName, struct{}{})
 	}
+
+	if err := c.database.WithContext(ctx).Table("pg_tables").Where("tablename LIKE ?", fmt.Sprintf("%s_%%", (*table.ActivitySearch).TableName(nil))).Pluck("tablename", &result).Error; err != nil {
+		zap.L().Error("failed to load activity search partition tables", zap.Error(err))
+	}
+
+	for _, tableName := range result {
+		searchTables.Store(tableName, struct{}{})
+	}
 }
 
 // saveActivitiesPartitioned saves Activities in partitioned tables.
@@ -204,6 +218,7 @@ func (c *client) saveActivitiesPartitioned(ctx context.Context, activities []*ac
 	}
 
 	errorGroup, ctx := errgroup.WithContext(ctx)
+	errorGroup.SetLimit(c.maxGoroutines(-1))
 
 	// Save activities and indexes in parallel.
 	for name, activities := range partitions {
@@ -274,7 +289,11 @@ func (c *client) saveActivitiesPartitioned(ctx context.Context, activities []*ac
 				zap.String("partition_name", name),
 				zap.Int("affected_count", len(affectedActivities)))
 
-			return c.saveIndexesPartitioned(ctx, lo.Must(affectedActivities.Export()))
+			if err := c.saveIndexesPartitioned(ctx, lo.Must(affectedActivities.Export())); err != nil {
+				return err
+			}
+
+			return c.saveSearchesPartitioned(ctx, lo.Must(affectedActivities.Export()))
 		})
 	}
 
@@ -549,7 +568,7 @@ func (c *client) saveIndexesPartitioned(ctx context.Context, activities []*activ
 	zap.L().Debug("deleting existing indexes",
 		zap.Any("condition", conditions))
 
-	errorPool := pool.New().WithContext(ctx).WithMaxGoroutines(10).WithCancelOnError().WithFirstError()
+	errorPool := pool.New().WithContext(ctx).WithMaxGoroutines(c.maxGoroutines(10)).WithCancelOnError().WithFirstError()
 
 	for _, condition := range lo.Chunk(conditions, math.MaxUint8) {
 		condition := condition
@@ -586,7 +605,7 @@ func (c *client) saveIndexesPartitioned(ctx context.Context, activities []*activ
 	zap.L().Debug("saving new indexes",
 		zap.Any("indexes", indexes))
 
-	errorPool = pool.New().WithContext(ctx).WithMaxGoroutines(10).WithCancelOnError().WithFirstError()
+	errorPool = pool.New().WithContext(ctx).WithMaxGoroutines(c.maxGoroutines(10)).WithCancelOnError().WithFirstError()
 
 	for _, index := range lo.Chunk(indexes, math.MaxUint8) {
 		index := index
@@ -864,6 +883,10 @@ func (c *client) deleteExpiredActivitiesPartitioned(ctx context.Context, network
 			}
 		}
 
+		if err := c.deleteExpiredSearchesPartitioned(ctx, network, timestamp, c.buildSearchTableNames(checkTimestamp)); err != nil {
+			return fmt.Errorf("delete expired searches: %w", err)
+		}
+
 		if dropActivity {
 			zap.L().Debug("dropping activity table",
 				zap.String("table", activityTable))
@@ -932,6 +955,61 @@ func (c *client) batchDeleteExpiredActivities(ctx context.Context, network netwo
 	return false, nil
 }
 
+// deleteActivitiesPartitioned deletes activities and indexes by ids from the partitioned tables since the timestamp.
+func (c *client) deleteActivitiesPartitioned(ctx context.Context, network network.Network, ids []string, since time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	zap.L().Debug("starting to delete activities",
+		zap.String("network", network.String()),
+		zap.Int("count", len(ids)),
+		zap.Time("since", since))
+
+	// Iterate through every quarter from the timestamp to now, the activities may have been stored in any of them.
+	quarterStart := time.Date(since.Year(), since.Month()-(since.Month()-1)%3, 1, 0, 0, 0, 0, since.Location())
+
+	for timestamp := quarterStart; !timestamp.After(time.Now()); timestamp = timestamp.AddDate(0, 3, 0) {
+		indexTable := c.buildIndexesTableNames(timestamp)
+
+		indexTableExists, err := c.findPartitionTableExists(ctx, indexTable)
+		if err != nil {
+			return fmt.Errorf("find partition table exists: %w", err)
+		}
+
+		if indexTableExists {
+			if err := c.database.WithContext(ctx).Table(indexTable).Where("network = ? AND id IN ?", network, ids).Delete(&table.Index{}).Error; err != nil {
+				return fmt.Errorf("delete indexes: %w", err)
+			}
+		}
+
+		if _, exists := searchTables.Load(c.buildSearchTableNames(timestamp)); exists {
+			if err := c.database.WithContext(ctx).Table(c.buildSearchTableNames(timestamp)).Where("network = ? AND id IN ?", network, ids).Delete(&table.ActivitySearch{}).Error; err != nil {
+				return fmt.Errorf("delete searches: %w", err)
+			}
+		}
+
+		activityTable := c.buildActivitiesTableNames(network, timestamp)
+
+		activityTableExists, err := c.findPartitionTableExists(ctx, activityTable)
+		if err != nil {
+			return fmt.Errorf("find partition table exists: %w", err)
+		}
+
+		if activityTableExists {
+			if err := c.database.WithContext(ctx).Table(activityTable).Where("id IN ?", ids).Delete(&table.Activity{}).Error; err != nil {
+				return fmt.Errorf("delete activities: %w", err)
+			}
+		}
+
+		zap.L().Debug("successfully deleted activities from partition",
+			zap.String("activity_table", activityTable),
+			zap.String("index_table", indexTable))
+	}
+
+	return nil
+}
+
 // buildFindIndexStatement builds the query index statement.
 func (c *client) buildFindIndexStatement(ctx context.Context, partitionedName string, query model.ActivityQuery) *gorm.DB {
 	databaseStatement := c.database.WithContext(ctx).Table(partitionedName)
@@ -1058,6 +1136,213 @@ func (c *client) buildFindActivitiesStatement(ctx context.Context, partitionedNa
 	return databaseStatement.Order("timestamp DESC, index DESC").Limit(query.Limit)
 }
 
+// saveSearchesPartitioned replaces the search documents of the activities in partitioned tables,
+// the activities must be in the same quarter.
+func (c *client) saveSearchesPartitioned(ctx context.Context, activities []*activityx.Activity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	var searches table.ActivitySearches
+
+	if err := searches.Import(activities); err != nil {
+		return err
+	}
+
+	partitionedName := c.buildSearchTableNames(time.Unix(int64(activities[0].Timestamp), 0))
+
+	// The table is created even if there is no search document, so the stale documents of updated activities are deleted.
+	// #nosec
+	if err := c.createPartitionTable(ctx, partitionedName, (*table.ActivitySearch).TableName(nil)); err != nil {
+		return fmt.Errorf("create partition table: %w", err)
+	}
+
+	networkActivities := lo.GroupBy(activities, func(activity *activityx.Activity) network.Network {
+		return activity.Network
+	})
+
+	for activityNetwork, activities := range networkActivities {
+		for _, activities := range lo.Chunk(activities, math.MaxUint8) {
+			activityIDs := lo.Map(activities, func(activity *activityx.Activity, _ int) string {
+				return activity.ID
+			})
+
+			if err := c.database.WithContext(ctx).
+				Table(partitionedName).
+				Where("network = ? AND id IN ?", activityNetwork, activityIDs).
+				Delete(&table.ActivitySearch{}).
+				Error; err != nil {
+				return fmt.Errorf("delete searches: %w", err)
+			}
+		}
+	}
+
+	if len(searches) == 0 {
+		return nil
+	}
+
+	onConflict := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}, {Name: "network"}},
+		UpdateAll: true,
+	}
+
+	if err := c.database.WithContext(ctx).
+		Table(partitionedName).
+		Clauses(onConflict).
+		CreateInBatches(searches, math.MaxUint8).
+		Error; err != nil {
+		return fmt.Errorf("save searches: %w", err)
+	}
+
+	zap.L().Debug("successfully saved searches in partitioned tables",
+		zap.String("partition_name", partitionedName),
+		zap.Int("total_count", len(searches)))
+
+	return nil
+}
+
+// searchActivitiesPartitioned searches activities by keyword, the partitions are searched from the latest to the earliest until the limit is reached.
+func (c *client) searchActivitiesPartitioned(ctx context.Context, query model.ActivitiesSearchQuery) ([]*activityx.Activity, error) {
+	timestamp := time.Now()
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 && *query.EndTimestamp < uint64(timestamp.Unix()) {
+		timestamp = time.Unix(int64(lo.FromPtr(query.EndTimestamp)), 0)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp < uint64(timestamp.Unix()) {
+		timestamp = time.Unix(int64(query.Cursor.Timestamp), 0)
+	}
+
+	indexes := make([]*table.Index, 0, query.Limit)
+
+	for i := 0; i <= 4 && len(indexes) < query.Limit; i++ {
+		if timestamp.Unix() < time.Now().AddDate(-1, 0, 0).Unix() {
+			break
+		}
+
+		partitionedName := c.buildSearchTableNames(timestamp)
+
+		if _, exists := searchTables.Load(partitionedName); exists {
+			var searches []*table.ActivitySearch
+
+			if err := c.buildSearchActivitiesStatement(ctx, partitionedName, query, query.Limit-len(indexes)).Find(&searches).Error; err != nil {
+				return nil, fmt.Errorf("search activities: %w", err)
+			}
+
+			for _, search := range searches {
+				indexes = append(indexes, search.Export())
+			}
+		}
+
+		year := timestamp.Year()
+		month := timestamp.Month()
+
+		timestamp = time.Date(lo.Ternary(month < 3, year-1, year), lo.Ternary(month < 3, month+9, month-3), timestamp.Day(), 23, 59, 59, 1e9-1, time.Local)
+	}
+
+	partition := lo.GroupBy(indexes, func(index *table.Index) string {
+		activity := table.Activity{
+			Network:   index.Network,
+			Timestamp: index.Timestamp,
+		}
+
+		return activity.PartitionName(nil)
+	})
+
+	result := make([]*activityx.Activity, 0, len(indexes))
+
+	for tableName, index := range partition {
+		ids := lo.Map(index, func(index *table.Index, _ int) string {
+			return index.ID
+		})
+
+		tableActivities := make(table.Activities, 0)
+
+		if err := c.database.WithContext(ctx).Table(tableName).Where("id IN ?", lo.Uniq(ids)).Find(&tableActivities).Error; err != nil {
+			return nil, fmt.Errorf("find activities: %w", err)
+		}
+
+		activities, err := tableActivities.ExportByIndexes(index)
+		if err != nil {
+			return nil, fmt.Errorf("export activities: %w", err)
+		}
+
+		result = append(result, activities...)
+	}
+
+	lo.ForEach(result, func(activity *activityx.Activity, i int) {
+		result[i].Actions = lo.Slice(activity.Actions, 0, query.ActionLimit)
+	})
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Timestamp == result[j].Timestamp {
+			return result[i].Index > result[j].Index
+		}
+
+		return result[i].Timestamp > result[j].Timestamp
+	})
+
+	return result, nil
+}
+
+// deleteExpiredSearchesPartitioned deletes expired search documents from the partition table.
+func (c *client) deleteExpiredSearchesPartitioned(ctx context.Context, network network.Network, timestamp time.Time, partitionedName string) error {
+	if _, exists := searchTables.Load(partitionedName); !exists {
+		return nil
+	}
+
+	return c.database.WithContext(ctx).
+		Table(partitionedName).
+		Where("network = ? AND timestamp < ?", network, timestamp).
+		Delete(&table.ActivitySearch{}).
+		Error
+}
+
+// buildSearchActivitiesStatement builds the search activities statement.
+func (c *client) buildSearchActivitiesStatement(ctx context.Context, partitionedName string, query model.ActivitiesSearchQuery, limit int) *gorm.DB {
+	databaseStatement := c.database.WithContext(ctx).Table(partitionedName).
+		Where("search @@ websearch_to_tsquery('simple', ?)", query.Keyword)
+
+	if query.Status != nil {
+		databaseStatement = databaseStatement.Where("status = ?", query.Status)
+	}
+
+	if query.StartTimestamp != nil && *query.StartTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp >= ?", time.Unix(int64(*query.StartTimestamp), 0))
+	}
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp <= ?", time.Unix(int64(*query.EndTimestamp), 0))
+	}
+
+	if len(query.Platforms) > 0 {
+		databaseStatement = databaseStatement.Where("platform IN ?", query.Platforms)
+	}
+
+	if len(query.Tags) > 0 {
+		databaseStatement = databaseStatement.Where("tag IN ?", query.Tags)
+	}
+
+	if len(query.Types) > 0 {
+		databaseStatement = databaseStatement.Where("type IN ?", query.Types)
+	}
+
+	if len(query.Network) > 0 {
+		databaseStatement = databaseStatement.Where("network IN ?", query.Network)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp < ? OR (timestamp = ? AND index < ?)", time.Unix(int64(query.Cursor.Timestamp), 0), time.Unix(int64(query.Cursor.Timestamp), 0), query.Cursor.Index)
+	}
+
+	return databaseStatement.Order("timestamp DESC, index DESC").Limit(limit)
+}
+
+// buildSearchTableNames builds the search table names.
+func (c *client) buildSearchTableNames(timestamp time.Time) string {
+	return fmt.Sprintf("%s_%d_q%d", (*table.ActivitySearch).TableName(nil), timestamp.Year(), int(timestamp.Month()-1)/3+1)
+}
+
 // buildActivitiesTableNames builds the activities table names.
 func (c *client) buildActivitiesTableNames(network network.Network, timestamp time.Time) string {
 	return fmt.Sprintf("%s_%s_%d_q%d", (*table.Activity).TableName(nil), network, timestamp.Year(), int(timestamp.Month()-1)/3+1)
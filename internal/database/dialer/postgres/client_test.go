@@ -2,6 +2,7 @@ package postgres_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -14,10 +15,12 @@ import (
 	"github.com/rss3-network/node/v2/internal/database/model"
 	"github.com/rss3-network/node/v2/schema/worker/decentralized"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/rss3-network/protocol-go/schema/typex"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestClient(t *testing.T) {
@@ -291,6 +294,171 @@ func TestClient(t *testing.T) {
 				require.Equal(t, data.Platform, activity.Platform)
 				require.Equal(t, data.Type, activity.Type)
 			}
+
+			// Delete activities of orphaned blocks.
+			orphanedActivityIDs := lo.Map(testcase.otherWorkerActivityCreated, func(activity *activityx.Activity, _ int) string {
+				return activity.ID
+			})
+
+			require.NoError(t, client.DeleteActivities(context.Background(), network.Ethereum, orphanedActivityIDs, time.Now().Add(-4*31*24*time.Hour)))
+
+			// Query deleted activities.
+			for _, id := range orphanedActivityIDs {
+				data, _, err := client.FindActivity(context.Background(), model.ActivityQuery{ID: lo.ToPtr(id), ActionLimit: 10})
+				require.NoError(t, err)
+				require.Nil(t, data)
+			}
+
+			// Search activities by the words of their content.
+			post := activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000002",
+				Network:  network.Farcaster,
+				From:     "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+				To:       "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+				Platform: decentralized.PlatformFarcaster.String(),
+				Type:     typex.SocialPost,
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialPost,
+						From:     "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+						To:       "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+						Platform: decentralized.PlatformFarcaster.String(),
+						Metadata: &metadata.SocialPost{
+							Handle: "vitalik.eth",
+							Body:   "Open Information Layer",
+						},
+					},
+				},
+				Timestamp: uint64(time.Now().Unix()),
+			}
+
+			require.NoError(t, client.SaveActivities(context.Background(), []*activityx.Activity{&post}, false))
+
+			activities, err := client.SearchActivities(context.Background(), model.ActivitiesSearchQuery{Keyword: "information layer", Limit: 10, ActionLimit: 10})
+			require.NoError(t, err)
+			require.Len(t, activities, 1)
+			require.Equal(t, post.ID, activities[0].ID)
+
+			activities, err = client.SearchActivities(context.Background(), model.ActivitiesSearchQuery{Keyword: "information swap", Limit: 10, ActionLimit: 10})
+			require.NoError(t, err)
+			require.Len(t, activities, 0)
+
+			// Save dead letters, the attempts are increased when the task fails again.
+			deadLetter := model.DeadLetter{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000001",
+				Network:  network.Ethereum,
+				Worker:   "core",
+				Error:    "unsupported transaction",
+				Payload:  json.RawMessage(`{}`),
+				Attempts: 1,
+			}
+
+			require.NoError(t, client.SaveDeadLetters(context.Background(), []*model.DeadLetter{&deadLetter}))
+			require.NoError(t, client.SaveDeadLetters(context.Background(), []*model.DeadLetter{&deadLetter}))
+
+			deadLetters, err := client.FindDeadLetters(context.Background(), model.DeadLettersQuery{Network: lo.ToPtr(network.Ethereum), Limit: 10})
+			require.NoError(t, err)
+			require.Len(t, deadLetters, 1)
+			require.Equal(t, 2, deadLetters[0].Attempts)
+
+			// The dead letters of the same task are paginated by their primary keys.
+			otherDeadLetter := deadLetter
+			otherDeadLetter.Worker = "uniswap"
+
+			require.NoError(t, client.SaveDeadLetters(context.Background(), []*model.DeadLetter{&otherDeadLetter}))
+
+			deadLetters, err = client.FindDeadLetters(context.Background(), model.DeadLettersQuery{Network: lo.ToPtr(network.Ethereum), Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, deadLetters, 1)
+
+			deadLetters, err = client.FindDeadLetters(context.Background(), model.DeadLettersQuery{
+				Network: lo.ToPtr(network.Ethereum),
+				Cursor:  &model.DeadLetterCursor{ID: deadLetters[0].ID, Network: deadLetters[0].Network, Worker: deadLetters[0].Worker},
+				Limit:   1,
+			})
+			require.NoError(t, err)
+			require.Len(t, deadLetters, 1)
+
+			_, err = client.FindDeadLetters(context.Background(), model.DeadLettersQuery{
+				Cursor: &model.DeadLetterCursor{ID: deadLetter.ID, Network: network.Ethereum, Worker: "unknown"},
+				Limit:  1,
+			})
+			require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+			require.NoError(t, client.DeleteDeadLetter(context.Background(), &otherDeadLetter))
+
+			// Delete the dead letter once it has been replayed.
+			require.NoError(t, client.DeleteDeadLetter(context.Background(), &deadLetter))
+
+			deadLetters, err = client.FindDeadLetters(context.Background(), model.DeadLettersQuery{Network: lo.ToPtr(network.Ethereum), Limit: 10})
+			require.NoError(t, err)
+			require.Empty(t, deadLetters)
+
+			// Save a stream outbox and relay it in a transaction.
+			require.NoError(t, client.SaveStreamOutbox(context.Background(), &model.StreamOutbox{Activities: testcase.coreWorkerActivityCreated}))
+
+			err = client.WithTransaction(context.Background(), func(ctx context.Context, client database.Client) error {
+				outboxes, err := client.LockStreamOutboxes(ctx, 10)
+				require.NoError(t, err)
+				require.Len(t, outboxes, 1)
+				require.Len(t, outboxes[0].Activities, len(testcase.coreWorkerActivityCreated))
+
+				return client.DeleteStreamOutboxes(ctx, []uint64{outboxes[0].ID})
+			})
+			require.NoError(t, err)
+
+			// Save a webhook and a delivery of the created activities, and record the result of the attempt.
+			webhook := model.Webhook{
+				ID:      "webhook",
+				URL:     "https://example.com/webhook",
+				Secret:  "secret",
+				Filter:  model.WebhookFilter{Network: []network.Network{network.Ethereum}},
+				Enabled: true,
+			}
+
+			require.NoError(t, client.SaveWebhook(context.Background(), &webhook))
+
+			webhooks, err := client.FindWebhooks(context.Background(), model.WebhooksQuery{Enabled: lo.ToPtr(true)})
+			require.NoError(t, err)
+			require.Len(t, webhooks, 1)
+			require.Equal(t, webhook.Filter, webhooks[0].Filter)
+
+			delivery := model.WebhookDelivery{
+				WebhookID:     webhook.ID,
+				Activities:    testcase.coreWorkerActivityCreated,
+				Status:        model.WebhookDeliveryStatusPending,
+				NextAttemptAt: time.Now().Add(-time.Second),
+			}
+
+			require.NoError(t, client.SaveWebhookDeliveries(context.Background(), []*model.WebhookDelivery{&delivery}))
+			require.NotZero(t, delivery.ID)
+
+			err = client.WithTransaction(context.Background(), func(ctx context.Context, client database.Client) error {
+				deliveries, err := client.LockWebhookDeliveries(ctx, 10)
+				require.NoError(t, err)
+				require.Len(t, deliveries, 1)
+				require.Len(t, deliveries[0].Activities, len(testcase.coreWorkerActivityCreated))
+
+				deliveries[0].Status = model.WebhookDeliveryStatusDelivered
+				deliveries[0].Attempts++
+				deliveries[0].ResponseStatus = 200
+
+				return client.UpdateWebhookDelivery(ctx, deliveries[0])
+			})
+			require.NoError(t, err)
+
+			deliveries, err := client.FindWebhookDeliveries(context.Background(), model.WebhookDeliveriesQuery{WebhookID: webhook.ID, Limit: 10})
+			require.NoError(t, err)
+			require.Len(t, deliveries, 1)
+			require.Equal(t, model.WebhookDeliveryStatusDelivered, deliveries[0].Status)
+			require.Empty(t, deliveries[0].Activities)
+
+			// Delete the webhook along with its delivery logs.
+			require.NoError(t, client.DeleteWebhook(context.Background(), webhook.ID))
+
+			loadedWebhook, err := client.LoadWebhook(context.Background(), webhook.ID)
+			require.NoError(t, err)
+			require.Nil(t, loadedWebhook)
 		})
 	}
 }
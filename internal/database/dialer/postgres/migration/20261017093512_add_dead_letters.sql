@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dead_letters
+(
+    "id"         text        NOT NULL,
+    "network"    text        NOT NULL,
+    "worker"     text        NOT NULL,
+    "error"      text        NOT NULL,
+    "payload"    jsonb       NOT NULL,
+    "attempts"   integer     NOT NULL DEFAULT 1,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT pk_dead_letters PRIMARY KEY ("id", "network", "worker")
+);
+
+CREATE INDEX idx_dead_letters_cursor ON dead_letters (created_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dead_letters;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS stream_outbox
+(
+    "id"         bigserial PRIMARY KEY,
+    "activities" jsonb       NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS stream_outbox;
+-- +goose StatementEnd
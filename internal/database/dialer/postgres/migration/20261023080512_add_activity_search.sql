@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "activity_search"
+(
+    "id"         text        NOT NULL,
+    "network"    text        NOT NULL,
+    "platform"   text,
+    "index"      int         NOT NULL,
+    "tag"        text        NOT NULL,
+    "type"       text        NOT NULL,
+    "status"     bool        NOT NULL,
+    "document"   text        NOT NULL,
+    "search"     tsvector GENERATED ALWAYS AS (to_tsvector('simple', "document")) STORED,
+    "timestamp"  timestamptz NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT "pk_activity_search" PRIMARY KEY ("id", "network")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_activity_search_search" ON "activity_search" USING gin ("search");
+CREATE INDEX IF NOT EXISTS "idx_activity_search_timestamp" ON "activity_search" ("timestamp" DESC, "index" DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "activity_search";
+-- +goose StatementEnd
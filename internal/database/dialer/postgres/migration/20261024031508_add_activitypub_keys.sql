@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dataset_activitypub_keys
+(
+    "id"          text        NOT NULL,
+    "private_key" text        NOT NULL,
+    "created_at"  timestamptz NOT NULL DEFAULT now(),
+    "updated_at"  timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT pk_dataset_activitypub_keys PRIMARY KEY ("id")
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dataset_activitypub_keys;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dataset_bluesky_graphs
+(
+    "uri"        text        NOT NULL,
+    "did"        text        NOT NULL,
+    "subject"    text        NOT NULL,
+    "kind"       text        NOT NULL,
+    "list"       text        NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT pk_dataset_bluesky_graphs PRIMARY KEY ("uri")
+);
+
+CREATE INDEX idx_dataset_bluesky_graphs_did ON dataset_bluesky_graphs (did, kind, created_at DESC, uri DESC);
+
+CREATE INDEX idx_dataset_bluesky_graphs_subject ON dataset_bluesky_graphs (subject, kind, created_at DESC, uri DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dataset_bluesky_graphs;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dataset_farcaster_verifications
+(
+    "fid"     bigint NOT NULL,
+    "address" text   NOT NULL,
+    CONSTRAINT pk_dataset_farcaster_verifications PRIMARY KEY ("address", "fid")
+);
+
+CREATE INDEX idx_dataset_farcaster_verifications_fid ON dataset_farcaster_verifications (fid);
+
+INSERT INTO dataset_farcaster_verifications (fid, address)
+SELECT fid, unnest(eth_addresses)
+FROM dataset_farcaster_profiles
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dataset_farcaster_verifications;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_dataset_farcaster_profiles_username ON dataset_farcaster_profiles (username);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_dataset_farcaster_profiles_username;
+-- +goose StatementEnd
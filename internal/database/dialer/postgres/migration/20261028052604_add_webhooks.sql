@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS webhooks
+(
+    "id"         text        NOT NULL,
+    "url"        text        NOT NULL,
+    "secret"     text        NOT NULL,
+    "filter"     jsonb       NOT NULL DEFAULT '{}',
+    "enabled"    bool        NOT NULL DEFAULT true,
+    "failures"   int         NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT pk_webhooks PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    "id"              bigserial PRIMARY KEY,
+    "webhook_id"      text        NOT NULL,
+    "activities"      jsonb       NOT NULL,
+    "status"          text        NOT NULL,
+    "attempts"        int         NOT NULL DEFAULT 0,
+    "response_status" int         NOT NULL DEFAULT 0,
+    "error"           text        NOT NULL DEFAULT '',
+    "next_attempt_at" timestamptz NOT NULL DEFAULT now(),
+    "created_at"      timestamptz NOT NULL DEFAULT now(),
+    "updated_at"      timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_webhook_deliveries_status_next_attempt_at ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX idx_webhook_deliveries_webhook_id_id ON webhook_deliveries (webhook_id, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
+-- +goose StatementEnd
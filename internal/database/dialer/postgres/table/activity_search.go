@@ -0,0 +1,166 @@
+package table
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/samber/lo"
+)
+
+// ActivitySearchDocumentLimit is the maximum length in bytes of a search document.
+const ActivitySearchDocumentLimit = 1 << 16
+
+// activitySearchFields are the metadata fields indexed for full-text search,
+// which cover the content of social posts and profiles and the names of tokens.
+var activitySearchFields = map[string]struct{}{
+	"handle":  {},
+	"title":   {},
+	"summary": {},
+	"body":    {},
+	"tags":    {},
+	"name":    {},
+	"bio":     {},
+	"symbol":  {},
+}
+
+// ActivitySearch is the full-text search document of an activity,
+// the search vector is generated from the document by the database.
+type ActivitySearch struct {
+	ID        string          `gorm:"column:id"`
+	Network   network.Network `gorm:"column:network"`
+	Platform  string          `gorm:"column:platform"`
+	Index     uint            `gorm:"column:index"`
+	Tag       tag.Tag         `gorm:"column:tag"`
+	Type      string          `gorm:"column:type"`
+	Status    bool            `gorm:"column:status"`
+	Document  string          `gorm:"column:document"`
+	Timestamp time.Time       `gorm:"column:timestamp"`
+	CreatedAt time.Time       `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time       `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (s *ActivitySearch) TableName() string {
+	return "activity_search"
+}
+
+func (s *ActivitySearch) PartitionName() string {
+	return fmt.Sprintf("%s_%d_q%d", s.TableName(), s.Timestamp.Year(), int(math.Ceil(float64(s.Timestamp.Month())/3)))
+}
+
+func (s *ActivitySearch) Import(activity *activityx.Activity) error {
+	s.ID = activity.ID
+	s.Network = activity.Network
+	s.Platform = activity.Platform
+	s.Index = activity.Index
+	s.Tag = activity.Type.Tag()
+	s.Type = activity.Type.Name()
+	s.Status = activity.Status
+	s.Timestamp = time.Unix(int64(activity.Timestamp), 0)
+
+	values := make([]string, 0)
+
+	for _, action := range activity.Actions {
+		data, err := json.Marshal(action.Metadata)
+		if err != nil {
+			return fmt.Errorf("invalid metadata: %w", err)
+		}
+
+		var metadata any
+
+		if err := json.Unmarshal(data, &metadata); err != nil {
+			return fmt.Errorf("invalid metadata: %w", err)
+		}
+
+		values = appendSearchValues(values, metadata, false)
+	}
+
+	s.Document = truncateSearchDocument(strings.Join(values, " "))
+
+	return nil
+}
+
+// Export returns the index of the activity, which is used to export the activities matched by the search.
+func (s *ActivitySearch) Export() *Index {
+	return &Index{
+		ID:        s.ID,
+		Network:   s.Network,
+		Platform:  s.Platform,
+		Index:     s.Index,
+		Tag:       s.Tag,
+		Type:      s.Type,
+		Status:    s.Status,
+		Timestamp: s.Timestamp,
+	}
+}
+
+type ActivitySearches []*ActivitySearch
+
+// Import imports the activities that have searchable content.
+func (s *ActivitySearches) Import(activities []*activityx.Activity) error {
+	*s = make([]*ActivitySearch, 0, len(activities))
+
+	for _, activity := range activities {
+		var search ActivitySearch
+
+		if err := search.Import(activity); err != nil {
+			return err
+		}
+
+		if search.Document == "" {
+			continue
+		}
+
+		*s = append(*s, &search)
+	}
+
+	return nil
+}
+
+// appendSearchValues appends the string values of the searchable fields in the metadata.
+func appendSearchValues(values []string, metadata any, searchable bool) []string {
+	switch metadata := metadata.(type) {
+	case map[string]any:
+		keys := lo.Keys(metadata)
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			_, ok := activitySearchFields[key]
+
+			values = appendSearchValues(values, metadata[key], ok)
+		}
+	case []any:
+		for _, value := range metadata {
+			values = appendSearchValues(values, value, searchable)
+		}
+	case string:
+		// PostgreSQL does not allow null characters in text.
+		if metadata = strings.ReplaceAll(metadata, "\x00", ""); searchable && strings.TrimSpace(metadata) != "" {
+			values = append(values, metadata)
+		}
+	}
+
+	return values
+}
+
+// truncateSearchDocument truncates the document to the limit without splitting a character.
+func truncateSearchDocument(document string) string {
+	if len(document) <= ActivitySearchDocumentLimit {
+		return document
+	}
+
+	document = document[:ActivitySearchDocumentLimit]
+
+	for !utf8.ValidString(document) {
+		document = document[:len(document)-1]
+	}
+
+	return document
+}
@@ -0,0 +1,34 @@
+package table
+
+import (
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database/model"
+)
+
+type DatasetActivityPubKey struct {
+	ID         string    `gorm:"column:id;primaryKey"`
+	PrivateKey string    `gorm:"column:private_key"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (d *DatasetActivityPubKey) TableName() string {
+	return "dataset_activitypub_keys"
+}
+
+func (d *DatasetActivityPubKey) Import(key *model.ActivityPubKey) error {
+	d.ID = key.ID
+	d.PrivateKey = key.PrivateKey
+
+	return nil
+}
+
+func (d *DatasetActivityPubKey) Export() (*model.ActivityPubKey, error) {
+	key := model.ActivityPubKey{
+		ID:         d.ID,
+		PrivateKey: d.PrivateKey,
+	}
+
+	return &key, nil
+}
@@ -0,0 +1,45 @@
+package table
+
+import (
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database/model"
+)
+
+type DatasetBlueskyGraph struct {
+	URI       string    `gorm:"column:uri;primaryKey"`
+	DID       string    `gorm:"column:did"`
+	Subject   string    `gorm:"column:subject"`
+	Kind      string    `gorm:"column:kind"`
+	List      string    `gorm:"column:list"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (DatasetBlueskyGraph) TableName() string {
+	return "dataset_bluesky_graphs"
+}
+
+func (d *DatasetBlueskyGraph) Import(graph *model.BlueskyGraph) error {
+	d.URI = graph.URI
+	d.DID = graph.DID
+	d.Subject = graph.Subject
+	d.Kind = graph.Kind
+	d.List = graph.List
+	d.CreatedAt = graph.CreatedAt
+
+	return nil
+}
+
+func (d *DatasetBlueskyGraph) Export() (*model.BlueskyGraph, error) {
+	graph := model.BlueskyGraph{
+		URI:       d.URI,
+		DID:       d.DID,
+		Subject:   d.Subject,
+		Kind:      d.Kind,
+		List:      d.List,
+		CreatedAt: d.CreatedAt,
+	}
+
+	return &graph, nil
+}
@@ -0,0 +1,12 @@
+package table
+
+// DatasetFarcasterVerification is an EVM address verified by a Farcaster account, it is replaced
+// with the verified addresses of the profile every time the profile is saved.
+type DatasetFarcasterVerification struct {
+	Fid     int64  `gorm:"column:fid;primaryKey"`
+	Address string `gorm:"column:address;primaryKey"`
+}
+
+func (DatasetFarcasterVerification) TableName() string {
+	return "dataset_farcaster_verifications"
+}
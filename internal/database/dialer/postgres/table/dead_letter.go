@@ -0,0 +1,52 @@
+package table
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/protocol-go/schema/network"
+)
+
+type DeadLetter struct {
+	ID        string          `gorm:"column:id;primaryKey"`
+	Network   network.Network `gorm:"column:network;primaryKey"`
+	Worker    string          `gorm:"column:worker;primaryKey"`
+	Error     string          `gorm:"column:error"`
+	Payload   json.RawMessage `gorm:"column:payload;type:jsonb"`
+	Attempts  int             `gorm:"column:attempts"`
+	CreatedAt time.Time       `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time       `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (DeadLetter) TableName() string {
+	return "dead_letters"
+}
+
+func (d *DeadLetter) Import(deadLetter *model.DeadLetter) error {
+	d.ID = deadLetter.ID
+	d.Network = deadLetter.Network
+	d.Worker = deadLetter.Worker
+	d.Error = deadLetter.Error
+	d.Payload = deadLetter.Payload
+	d.Attempts = deadLetter.Attempts
+	d.CreatedAt = deadLetter.CreatedAt
+	d.UpdatedAt = deadLetter.UpdatedAt
+
+	return nil
+}
+
+func (d *DeadLetter) Export() (*model.DeadLetter, error) {
+	deadLetter := model.DeadLetter{
+		ID:        d.ID,
+		Network:   d.Network,
+		Worker:    d.Worker,
+		Error:     d.Error,
+		Payload:   d.Payload,
+		Attempts:  d.Attempts,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+
+	return &deadLetter, nil
+}
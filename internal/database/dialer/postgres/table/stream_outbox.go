@@ -0,0 +1,74 @@
+package table
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database/model"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+)
+
+type StreamOutbox struct {
+	ID         uint64          `gorm:"column:id;primaryKey;autoIncrement"`
+	Activities json.RawMessage `gorm:"column:activities;type:jsonb"`
+	CreatedAt  time.Time       `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (StreamOutbox) TableName() string {
+	return "stream_outbox"
+}
+
+func (s *StreamOutbox) Import(outbox *model.StreamOutbox) (err error) {
+	s.ID = outbox.ID
+	s.CreatedAt = outbox.CreatedAt
+
+	// The tags are derived from the types, so that the activities can be unmarshalled even if the workers left them empty.
+	activities := make([]*activityx.Activity, 0, len(outbox.Activities))
+
+	for _, item := range outbox.Activities {
+		activity := *item
+
+		if activity.Type != nil {
+			activity.Tag = activity.Type.Tag()
+		}
+
+		activity.Actions = make([]*activityx.Action, 0, len(activity.Actions))
+
+		for _, action := range item.Actions {
+			action := *action
+
+			if action.Type != nil {
+				action.Tag = action.Type.Tag()
+			}
+
+			activity.Actions = append(activity.Actions, &action)
+		}
+
+		activities = append(activities, &activity)
+	}
+
+	if s.Activities, err = json.Marshal(activities); err != nil {
+		return fmt.Errorf("marshal activities: %w", err)
+	}
+
+	return nil
+}
+
+func (s *StreamOutbox) Export() (*model.StreamOutbox, error) {
+	outbox := model.StreamOutbox{
+		ID:        s.ID,
+		CreatedAt: s.CreatedAt,
+	}
+
+	// The activities are unmarshalled as activityx.Activities, which parses the type from the tag.
+	var activities activityx.Activities
+
+	if err := json.Unmarshal(s.Activities, &activities); err != nil {
+		return nil, fmt.Errorf("unmarshal activities: %w", err)
+	}
+
+	outbox.Activities = activities
+
+	return &outbox, nil
+}
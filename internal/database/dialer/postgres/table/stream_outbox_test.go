@@ -0,0 +1,60 @@
+package table_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database/dialer/postgres/table"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamOutbox(t *testing.T) {
+	t.Parallel()
+
+	outbox := model.StreamOutbox{
+		ID: 1,
+		Activities: []*activityx.Activity{
+			{
+				ID:           "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+				Network:      network.Ethereum,
+				From:         "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+				To:           "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+				Tag:          tag.Transaction,
+				Type:         typex.TransactionTransfer,
+				Platform:     "Uniswap",
+				TotalActions: 1,
+				Actions: []*activityx.Action{
+					{
+						Tag:  tag.Transaction,
+						Type: typex.TransactionTransfer,
+						From: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+						To:   "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+						Metadata: &metadata.TransactionTransfer{
+							Name:  "Wrapped Ether",
+							Value: lo.ToPtr(decimal.NewFromInt(1)),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1729000000,
+			},
+		},
+		CreatedAt: time.Unix(1729000000, 0).UTC(),
+	}
+
+	var value table.StreamOutbox
+
+	require.NoError(t, value.Import(&outbox))
+
+	result, err := value.Export()
+	require.NoError(t, err)
+	require.Equal(t, &outbox, result)
+}
@@ -0,0 +1,126 @@
+package table
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database/model"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+)
+
+type Webhook struct {
+	ID        string          `gorm:"column:id;primaryKey"`
+	URL       string          `gorm:"column:url"`
+	Secret    string          `gorm:"column:secret"`
+	Filter    json.RawMessage `gorm:"column:filter;type:jsonb"`
+	Enabled   bool            `gorm:"column:enabled"`
+	Failures  int             `gorm:"column:failures"`
+	CreatedAt time.Time       `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time       `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (Webhook) TableName() string {
+	return "webhooks"
+}
+
+func (w *Webhook) Import(webhook *model.Webhook) (err error) {
+	w.ID = webhook.ID
+	w.URL = webhook.URL
+	w.Secret = webhook.Secret
+	w.Enabled = webhook.Enabled
+	w.Failures = webhook.Failures
+	w.CreatedAt = webhook.CreatedAt
+	w.UpdatedAt = webhook.UpdatedAt
+
+	if w.Filter, err = json.Marshal(webhook.Filter); err != nil {
+		return fmt.Errorf("marshal filter: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Webhook) Export() (*model.Webhook, error) {
+	webhook := model.Webhook{
+		ID:        w.ID,
+		URL:       w.URL,
+		Secret:    w.Secret,
+		Enabled:   w.Enabled,
+		Failures:  w.Failures,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+
+	if err := json.Unmarshal(w.Filter, &webhook.Filter); err != nil {
+		return nil, fmt.Errorf("unmarshal filter: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+type WebhookDelivery struct {
+	ID             uint64          `gorm:"column:id;primaryKey;autoIncrement"`
+	WebhookID      string          `gorm:"column:webhook_id"`
+	Activities     json.RawMessage `gorm:"column:activities;type:jsonb"`
+	Status         string          `gorm:"column:status"`
+	Attempts       int             `gorm:"column:attempts"`
+	ResponseStatus int             `gorm:"column:response_status"`
+	Error          string          `gorm:"column:error"`
+	NextAttemptAt  time.Time       `gorm:"column:next_attempt_at"`
+	CreatedAt      time.Time       `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time       `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (w *WebhookDelivery) Import(delivery *model.WebhookDelivery) (err error) {
+	w.ID = delivery.ID
+	w.WebhookID = delivery.WebhookID
+	w.Status = string(delivery.Status)
+	w.Attempts = delivery.Attempts
+	w.ResponseStatus = delivery.ResponseStatus
+	w.Error = delivery.Error
+	w.NextAttemptAt = delivery.NextAttemptAt
+	w.CreatedAt = delivery.CreatedAt
+	w.UpdatedAt = delivery.UpdatedAt
+
+	// The activities are stored as the stream outbox does, so the types can be parsed from the tags.
+	var outbox StreamOutbox
+
+	if err := outbox.Import(&model.StreamOutbox{Activities: delivery.Activities}); err != nil {
+		return err
+	}
+
+	w.Activities = outbox.Activities
+
+	return nil
+}
+
+func (w *WebhookDelivery) Export() (*model.WebhookDelivery, error) {
+	delivery := model.WebhookDelivery{
+		ID:             w.ID,
+		WebhookID:      w.WebhookID,
+		Status:         model.WebhookDeliveryStatus(w.Status),
+		Attempts:       w.Attempts,
+		ResponseStatus: w.ResponseStatus,
+		Error:          w.Error,
+		NextAttemptAt:  w.NextAttemptAt,
+		CreatedAt:      w.CreatedAt,
+		UpdatedAt:      w.UpdatedAt,
+	}
+
+	// The activities are not loaded by the queries of the delivery logs.
+	if len(w.Activities) > 0 {
+		var activities activityx.Activities
+
+		if err := json.Unmarshal(w.Activities, &activities); err != nil {
+			return nil, fmt.Errorf("unmarshal activities: %w", err)
+		}
+
+		delivery.Activities = activities
+	}
+
+	return &delivery, nil
+}
@@ -0,0 +1,648 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database/dialer/postgres/table"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	networkx "github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The index, from and to columns are keywords of SQLite and must be quoted in raw statements.
+
+// SaveActivities saves activities and indexes to the database.
+func (c *client) SaveActivities(ctx context.Context, activities []*activityx.Activity, lowPriority bool) error {
+	zap.L().Debug("saving activities",
+		zap.Int("count", len(activities)),
+		zap.Bool("low_priority", lowPriority))
+
+	spanStartOptions := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.Int("activities", len(activities)),
+		),
+	}
+
+	ctx, span := otel.Tracer("").Start(ctx, "Database saveActivities", spanStartOptions...)
+	defer span.End()
+
+	tableActivities := make(table.Activities, 0)
+
+	if err := tableActivities.Import(activities); err != nil {
+		return err
+	}
+
+	if len(tableActivities) == 0 {
+		return nil
+	}
+
+	onConflict := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		UpdateAll: true,
+	}
+
+	// If low priority, only save activities without platform.
+	if lowPriority {
+		onConflict.Where = clause.Where{
+			Exprs: []clause.Expression{
+				gorm.Expr(`"activities"."platform" IS NULL OR "activities"."platform" = ''`),
+			},
+		}
+	}
+
+	if err := c.database.WithContext(ctx).
+		Clauses(onConflict).
+		CreateInBatches(tableActivities, math.MaxUint8).
+		Error; err != nil {
+		return fmt.Errorf("save activities: %w", err)
+	}
+
+	activityIDs := lo.Map(tableActivities, func(item *table.Activity, _ int) string {
+		return item.ID
+	})
+
+	// Rebuild the indexes from the saved activities, including the ones skipped above.
+	var affectedActivities table.Activities
+
+	for _, ids := range lo.Chunk(activityIDs, math.MaxUint8) {
+		var result table.Activities
+
+		if err := c.database.WithContext(ctx).Where("id IN ?", ids).Find(&result).Error; err != nil {
+			return fmt.Errorf("find saved activities: %w", err)
+		}
+
+		affectedActivities = append(affectedActivities, result...)
+	}
+
+	zap.L().Debug("successfully saved activities",
+		zap.Int("affected_count", len(affectedActivities)))
+
+	if err := c.saveIndexes(ctx, lo.Must(affectedActivities.Export())); err != nil {
+		return err
+	}
+
+	return c.saveSearches(ctx, lo.Must(affectedActivities.Export()))
+}
+
+// saveIndexes replaces the indexes of the activities.
+func (c *client) saveIndexes(ctx context.Context, activities []*activityx.Activity) error {
+	indexes := make(table.Indexes, 0)
+
+	if err := indexes.Import(activities); err != nil {
+		return err
+	}
+
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	// Delete indexes with the same activity id and network,
+	// the ids are grouped by network because SQLite does not support row values in the IN operator of gorm.
+	ids := make(map[networkx.Network][]string)
+
+	for _, index := range indexes {
+		if !lo.Contains(ids[index.Network], index.ID) {
+			ids[index.Network] = append(ids[index.Network], index.ID)
+		}
+	}
+
+	for network, networkIDs := range ids {
+		for _, chunk := range lo.Chunk(networkIDs, math.MaxUint8) {
+			if err := c.database.WithContext(ctx).
+				Where("network = ? AND id IN ?", network, chunk).
+				Delete(&table.Index{}).
+				Error; err != nil {
+				return fmt.Errorf("delete indexes: %w", err)
+			}
+		}
+	}
+
+	onConflict := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}, {Name: "network"}, {Name: "owner"}},
+		UpdateAll: true,
+	}
+
+	if err := c.database.WithContext(ctx).
+		Clauses(onConflict).
+		CreateInBatches(indexes, math.MaxUint8).
+		Error; err != nil {
+		return fmt.Errorf("save indexes: %w", err)
+	}
+
+	return nil
+}
+
+// saveSearches replaces the search documents of the activities.
+func (c *client) saveSearches(ctx context.Context, activities []*activityx.Activity) error {
+	var searches table.ActivitySearches
+
+	if err := searches.Import(activities); err != nil {
+		return err
+	}
+
+	// The stale documents of updated activities are deleted even if there is no search document.
+	networkActivities := lo.GroupBy(activities, func(activity *activityx.Activity) networkx.Network {
+		return activity.Network
+	})
+
+	for network, activities := range networkActivities {
+		for _, activities := range lo.Chunk(activities, math.MaxUint8) {
+			activityIDs := lo.Map(activities, func(activity *activityx.Activity, _ int) string {
+				return activity.ID
+			})
+
+			if err := c.database.WithContext(ctx).
+				Where("network = ? AND id IN ?", network, activityIDs).
+				Delete(&table.ActivitySearch{}).
+				Error; err != nil {
+				return fmt.Errorf("delete searches: %w", err)
+			}
+		}
+	}
+
+	if len(searches) == 0 {
+		return nil
+	}
+
+	onConflict := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}, {Name: "network"}},
+		UpdateAll: true,
+	}
+
+	if err := c.database.WithContext(ctx).
+		Clauses(onConflict).
+		CreateInBatches(searches, math.MaxUint8).
+		Error; err != nil {
+		return fmt.Errorf("save searches: %w", err)
+	}
+
+	return nil
+}
+
+// FindActivity finds an Activity by id.
+func (c *client) FindActivity(ctx context.Context, query model.ActivityQuery) (*activityx.Activity, *int, error) {
+	var index table.Index
+
+	if err := c.buildFindIndexStatement(ctx, query).Limit(1).Find(&index).Error; err != nil {
+		return nil, nil, fmt.Errorf("find index: %w", err)
+	}
+
+	if lo.IsEmpty(index.ID) {
+		return nil, nil, nil
+	}
+
+	var activity table.Activity
+
+	if err := c.database.WithContext(ctx).Where("id = ?", index.ID).Limit(1).Find(&activity).Error; err != nil {
+		return nil, nil, fmt.Errorf("find activity: %w", err)
+	}
+
+	page := math.Ceil(float64(len(activity.Actions)) / float64(query.ActionLimit))
+
+	activity.Actions = lo.Slice(activity.Actions, query.ActionLimit*(query.ActionPage-1), query.ActionLimit*query.ActionPage)
+
+	result, err := activity.Export(&index)
+	if err != nil {
+		return nil, nil, fmt.Errorf("export activity: %w", err)
+	}
+
+	return result, lo.ToPtr(int(page)), nil
+}
+
+// FindActivities finds Activities.
+func (c *client) FindActivities(ctx context.Context, query model.ActivitiesQuery) ([]*activityx.Activity, error) {
+	var indexes []*table.Index
+
+	if err := c.buildFindIndexesStatement(ctx, query).Find(&indexes).Error; err != nil {
+		return nil, fmt.Errorf("find indexes: %w", err)
+	}
+
+	ids := lo.Uniq(lo.Map(indexes, func(index *table.Index, _ int) string {
+		return index.ID
+	}))
+
+	tableActivities := make(table.Activities, 0, len(ids))
+
+	for _, chunk := range lo.Chunk(ids, math.MaxUint8) {
+		var result table.Activities
+
+		if err := c.database.WithContext(ctx).Where("id IN ?", chunk).Find(&result).Error; err != nil {
+			return nil, fmt.Errorf("find activities: %w", err)
+		}
+
+		tableActivities = append(tableActivities, result...)
+	}
+
+	result, err := tableActivities.ExportByIndexes(indexes)
+	if err != nil {
+		return nil, fmt.Errorf("export activities: %w", err)
+	}
+
+	lo.ForEach(result, func(activity *activityx.Activity, i int) {
+		result[i].Actions = lo.Slice(activity.Actions, 0, query.ActionLimit)
+	})
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Timestamp > result[j].Timestamp
+	})
+
+	return result, nil
+}
+
+// SearchActivities finds Activities by the keyword in their content.
+func (c *client) SearchActivities(ctx context.Context, query model.ActivitiesSearchQuery) ([]*activityx.Activity, error) {
+	words := strings.Fields(query.Keyword)
+
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	var searches []*table.ActivitySearch
+
+	if err := c.buildSearchActivitiesStatement(ctx, words, query).Find(&searches).Error; err != nil {
+		return nil, fmt.Errorf("search activities: %w", err)
+	}
+
+	indexes := lo.Map(searches, func(search *table.ActivitySearch, _ int) *table.Index {
+		return search.Export()
+	})
+
+	ids := lo.Uniq(lo.Map(indexes, func(index *table.Index, _ int) string {
+		return index.ID
+	}))
+
+	tableActivities := make(table.Activities, 0, len(ids))
+
+	for _, chunk := range lo.Chunk(ids, math.MaxUint8) {
+		var result table.Activities
+
+		if err := c.database.WithContext(ctx).Where("id IN ?", chunk).Find(&result).Error; err != nil {
+			return nil, fmt.Errorf("find activities: %w", err)
+		}
+
+		tableActivities = append(tableActivities, result...)
+	}
+
+	result, err := tableActivities.ExportByIndexes(indexes)
+	if err != nil {
+		return nil, fmt.Errorf("export activities: %w", err)
+	}
+
+	lo.ForEach(result, func(activity *activityx.Activity, i int) {
+		result[i].Actions = lo.Slice(activity.Actions, 0, query.ActionLimit)
+	})
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Timestamp == result[j].Timestamp {
+			return result[i].Index > result[j].Index
+		}
+
+		return result[i].Timestamp > result[j].Timestamp
+	})
+
+	return result, nil
+}
+
+// FindActivitiesMetadata finds Activities by metadata.
+// SQLite has no JSON containment operator, so the metadata is matched after loading the activities page by page.
+func (c *client) FindActivitiesMetadata(ctx context.Context, query model.ActivitiesMetadataQuery) ([]*activityx.Activity, error) {
+	if query.Metadata == nil {
+		var result table.Activities
+
+		if err := c.buildFindActivitiesStatement(ctx, query).Find(&result).Error; err != nil {
+			return nil, fmt.Errorf("failed to find activities metadata: %w", err)
+		}
+
+		return result.Export()
+	}
+
+	data, err := json.Marshal(query.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("marshal metadata: %w", err)
+	}
+
+	var expected any
+
+	if err := json.Unmarshal(data, &expected); err != nil {
+		return nil, fmt.Errorf("unmarshal metadata: %w", err)
+	}
+
+	result := make(table.Activities, 0, query.Limit)
+
+	for len(result) < query.Limit {
+		var candidates table.Activities
+
+		if err := c.buildFindActivitiesStatement(ctx, query).Find(&candidates).Error; err != nil {
+			return nil, fmt.Errorf("failed to find activities metadata: %w", err)
+		}
+
+		for _, candidate := range candidates {
+			if len(result) < query.Limit && c.matchActionsMetadata(candidate.Actions, expected) {
+				result = append(result, candidate)
+			}
+		}
+
+		if len(candidates) < query.Limit {
+			break
+		}
+
+		last := candidates[len(candidates)-1]
+
+		query.Cursor = &activityx.Activity{
+			Timestamp: uint64(last.Timestamp.Unix()),
+			Index:     last.Index,
+		}
+	}
+
+	return result.Export()
+}
+
+// matchActionsMetadata returns true if the metadata of any action contains the expected value.
+func (c *client) matchActionsMetadata(actions table.ActivityActions, expected any) bool {
+	for _, action := range actions {
+		var value any
+
+		if err := json.Unmarshal(action.Metadata, &value); err != nil {
+			continue
+		}
+
+		if jsonContains(value, expected) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jsonContains reports whether the value contains the expected value, following the semantics of the PostgreSQL @> operator.
+func jsonContains(value, expected any) bool {
+	switch expected := expected.(type) {
+	case map[string]any:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for key, expectedValue := range expected {
+			if actualValue, exists := object[key]; !exists || !jsonContains(actualValue, expectedValue) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		array, ok := value.([]any)
+		if !ok {
+			return false
+		}
+
+		for _, expectedElement := range expected {
+			if !lo.ContainsBy(array, func(element any) bool {
+				return jsonContains(element, expectedElement)
+			}) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(value, expected)
+	}
+}
+
+// DeleteExpiredActivities deletes expired activities.
+func (c *client) DeleteExpiredActivities(ctx context.Context, network networkx.Network, timestamp time.Time) error {
+	if err := c.database.WithContext(ctx).
+		Where("network = ? AND timestamp < ?", network, timestamp.UTC()).
+		Delete(&table.Index{}).
+		Error; err != nil {
+		return fmt.Errorf("delete expired indexes: %w", err)
+	}
+
+	if err := c.database.WithContext(ctx).
+		Where("network = ? AND timestamp < ?", network, timestamp.UTC()).
+		Delete(&table.ActivitySearch{}).
+		Error; err != nil {
+		return fmt.Errorf("delete expired searches: %w", err)
+	}
+
+	if err := c.database.WithContext(ctx).
+		Where("network = ? AND timestamp < ?", network, timestamp.UTC()).
+		Delete(&table.Activity{}).
+		Error; err != nil {
+		return fmt.Errorf("delete expired activities: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteActivities deletes activities and their indexes by ids, which were stored since the timestamp.
+func (c *client) DeleteActivities(ctx context.Context, network networkx.Network, ids []string, since time.Time) error {
+	for _, chunk := range lo.Chunk(ids, math.MaxUint8) {
+		if err := c.database.WithContext(ctx).
+			Where("network = ? AND id IN ? AND timestamp >= ?", network, chunk, since.UTC()).
+			Delete(&table.Index{}).
+			Error; err != nil {
+			return fmt.Errorf("delete indexes: %w", err)
+		}
+
+		if err := c.database.WithContext(ctx).
+			Where("network = ? AND id IN ? AND timestamp >= ?", network, chunk, since.UTC()).
+			Delete(&table.ActivitySearch{}).
+			Error; err != nil {
+			return fmt.Errorf("delete searches: %w", err)
+		}
+
+		if err := c.database.WithContext(ctx).
+			Where("network = ? AND id IN ? AND timestamp >= ?", network, chunk, since.UTC()).
+			Delete(&table.Activity{}).
+			Error; err != nil {
+			return fmt.Errorf("delete activities: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// buildFindIndexStatement builds the query index statement.
+func (c *client) buildFindIndexStatement(ctx context.Context, query model.ActivityQuery) *gorm.DB {
+	databaseStatement := c.database.WithContext(ctx).Model(&table.Index{})
+
+	if query.ID != nil {
+		databaseStatement = databaseStatement.Where("id = ?", lo.FromPtr(query.ID))
+	}
+
+	if query.Network != nil {
+		databaseStatement = databaseStatement.Where("network = ?", lo.FromPtr(query.Network))
+	}
+
+	if query.Owner != nil {
+		databaseStatement = databaseStatement.Where("owner = ?", lo.FromPtr(query.Owner))
+	}
+
+	return databaseStatement
+}
+
+// buildFindIndexesStatement builds the query indexes statement.
+func (c *client) buildFindIndexesStatement(ctx context.Context, query model.ActivitiesQuery) *gorm.DB {
+	databaseStatement := c.database.WithContext(ctx).Model(&table.Index{})
+
+	if query.Distinct != nil && lo.FromPtr(query.Distinct) {
+		databaseStatement = databaseStatement.Select(`DISTINCT id, timestamp, "index", network`)
+	}
+
+	if query.Owner != nil {
+		databaseStatement = databaseStatement.Where("owner = ?", query.Owner)
+	}
+
+	if len(query.Owners) > 0 {
+		databaseStatement = databaseStatement.Where("owner IN ?", query.Owners)
+	}
+
+	if query.Status != nil {
+		databaseStatement = databaseStatement.Where("status = ?", query.Status)
+	}
+
+	if query.Direction != nil {
+		databaseStatement = databaseStatement.Where("direction = ?", query.Direction)
+	}
+
+	if query.StartTimestamp != nil && *query.StartTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp >= ?", time.Unix(int64(*query.StartTimestamp), 0).UTC())
+	}
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp <= ?", time.Unix(int64(*query.EndTimestamp), 0).UTC())
+	}
+
+	if query.Platform != "" {
+		databaseStatement = databaseStatement.Where("platform = ?", query.Platform)
+	}
+
+	if len(query.Platforms) > 0 {
+		databaseStatement = databaseStatement.Where("platform IN ?", query.Platforms)
+	}
+
+	if len(query.Tags) > 0 {
+		databaseStatement = databaseStatement.Where("tag IN ?", query.Tags)
+	}
+
+	if len(query.Types) > 0 {
+		databaseStatement = databaseStatement.Where("type IN ?", query.Types)
+	}
+
+	if len(query.Network) > 0 {
+		databaseStatement = databaseStatement.Where("network IN ?", query.Network)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp > 0 {
+		timestamp := time.Unix(int64(query.Cursor.Timestamp), 0).UTC()
+
+		databaseStatement = databaseStatement.Where(`timestamp < ? OR (timestamp = ? AND "index" < ?)`, timestamp, timestamp, query.Cursor.Index)
+	}
+
+	return databaseStatement.Order(`timestamp DESC, "index" DESC`).Limit(query.Limit)
+}
+
+// buildSearchActivitiesStatement builds the search activities statement, every word of the keyword must be contained in the document.
+func (c *client) buildSearchActivitiesStatement(ctx context.Context, words []string, query model.ActivitiesSearchQuery) *gorm.DB {
+	databaseStatement := c.database.WithContext(ctx).Model(&table.ActivitySearch{})
+
+	escaper := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+	for _, word := range words {
+		databaseStatement = databaseStatement.Where(`document LIKE ? ESCAPE '\'`, "%"+escaper.Replace(word)+"%")
+	}
+
+	if query.Status != nil {
+		databaseStatement = databaseStatement.Where("status = ?", query.Status)
+	}
+
+	if query.StartTimestamp != nil && *query.StartTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp >= ?", time.Unix(int64(*query.StartTimestamp), 0).UTC())
+	}
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp <= ?", time.Unix(int64(*query.EndTimestamp), 0).UTC())
+	}
+
+	if len(query.Platforms) > 0 {
+		databaseStatement = databaseStatement.Where("platform IN ?", query.Platforms)
+	}
+
+	if len(query.Tags) > 0 {
+		databaseStatement = databaseStatement.Where("tag IN ?", query.Tags)
+	}
+
+	if len(query.Types) > 0 {
+		databaseStatement = databaseStatement.Where("type IN ?", query.Types)
+	}
+
+	if len(query.Network) > 0 {
+		databaseStatement = databaseStatement.Where("network IN ?", query.Network)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp > 0 {
+		timestamp := time.Unix(int64(query.Cursor.Timestamp), 0).UTC()
+
+		databaseStatement = databaseStatement.Where(`timestamp < ? OR (timestamp = ? AND "index" < ?)`, timestamp, timestamp, query.Cursor.Index)
+	}
+
+	return databaseStatement.Order(`timestamp DESC, "index" DESC`).Limit(query.Limit)
+}
+
+// buildFindActivitiesStatement builds the query activities statement.
+func (c *client) buildFindActivitiesStatement(ctx context.Context, query model.ActivitiesMetadataQuery) *gorm.DB {
+	databaseStatement := c.database.WithContext(ctx).Model(&table.Activity{})
+
+	if query.Network != nil {
+		databaseStatement = databaseStatement.Where("network = ?", query.Network)
+	}
+
+	if query.Platform != nil {
+		databaseStatement = databaseStatement.Where("platform = ?", query.Platform)
+	}
+
+	if query.Tag != nil {
+		databaseStatement = databaseStatement.Where("tag = ?", query.Tag)
+	}
+
+	if query.Type != nil {
+		databaseStatement = databaseStatement.Where("type = ?", query.Type)
+	}
+
+	if query.StartTimestamp != nil && *query.StartTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp >= ?", time.Unix(int64(*query.StartTimestamp), 0).UTC())
+	}
+
+	if query.EndTimestamp != nil && *query.EndTimestamp > 0 {
+		databaseStatement = databaseStatement.Where("timestamp <= ?", time.Unix(int64(*query.EndTimestamp), 0).UTC())
+	}
+
+	if query.Accounts != nil {
+		databaseStatement = databaseStatement.Where(`"from" IN ? OR "to" IN ?`, query.Accounts, query.Accounts)
+	}
+
+	if query.Cursor != nil && query.Cursor.Timestamp > 0 {
+		timestamp := time.Unix(int64(query.Cursor.Timestamp), 0).UTC()
+
+		databaseStatement = databaseStatement.Where(`timestamp < ? OR (timestamp = ? AND "index" < ?)`, timestamp, timestamp, query.Cursor.Index)
+	}
+
+	return databaseStatement.Order(`timestamp DESC, "index" DESC`).Limit(query.Limit)
+}
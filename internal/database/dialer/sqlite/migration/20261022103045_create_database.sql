@@ -0,0 +1,147 @@
+-- +goose Up
+-- Activities and indexes are stored in single tables, timestamps are stored in UTC so they can be compared as text.
+CREATE TABLE IF NOT EXISTS "indexes"
+(
+    "id"         text     NOT NULL,
+    "owner"      text     NOT NULL,
+    "network"    text     NOT NULL,
+    "index"      integer  NOT NULL,
+    "platform"   text,
+    "tag"        text     NOT NULL,
+    "type"       text     NOT NULL,
+    "status"     boolean  NOT NULL,
+    "direction"  integer  NOT NULL,
+    "timestamp"  datetime NOT NULL,
+    "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pk_indexes" PRIMARY KEY ("id", "network", "owner")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_indexes_platform" ON "indexes" ("platform", "timestamp" DESC, "index" DESC);
+CREATE INDEX IF NOT EXISTS "idx_indexes_filter" ON "indexes" ("tag", "timestamp" DESC, "index" DESC, "type");
+CREATE INDEX IF NOT EXISTS "idx_indexes_network" ON "indexes" ("network", "timestamp" DESC, "index" DESC);
+CREATE INDEX IF NOT EXISTS "idx_indexes_owner" ON "indexes" ("owner", "timestamp" DESC, "index" DESC, "direction");
+CREATE INDEX IF NOT EXISTS "idx_indexes_timestamp" ON "indexes" ("timestamp" DESC, "index" DESC);
+
+CREATE TABLE IF NOT EXISTS "activities"
+(
+    "id"            text     NOT NULL,
+    "network"       text     NOT NULL,
+    "platform"      text,
+    "index"         integer  NOT NULL,
+    "from"          text     NOT NULL,
+    "to"            text     NOT NULL,
+    "tag"           text     NOT NULL,
+    "type"          text     NOT NULL,
+    "status"        boolean  NOT NULL,
+    "actions"       text     NOT NULL,
+    "total_actions" integer  NOT NULL,
+    "fee"           text,
+    "calldata"      text,
+    "timestamp"     datetime NOT NULL,
+    "created_at"    datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at"    datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pk_activities" PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_activities_network" ON "activities" ("network", "timestamp" DESC, "index" DESC);
+
+CREATE TABLE IF NOT EXISTS "checkpoints"
+(
+    "id"          text     NOT NULL,
+    "network"     text     NOT NULL,
+    "worker"      text     NOT NULL,
+    "state"       text     NOT NULL,
+    "index_count" integer  NOT NULL DEFAULT 0,
+    "created_at"  datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at"  datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pk_checkpoints" PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "dataset_mirror_posts"
+(
+    "id"                    text NOT NULL,
+    "origin_content_digest" text NOT NULL,
+
+    CONSTRAINT "pk_dataset_mirror_posts" PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_origin_content_digest" ON "dataset_mirror_posts" ("origin_content_digest");
+
+CREATE TABLE IF NOT EXISTS "dataset_farcaster_profiles"
+(
+    "fid"             integer NOT NULL,
+    "username"        text,
+    "custody_address" text,
+    "eth_addresses"   text,
+
+    CONSTRAINT "pk_dataset_farcaster_profiles" PRIMARY KEY ("fid")
+);
+
+CREATE TABLE IF NOT EXISTS "dataset_ens_namehashes"
+(
+    "hash" blob NOT NULL,
+    "name" text NOT NULL,
+
+    CONSTRAINT "pk_dataset_ens_namehashs" PRIMARY KEY ("hash")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_ensnamehash_name" ON "dataset_ens_namehashes" ("name");
+
+CREATE TABLE IF NOT EXISTS "dataset_mastodon_update_handles"
+(
+    "handle"     text     NOT NULL PRIMARY KEY,
+    "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "idx_mastodon_update_handles_time_at" ON "dataset_mastodon_update_handles" ("updated_at" DESC, "created_at" DESC);
+
+CREATE TABLE IF NOT EXISTS "dataset_bluesky_profiles"
+(
+    "did"        text     NOT NULL PRIMARY KEY,
+    "handle"     text,
+    "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "idx_dataset_bluesky_profiles_cursor" ON "dataset_bluesky_profiles" ("updated_at" DESC, "created_at" DESC);
+CREATE INDEX IF NOT EXISTS "idx_dataset_bluesky_profiles_handle" ON "dataset_bluesky_profiles" ("handle");
+
+CREATE TABLE IF NOT EXISTS "dead_letters"
+(
+    "id"         text     NOT NULL,
+    "network"    text     NOT NULL,
+    "worker"     text     NOT NULL,
+    "error"      text     NOT NULL,
+    "payload"    text     NOT NULL,
+    "attempts"   integer  NOT NULL DEFAULT 1,
+    "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pk_dead_letters" PRIMARY KEY ("id", "network", "worker")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_dead_letters_cursor" ON "dead_letters" ("created_at" DESC, "id" DESC);
+
+CREATE TABLE IF NOT EXISTS "stream_outbox"
+(
+    "id"         integer  PRIMARY KEY AUTOINCREMENT,
+    "activities" text     NOT NULL,
+    "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS "stream_outbox";
+DROP TABLE IF EXISTS "dead_letters";
+DROP TABLE IF EXISTS "dataset_bluesky_profiles";
+DROP TABLE IF EXISTS "dataset_mastodon_update_handles";
+DROP TABLE IF EXISTS "dataset_ens_namehashes";
+DROP TABLE IF EXISTS "dataset_farcaster_profiles";
+DROP TABLE IF EXISTS "dataset_mirror_posts";
+DROP TABLE IF EXISTS "checkpoints";
+DROP TABLE IF EXISTS "activities";
+DROP TABLE IF EXISTS "indexes";
@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQLite matches every word of the keyword with LIKE, which is case-insensitive for ASCII characters.
+CREATE TABLE IF NOT EXISTS "activity_search"
+(
+    "id"         text     NOT NULL,
+    "network"    text     NOT NULL,
+    "platform"   text,
+    "index"      integer  NOT NULL,
+    "tag"        text     NOT NULL,
+    "type"       text     NOT NULL,
+    "status"     boolean  NOT NULL,
+    "document"   text     NOT NULL,
+    "timestamp"  datetime NOT NULL,
+    "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pk_activity_search" PRIMARY KEY ("id", "network")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_activity_search_timestamp" ON "activity_search" ("timestamp" DESC, "index" DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS "activity_search";
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "dataset_activitypub_keys"
+(
+    "id"          text     NOT NULL,
+    "private_key" text     NOT NULL,
+    "created_at"  datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at"  datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pk_dataset_activitypub_keys" PRIMARY KEY ("id")
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS "dataset_activitypub_keys";
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "dataset_bluesky_graphs"
+(
+    "uri"        text     NOT NULL,
+    "did"        text     NOT NULL,
+    "subject"    text     NOT NULL,
+    "kind"       text     NOT NULL,
+    "list"       text     NOT NULL DEFAULT '',
+    "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pk_dataset_bluesky_graphs" PRIMARY KEY ("uri")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_dataset_bluesky_graphs_did" ON "dataset_bluesky_graphs" ("did", "kind", "created_at" DESC, "uri" DESC);
+CREATE INDEX IF NOT EXISTS "idx_dataset_bluesky_graphs_subject" ON "dataset_bluesky_graphs" ("subject", "kind", "created_at" DESC, "uri" DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS "dataset_bluesky_graphs";
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "dataset_farcaster_verifications"
+(
+    "fid"     integer NOT NULL,
+    "address" text    NOT NULL,
+
+    CONSTRAINT "pk_dataset_farcaster_verifications" PRIMARY KEY ("address", "fid")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_dataset_farcaster_verifications_fid" ON "dataset_farcaster_verifications" ("fid");
+
+INSERT OR IGNORE INTO "dataset_farcaster_verifications" ("fid", "address")
+SELECT "dataset_farcaster_profiles"."fid", "addresses"."value"
+FROM "dataset_farcaster_profiles", json_each("dataset_farcaster_profiles"."eth_addresses") AS "addresses"
+WHERE json_valid("dataset_farcaster_profiles"."eth_addresses");
+
+-- +goose Down
+DROP TABLE IF EXISTS "dataset_farcaster_verifications";
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS "idx_dataset_farcaster_profiles_username" ON "dataset_farcaster_profiles" ("username");
+
+-- +goose Down
+DROP INDEX IF EXISTS "idx_dataset_farcaster_profiles_username";
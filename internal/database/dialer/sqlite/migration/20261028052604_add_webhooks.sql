@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "webhooks"
+(
+    "id"         text     NOT NULL,
+    "url"        text     NOT NULL,
+    "secret"     text     NOT NULL,
+    "filter"     text     NOT NULL DEFAULT '{}',
+    "enabled"    boolean  NOT NULL DEFAULT TRUE,
+    "failures"   integer  NOT NULL DEFAULT 0,
+    "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pk_webhooks" PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries"
+(
+    "id"              integer  PRIMARY KEY AUTOINCREMENT,
+    "webhook_id"      text     NOT NULL,
+    "activities"      text     NOT NULL,
+    "status"          text     NOT NULL,
+    "attempts"        integer  NOT NULL DEFAULT 0,
+    "response_status" integer  NOT NULL DEFAULT 0,
+    "error"           text     NOT NULL DEFAULT '',
+    "next_attempt_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_at"      datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at"      datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_webhook_id_id" ON "webhook_deliveries" ("webhook_id", "id");
+
+-- +goose Down
+DROP TABLE IF EXISTS "webhook_deliveries";
+DROP TABLE IF EXISTS "webhooks";
@@ -0,0 +1,38 @@
+package sqlite
+
+import (
+	"github.com/rss3-network/node/v2/internal/database/model"
+)
+
+// DatasetFarcasterProfile stores the Ethereum addresses as a JSON array, because SQLite has no array type.
+// The other tables are shared with PostgreSQL.
+type DatasetFarcasterProfile struct {
+	Fid            int64    `gorm:"column:fid"`
+	Username       string   `gorm:"column:username"`
+	CustodyAddress string   `gorm:"column:custody_address"`
+	EthAddresses   []string `gorm:"column:eth_addresses;serializer:json"`
+}
+
+func (d *DatasetFarcasterProfile) TableName() string {
+	return "dataset_farcaster_profiles"
+}
+
+func (d *DatasetFarcasterProfile) Import(profile *model.Profile) (err error) {
+	d.Fid = profile.Fid
+	d.Username = profile.Username
+	d.CustodyAddress = profile.CustodyAddress
+	d.EthAddresses = profile.EthAddresses
+
+	return nil
+}
+
+func (d *DatasetFarcasterProfile) Export() (*model.Profile, error) {
+	profile := model.Profile{
+		Fid:            d.Fid,
+		Username:       d.Username,
+		CustodyAddress: d.CustodyAddress,
+		EthAddresses:   d.EthAddresses,
+	}
+
+	return &profile, nil
+}
@@ -5,4 +5,5 @@ type Driver string
 const (
 	DriverPostgreSQL Driver = "postgres"
 	DriverMySQL      Driver = "mysql"
+	DriverSQLite     Driver = "sqlite"
 )
@@ -36,6 +36,21 @@ type ActivitiesQuery struct {
 	ActionLimit    int
 }
 
+// ActivitiesSearchQuery is a full-text search of the content of activities, such as the bodies of posts and the names of tokens.
+type ActivitiesSearchQuery struct {
+	Keyword        string
+	Cursor         *activityx.Activity
+	Status         *bool
+	StartTimestamp *uint64
+	EndTimestamp   *uint64
+	Network        []network.Network
+	Tags           []tag.Tag
+	Types          []schema.Type
+	Platforms      []string
+	Limit          int
+	ActionLimit    int
+}
+
 type ActivitiesMetadataQuery struct {
 	Network        *network.Network
 	Platform       *decentralized.Platform
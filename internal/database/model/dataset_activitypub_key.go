@@ -0,0 +1,7 @@
+package model
+
+// ActivityPubKey is the key pair of the ActivityPub actor of the node.
+type ActivityPubKey struct {
+	ID         string `json:"id"`          // The URL of the actor
+	PrivateKey string `json:"private_key"` // PKCS #8 private key in PEM format
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// BlueskyGraph is an edge of the Bluesky social graph, created by a follow, block or list item record.
+type BlueskyGraph struct {
+	URI       string    `json:"uri"`            // The URI of the record creating the edge
+	DID       string    `json:"did"`            // The account owning the record
+	Subject   string    `json:"subject"`        // The account followed, blocked or added to the list
+	Kind      string    `json:"kind"`           // The collection of the record
+	List      string    `json:"list,omitempty"` // The URI of the list of a list item
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type QueryBlueskyGraphs struct {
+	DID     *string
+	Subject *string
+	Kind    string
+	Limit   *int
+	Cursor  *string // The URI of the last edge of the previous page
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rss3-network/protocol-go/schema/network"
+)
+
+// DeadLetter is a task that failed to be transformed by a worker.
+type DeadLetter struct {
+	ID        string          `json:"id"`
+	Network   network.Network `json:"network"`
+	Worker    string          `json:"worker"`
+	Error     string          `json:"error"`
+	Payload   json.RawMessage `json:"payload"`
+	Attempts  int             `json:"attempts"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// DeadLetterCursor is the primary key of the last dead letter of a page.
+type DeadLetterCursor struct {
+	ID      string
+	Network network.Network
+	Worker  string
+}
+
+type DeadLettersQuery struct {
+	Network *network.Network
+	Worker  *string
+	Cursor  *DeadLetterCursor
+	Limit   int
+}
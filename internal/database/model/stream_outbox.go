@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+)
+
+// StreamOutbox is a batch of activities committed together with the checkpoint, waiting to be published to the stream.
+type StreamOutbox struct {
+	ID         uint64                `json:"id"`
+	Activities []*activityx.Activity `json:"activities"`
+	CreatedAt  time.Time             `json:"created_at"`
+}
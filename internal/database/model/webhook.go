@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+)
+
+// Webhook is an endpoint receiving the committed activities that match its filter.
+type Webhook struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Secret is the key of the HMAC-SHA256 signature of the deliveries.
+	Secret  string        `json:"-"`
+	Filter  WebhookFilter `json:"filter"`
+	Enabled bool          `json:"enabled"`
+	// Failures is the number of consecutive failed deliveries, the webhook is disabled once it reaches the threshold.
+	Failures  int       `json:"failures"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WebhookFilter matches activities as the fields of ActivitiesQuery do, an empty field matches all activities.
+type WebhookFilter struct {
+	Owners  []string          `json:"owners,omitempty"`
+	Network []network.Network `json:"network,omitempty"`
+	Tags    []tag.Tag         `json:"tags,omitempty"`
+	// Types are the names of the types, which are parsed with the tags.
+	Types     []string             `json:"types,omitempty"`
+	Platforms []string             `json:"platforms,omitempty"`
+	Status    *bool                `json:"status,omitempty"`
+	Direction *activityx.Direction `json:"direction,omitempty"`
+}
+
+type WebhooksQuery struct {
+	Enabled *bool
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is a batch of activities delivered to a webhook, it is kept as the delivery log once finished.
+type WebhookDelivery struct {
+	ID         uint64                `json:"id"`
+	WebhookID  string                `json:"webhook_id"`
+	Activities []*activityx.Activity `json:"-"`
+	Status     WebhookDeliveryStatus `json:"status"`
+	Attempts   int                   `json:"attempts"`
+	// ResponseStatus is the status code of the last attempt, it is zero if no response was received.
+	ResponseStatus int    `json:"response_status,omitempty"`
+	Error          string `json:"error,omitempty"`
+	// NextAttemptAt is the time the pending delivery is attempted again.
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type WebhookDeliveriesQuery struct {
+	WebhookID string
+	// Cursor is the id of the last delivery of the previous page, the deliveries are ordered from the latest.
+	Cursor *uint64
+	Limit  int
+}
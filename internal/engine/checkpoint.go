@@ -2,6 +2,7 @@ package engine
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/rss3-network/protocol-go/schema/network"
@@ -15,3 +16,77 @@ type Checkpoint struct {
 	IndexCount int64           `json:"index_count"`
 	UpdatedAt  time.Time       `json:"updated_at"`
 }
+
+// Commit merges the sub-checkpoint of a stream into the state of the checkpoint.
+// The fields of the stream state replace the same fields of the checkpoint state and nested objects are merged,
+// so the fields owned by the other streams of the data source are kept as they are.
+func (c *Checkpoint) Commit(stream *StreamCheckpoint) error {
+	if stream == nil || len(stream.State) == 0 {
+		return nil
+	}
+
+	state, err := mergeState(c.State, stream.State)
+	if err != nil {
+		return fmt.Errorf("merge state of stream %s: %w", stream.Name, err)
+	}
+
+	c.State = state
+
+	return nil
+}
+
+// mergeState merges the source object into the target object recursively,
+// the source replaces the target if either of them is not an object.
+func mergeState(target, source json.RawMessage) (json.RawMessage, error) {
+	var targetFields, sourceFields map[string]json.RawMessage
+
+	if json.Unmarshal(source, &sourceFields) != nil || sourceFields == nil {
+		return source, nil
+	}
+
+	if json.Unmarshal(target, &targetFields) != nil || targetFields == nil {
+		return source, nil
+	}
+
+	for key, value := range sourceFields {
+		merged, err := mergeState(targetFields[key], value)
+		if err != nil {
+			return nil, err
+		}
+
+		targetFields[key] = merged
+	}
+
+	data, err := json.Marshal(targetFields)
+	if err != nil {
+		return nil, fmt.Errorf("marshal state: %w", err)
+	}
+
+	return data, nil
+}
+
+// StreamCheckpoint is the sub-checkpoint of one of the concurrent streams of a data source.
+// It is attached to the tasks of the stream and committed to the checkpoint only after the tasks have been saved,
+// so a stream never advances the checkpoint past the tasks of another stream that are still in flight.
+type StreamCheckpoint struct {
+	// Name identifies the stream within the data source.
+	Name string
+	// State is a JSON object holding the cursor of the stream, its fields must not overlap with other streams,
+	// except for nested objects whose keys are owned by different streams.
+	// An empty state leaves the checkpoint unchanged.
+	State json.RawMessage
+}
+
+// NewStreamCheckpoint builds the sub-checkpoint of a stream from its state.
+func NewStreamCheckpoint(name string, state any) (*StreamCheckpoint, error) {
+	if state == nil {
+		return &StreamCheckpoint{Name: name}, nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("marshal state of stream %s: %w", name, err)
+	}
+
+	return &StreamCheckpoint{Name: name, State: data}, nil
+}
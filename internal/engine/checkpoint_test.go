@@ -0,0 +1,58 @@
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpoint_Commit(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name   string
+		state  json.RawMessage
+		stream *engine.StreamCheckpoint
+		want   string
+	}{
+		{
+			name:   "Empty checkpoint",
+			state:  nil,
+			stream: &engine.StreamCheckpoint{Name: "events", State: json.RawMessage(`{"event_id":2}`)},
+			want:   `{"event_id":2}`,
+		},
+		{
+			name:   "Keep the fields of other streams",
+			state:  json.RawMessage(`{"casts_fid":10,"event_id":1}`),
+			stream: &engine.StreamCheckpoint{Name: "events", State: json.RawMessage(`{"event_id":2}`)},
+			want:   `{"casts_fid":10,"event_id":2}`,
+		},
+		{
+			name:   "Merge nested objects",
+			state:  json.RawMessage(`{"relay_cursors":{"wss://a":1,"wss://b":5}}`),
+			stream: &engine.StreamCheckpoint{Name: "wss://a", State: json.RawMessage(`{"relay_cursors":{"wss://a":3}}`)},
+			want:   `{"relay_cursors":{"wss://a":3,"wss://b":5}}`,
+		},
+		{
+			name:   "Stream without state",
+			state:  json.RawMessage(`{"event_id":1}`),
+			stream: &engine.StreamCheckpoint{Name: "events"},
+			want:   `{"event_id":1}`,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			checkpoint := engine.Checkpoint{State: testcase.state}
+
+			require.NoError(t, checkpoint.Commit(testcase.stream))
+			require.JSONEq(t, testcase.want, string(checkpoint.State))
+		})
+	}
+}
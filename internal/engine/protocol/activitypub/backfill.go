@@ -0,0 +1,149 @@
+package activitypub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/provider/activitypub"
+	"github.com/rss3-network/node/v2/provider/activitypub/mastodon"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// backfillMessageTypes are the outbox activities indexed by the backfill.
+var backfillMessageTypes = []string{
+	mastodon.MessageTypeCreate.String(),
+	mastodon.MessageTypeAnnounce.String(),
+}
+
+// scheduleBackfill backfills the accounts on start, and then on every interval if it is configured.
+func (s *dataSource) scheduleBackfill(ctx context.Context, tasksChan chan<- *engine.Tasks) {
+	if err := s.backfillAccounts(ctx, tasksChan); err != nil {
+		zap.L().Error("failed to backfill accounts", zap.Error(err))
+	}
+
+	if s.option.BackfillInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Duration(s.option.BackfillInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.backfillAccounts(ctx, tasksChan); err != nil {
+				zap.L().Error("failed to backfill accounts", zap.Error(err))
+			}
+		}
+	}
+}
+
+// backfillAccounts backfills the outbox of the accounts one by one,
+// an account that fails to be backfilled does not stop the others.
+func (s *dataSource) backfillAccounts(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+	var errs []error
+
+	for _, handle := range s.option.BackfillAccounts {
+		if err := s.backfillAccount(ctx, handle, tasksChan); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			zap.L().Error("failed to backfill account", zap.String("handle", handle), zap.Error(err))
+
+			errs = append(errs, fmt.Errorf("backfill account %s: %w", handle, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// backfillAccount resolves the account via WebFinger and pushes the Create and Announce activities of its outbox
+// to the worker, the outbox is paged from the newest activities until the start timestamp is reached.
+func (s *dataSource) backfillAccount(ctx context.Context, handle string, tasksChan chan<- *engine.Tasks) error {
+	actorID, err := s.mastodonClient.LookupAccount(ctx, handle)
+	if err != nil {
+		return fmt.Errorf("lookup account: %w", err)
+	}
+
+	since := s.backfillSince()
+
+	zap.L().Info("starting to backfill account",
+		zap.String("handle", handle),
+		zap.String("actor", actorID),
+		zap.Time("since", since))
+
+	var count int
+
+	handler := func(activities []activitypub.Object) (bool, error) {
+		var (
+			tasks      engine.Tasks
+			reachedEnd bool
+		)
+
+		for _, activity := range activities {
+			if !lo.Contains(backfillMessageTypes, activity.Type) {
+				continue
+			}
+
+			published, err := time.Parse(time.RFC3339, activity.Published)
+			if err != nil {
+				continue
+			}
+
+			if published.Before(since) {
+				reachedEnd = true
+
+				continue
+			}
+
+			// The same IDs as the activities received from the relays, so the activities are not duplicated.
+			activity.ID = strings.TrimSuffix(activity.ID, mastodon.ActivitySuffix)
+
+			if activityTasks := s.buildMastodonMessageTasks(ctx, activity); activityTasks != nil {
+				tasks.Tasks = append(tasks.Tasks, activityTasks.Tasks...)
+			}
+		}
+
+		if len(tasks.Tasks) > 0 {
+			tasks.State = s.State()
+
+			select {
+			case tasksChan <- &tasks:
+				count += len(tasks.Tasks)
+			case <-ctx.Done():
+				return false, ctx.Err()
+			}
+		}
+
+		// The outbox is ordered from the newest activities, so the next pages are older than the start timestamp.
+		return !reachedEnd, nil
+	}
+
+	if err := s.mastodonClient.FetchOutbox(ctx, actorID, handler); err != nil {
+		return fmt.Errorf("fetch outbox: %w", err)
+	}
+
+	zap.L().Info("backfilled account", zap.String("handle", handle), zap.Int("tasks", count))
+
+	return nil
+}
+
+// backfillSince returns the start timestamp of the backfill, which is bounded by
+// the retention of the ActivityPub activities.
+func (s *dataSource) backfillSince() time.Time {
+	since := time.Unix(s.option.TimestampStart, 0)
+
+	if cutoff := time.Now().AddDate(0, -3, 0); since.Before(cutoff) {
+		return cutoff
+	}
+
+	return since
+}
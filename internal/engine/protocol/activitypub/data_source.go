@@ -2,6 +2,7 @@ package activitypub
 
 import (
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/rss3-network/node/v2/config"
 	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/model"
 	"github.com/rss3-network/node/v2/internal/engine"
 	"github.com/rss3-network/node/v2/provider/activitypub"
 	"github.com/rss3-network/node/v2/provider/activitypub/mastodon"
@@ -25,6 +27,15 @@ import (
 var _ engine.DataSource = (*dataSource)(nil)
 var defaultStartTime int64
 
+// receivedMessageTypes are the message types timestamped by the node when they are received.
+var receivedMessageTypes = []string{
+	mastodon.MessageTypeUpdate.String(),
+	mastodon.MessageTypeDelete.String(),
+	mastodon.MessageTypeLike.String(),
+	mastodon.MessageTypeFollow.String(),
+	mastodon.MessageTypeUndo.String(),
+}
+
 // dataSource implements the engine.DataSource interface.
 type dataSource struct {
 	config         *config.Module
@@ -54,6 +65,27 @@ func (s *dataSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks,
 		return
 	}
 
+	if s.option.BackfillOnly {
+		go func() {
+			err := s.backfillAccounts(ctx, tasksChan)
+			if err != nil {
+				err = fmt.Errorf("failed to backfill accounts: %w", err)
+			}
+
+			// A nil error notifies the indexer that the data source has finished.
+			select {
+			case errorChan <- err:
+			case <-ctx.Done():
+			}
+		}()
+
+		return
+	}
+
+	if len(s.option.BackfillAccounts) > 0 {
+		go s.scheduleBackfill(ctx, tasksChan)
+	}
+
 	go func() {
 		// Check if context is cancelled before we start
 		if ctx.Err() != nil {
@@ -106,6 +138,8 @@ func (s *dataSource) processMessages(ctx context.Context, tasksChan chan<- *engi
 				tasks := s.handleMessage(ctx, msg)
 
 				if tasks != nil {
+					tasks.State = s.State()
+
 					tasksChan <- tasks
 
 					zap.L().Info("sent message to tasks channel", zap.Int("totalTasks", len(tasks.Tasks)))
@@ -219,7 +253,19 @@ func isRelayMessage(id string) bool {
 // initialize creates and configures the Mastodon client.
 // It returns an error if the client creation fails.
 func (s *dataSource) initialize(ctx context.Context, errorChan chan<- error) (err error) {
-	client, err := mastodon.NewClient(ctx, s.config.Endpoint.URL, s.option.RelayURLList, s.option.Port, errorChan)
+	privateKey, err := s.loadActorKey(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load actor key: %w", err)
+	}
+
+	relayURLList, port := s.option.RelayURLList, s.option.Port
+
+	// A backfill only fetches from remote servers, the inbox is served by the live node.
+	if s.option.BackfillOnly {
+		relayURLList, port = nil, 0
+	}
+
+	client, err := mastodon.NewClient(ctx, s.config.Endpoint.URL, relayURLList, port, privateKey, errorChan)
 	if err != nil {
 		return fmt.Errorf("failed to create activitypub client: %w", err)
 	}
@@ -232,6 +278,46 @@ func (s *dataSource) initialize(ctx context.Context, errorChan chan<- error) (er
 	return nil
 }
 
+// loadActorKey loads the key of the actor from the database, the key is created and saved on the first start,
+// so relays and remote servers see the same actor key after the node restarts.
+func (s *dataSource) loadActorKey(ctx context.Context) (*rsa.PrivateKey, error) {
+	if s.databaseClient == nil {
+		return nil, nil
+	}
+
+	actorID := s.config.Endpoint.URL
+
+	key, err := s.databaseClient.LoadDatasetActivityPubKey(ctx, actorID)
+	if err != nil {
+		return nil, fmt.Errorf("load actor key: %w", err)
+	}
+
+	if key != nil {
+		return mastodon.ParsePrivateKey(key.PrivateKey)
+	}
+
+	privateKey, err := mastodon.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPem, err := mastodon.MarshalPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.databaseClient.SaveDatasetActivityPubKey(ctx, &model.ActivityPubKey{
+		ID:         actorID,
+		PrivateKey: privateKeyPem,
+	}); err != nil {
+		return nil, fmt.Errorf("save actor key: %w", err)
+	}
+
+	zap.L().Info("created actor key", zap.String("actor", actorID))
+
+	return privateKey, nil
+}
+
 // buildMastodonMessageTasks processes the relay message and creates tasks for the engine
 func (s *dataSource) buildMastodonMessageTasks(_ context.Context, object activitypub.Object) *engine.Tasks {
 	var tasks engine.Tasks
@@ -243,6 +329,12 @@ func (s *dataSource) buildMastodonMessageTasks(_ context.Context, object activit
 		return nil
 	}
 
+	// Update, Delete, Like, Follow and Undo activities usually have no published timestamp,
+	// they take effect at the time they are received.
+	if object.Published == "" && lo.Contains(receivedMessageTypes, object.Type) {
+		object.Published = time.Now().UTC().Format(time.RFC3339)
+	}
+
 	if object.Published == "" {
 		zap.L().Debug("skipping mastodon message object with missing published timestamp",
 			zap.String("objectID", object.ID),
@@ -13,6 +13,12 @@ type Option struct {
 	RelayURLList   []string `json:"relay_url_list"`
 	Port           int64    `json:"port"`
 	TimestampStart int64    `json:"timestamp_start" mapstructure:"timestamp_start"`
+	// BackfillAccounts are the handles (@username@domain) of the accounts whose outbox is backfilled.
+	BackfillAccounts []string `json:"backfill_accounts" mapstructure:"backfill_accounts"`
+	// BackfillInterval is the interval in seconds between the backfills, the accounts are only backfilled on start if it is zero.
+	BackfillInterval int64 `json:"backfill_interval" mapstructure:"backfill_interval"`
+	// BackfillOnly stops the data source once the accounts are backfilled, without listening to the relays.
+	BackfillOnly bool `json:"backfill_only" mapstructure:"backfill_only"`
 }
 
 // NewOption creates a new Option instance from the provided parameters.
@@ -275,13 +275,15 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 			zap.Int("block_count", len(blocks)),
 			zap.Int("transaction_count", len(transactions)))
 
-		// TODO It might be possible to use generics to avoid manual type assertions.
-		tasksChan <- tasks
-
 		// Update block height to state.
 		s.state.BlockHeight = blockHeightEnd
 		zap.L().Debug("updated state block height",
 			zap.Uint64("new_block_height", blockHeightEnd))
+
+		tasks.State = s.State()
+
+		// TODO It might be possible to use generics to avoid manual type assertions.
+		tasksChan <- tasks
 	}
 
 	return nil
@@ -378,13 +380,15 @@ func (s *dataSource) pollTransactionsFromIrys(ctx context.Context, tasksChan cha
 			zap.Int("block_count", len(blocks)),
 			zap.Int("transaction_count", len(transactions)))
 
-		// TODO It might be possible to use generics to avoid manual type assertions.
-		tasksChan <- tasks
-
 		// Update cursor to state.
 		s.state.Cursor = transactionsResponse.Transactions.PageInfo.EndCursor
 		zap.L().Debug("updated state cursor",
 			zap.String("new_cursor", s.state.Cursor))
+
+		tasks.State = s.State()
+
+		// TODO It might be possible to use generics to avoid manual type assertions.
+		tasksChan <- tasks
 	}
 }
 
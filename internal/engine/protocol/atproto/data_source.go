@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go/v4"
 	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/atproto/syntax"
 	"github.com/bluesky-social/indigo/events"
 	"github.com/bluesky-social/indigo/events/schedulers/sequential"
 	"github.com/gorilla/websocket"
@@ -21,12 +25,26 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// streamSubscribeRepos is the prefix of the streams subscribing to the relays, followed by the relay.
+	streamSubscribeRepos = "subscribe_repos"
+	streamListRepos      = "list_repos"
+	streamJetstream      = "jetstream"
+
+	// jetstreamBatchSize and jetstreamFlushInterval bound the batches of the records consumed from Jetstream.
+	jetstreamBatchSize     = 100
+	jetstreamFlushInterval = time.Second
+)
+
 var _ engine.DataSource = (*dataSource)(nil)
 
 type dataSource struct {
-	config         *config.Module
-	filter         *Filter
+	config *config.Module
+	filter *Filter
+	// The state holds the progress of the streams and is updated by their goroutines, so it is guarded by the mutex.
+	// Each stream attaches its own sub-checkpoint to its tasks, which is committed once the tasks have been saved.
 	state          State
+	stateMutex     sync.RWMutex
 	option         *Option
 	client         *bluesky.Client
 	databaseClient database.Client
@@ -37,6 +55,9 @@ func (s *dataSource) Network() network.Network {
 }
 
 func (s *dataSource) State() json.RawMessage {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+
 	return lo.Must(json.Marshal(s.state))
 }
 
@@ -48,18 +69,36 @@ func (s *dataSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks,
 		return
 	}
 
-	// Get latest events
-	go func() {
-		if err := s.retrySource(ctx, tasksChan, s.pollSubscribeRepos); err != nil {
-			zap.L().Error("poll subscribe repos failed", zap.Error(err))
+	switch s.option.Mode {
+	case ModeJetstream:
+		// Get latest events from the Jetstream instance
+		go func() {
+			if err := s.retrySource(ctx, tasksChan, s.pollJetstream); err != nil && ctx.Err() == nil {
+				zap.L().Error("poll jetstream failed", zap.Error(err))
 
-			errorChan <- fmt.Errorf("poll subscribe repos failed: %w", err)
+				errorChan <- fmt.Errorf("poll jetstream failed: %w", err)
+			}
+		}()
+	default:
+		// Get latest events from every relay, each relay is an independent stream with its own cursor.
+		for _, relay := range s.option.Relays {
+			relay := relay
+
+			go func() {
+				if err := s.retrySource(ctx, tasksChan, func(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+					return s.pollSubscribeRepos(ctx, relay, tasksChan)
+				}); err != nil && ctx.Err() == nil {
+					zap.L().Error("poll subscribe repos failed", zap.String("relay", relay), zap.Error(err))
+
+					errorChan <- fmt.Errorf("poll subscribe repos of %s failed: %w", relay, err)
+				}
+			}()
 		}
-	}()
+	}
 
 	// Get historical repos
 	go func() {
-		if err := s.retrySource(ctx, tasksChan, s.pollListRepos); err != nil {
+		if err := s.retrySource(ctx, tasksChan, s.pollListRepos); err != nil && ctx.Err() == nil {
 			zap.L().Error("poll list repos failed", zap.Error(err))
 
 			errorChan <- fmt.Errorf("poll list repos failed: %w", err)
@@ -67,66 +106,83 @@ func (s *dataSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks,
 	}()
 }
 
-// pollSubscribeRepos subscribes to repository events and processes them.
-func (s *dataSource) pollSubscribeRepos(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
-	uri := bluesky.BskySubscribeURI
-
-	if lo.IsNotEmpty(s.state.SubscribeCursor) {
-		uri = fmt.Sprintf("%s?cursor=%d", uri, s.state.SubscribeCursor)
-	} else {
-		uri = fmt.Sprintf("%s?cursor=1", uri)
+// pollSubscribeRepos subscribes to the repository events of a relay and processes them,
+// the subscription resumes from the cursor of the last event sent to the indexer.
+func (s *dataSource) pollSubscribeRepos(ctx context.Context, relay string, tasksChan chan<- *engine.Tasks) error {
+	uri, err := url.Parse(relay)
+	if err != nil {
+		return fmt.Errorf("parse relay url failed: %w", err)
 	}
 
-	conn, _, err := websocket.DefaultDialer.Dial(uri, nil)
+	cursor := s.relayCursor(relay)
+
+	uri.RawQuery = url.Values{"cursor": []string{strconv.FormatInt(lo.Ternary(cursor > 0, cursor, 1), 10)}}.Encode()
+
+	zap.L().Info("subscribe repos", zap.String("relay", relay), zap.Int64("cursor", cursor))
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, uri.String(), nil)
 	if err != nil {
-		zap.L().Error("dial websocket failed", zap.Error(err))
+		zap.L().Error("dial websocket failed", zap.String("relay", relay), zap.Error(err))
 
 		return fmt.Errorf("dial websocket failed: %w", err)
 	}
 
 	defer func() {
-		zap.L().Info("close websocket connection")
+		zap.L().Info("close websocket connection", zap.String("relay", relay))
 
 		_ = conn.Close()
 	}()
 
+	stream := fmt.Sprintf("%s:%s", streamSubscribeRepos, relay)
+
 	rsc := &events.RepoStreamCallbacks{
 		RepoCommit: func(evt *atproto.SyncSubscribeRepos_Commit) error {
-			messages := make([]*at.Message, 0)
+			// Records are decoded from the blocks carried by the commit.
+			messages, err := s.client.ParseCommit(ctx, evt)
+			if err != nil {
+				zap.L().Error("parse commit failed", zap.Error(err), zap.String("repo", evt.Repo), zap.Int64("seq", evt.Seq))
 
-			for _, op := range evt.Ops {
-				if op.Cid == nil {
-					continue
-				}
+				return fmt.Errorf("parse commit failed: %w", err)
+			}
 
-				// Parse the operation
-				message, err := s.client.GetRepoRecord(ctx, evt.Repo, op.Path)
-				if err != nil {
-					zap.L().Error("get subscribe repo record failed", zap.Error(err), zap.String("repo", evt.Repo), zap.Any("op", op))
+			if len(messages) > 0 {
+				state := subscribeReposState{
+					SubscribeTimestamp: messages[len(messages)-1].CreatedAt.Unix(),
+					RelayCursors:       map[string]int64{relay: evt.Seq},
+				}
 
-					return fmt.Errorf("get subscribe repo record failed: %w", err)
+				if err := s.sendTasks(ctx, tasksChan, s.buildTasks(ctx, messages), stream, state); err != nil {
+					return err
 				}
 
-				if message != nil {
-					messages = append(messages, message)
+				s.stateMutex.Lock()
+				s.state.SubscribeTimestamp = state.SubscribeTimestamp
+
+				if s.state.RelayCursors == nil {
+					s.state.RelayCursors = make(map[string]int64)
 				}
-			}
 
-			if len(messages) > 0 {
-				tasksChan <- s.buildTasks(ctx, messages)
+				s.state.RelayCursors[relay] = evt.Seq
+				s.stateMutex.Unlock()
+			}
 
-				s.state.SubscribeCursor = evt.Seq
-				s.state.SubscribeTimestamp = messages[len(messages)-1].CreatedAt.Unix()
+			return nil
+		},
+		RepoIdentity: func(evt *atproto.SyncSubscribeRepos_Identity) error {
+			// The cached handle is stale once the account has changed its handle.
+			if did, err := syntax.ParseDID(evt.Did); err == nil {
+				s.client.EvictHandle(did)
 			}
 
 			return nil
 		},
 	}
 
-	sched := sequential.NewScheduler("myfirehose", rsc.EventHandler)
+	sched := sequential.NewScheduler(relay, rsc.EventHandler)
 
-	if err = events.HandleRepoStream(context.Background(), conn, sched, nil); err != nil {
-		zap.L().Error("handle repo stream failed", zap.Error(err))
+	// The connection is closed once the context is canceled.
+	if err = events.HandleRepoStream(ctx, conn, sched, nil); err != nil {
+		zap.L().Error("handle repo stream failed", zap.String("relay", relay), zap.Error(err))
 
 		return fmt.Errorf("handle repo stream failed: %w", err)
 	}
@@ -134,14 +190,154 @@ func (s *dataSource) pollSubscribeRepos(ctx context.Context, tasksChan chan<- *e
 	return nil
 }
 
-// pollListRepos polls the list of repositories and processes them.
-func (s *dataSource) pollListRepos(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+// pollJetstream consumes the events of the Jetstream instance filtered by the collections of the worker,
+// the records are batched to avoid saving a checkpoint for every event.
+func (s *dataSource) pollJetstream(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+	s.stateMutex.RLock()
+	cursor := s.state.JetstreamCursor
+	s.stateMutex.RUnlock()
+
+	uri, err := bluesky.BuildJetstreamURI(s.option.Jetstream, s.filter.Type, cursor)
+	if err != nil {
+		return fmt.Errorf("build jetstream uri failed: %w", err)
+	}
+
+	zap.L().Info("subscribe jetstream", zap.String("jetstream", s.option.Jetstream), zap.Int64("cursor", cursor))
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, uri, nil)
+	if err != nil {
+		zap.L().Error("dial websocket failed", zap.String("jetstream", s.option.Jetstream), zap.Error(err))
+
+		return fmt.Errorf("dial websocket failed: %w", err)
+	}
+
+	defer func() {
+		zap.L().Info("close websocket connection", zap.String("jetstream", s.option.Jetstream))
+
+		_ = conn.Close()
+	}()
+
+	readCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		eventsChan = make(chan *bluesky.JetstreamEvent, jetstreamBatchSize)
+		readChan   = make(chan error, 1)
+	)
+
+	// Read the events in the background, the connection is closed once the context is canceled.
+	go func() {
+		for {
+			var event bluesky.JetstreamEvent
+
+			if err := conn.ReadJSON(&event); err != nil {
+				readChan <- err
+
+				return
+			}
+
+			select {
+			case eventsChan <- &event:
+			case <-readCtx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		<-readCtx.Done()
+
+		_ = conn.Close()
+	}()
+
+	ticker := time.NewTicker(jetstreamFlushInterval)
+	defer ticker.Stop()
+
+	var (
+		messages = make([]*at.Message, 0, jetstreamBatchSize)
+		state    jetstreamState
+	)
+
+	flush := func() error {
+		if len(messages) == 0 {
+			return nil
+		}
+
+		state.SubscribeTimestamp = messages[len(messages)-1].CreatedAt.Unix()
+
+		if err := s.sendTasks(ctx, tasksChan, s.buildTasks(ctx, messages), streamJetstream, state); err != nil {
+			return err
+		}
+
+		s.stateMutex.Lock()
+		s.state.JetstreamCursor, s.state.SubscribeTimestamp = state.JetstreamCursor, state.SubscribeTimestamp
+		s.stateMutex.Unlock()
+
+		messages = make([]*at.Message, 0, jetstreamBatchSize)
+
+		return nil
+	}
+
 	for {
-		var cursor string
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-readChan:
+			if flushErr := flush(); flushErr != nil {
+				return flushErr
+			}
+
+			return fmt.Errorf("read jetstream event failed: %w", err)
+		case event := <-eventsChan:
+			message, err := s.client.ParseJetstreamEvent(ctx, event)
+			if err != nil {
+				zap.L().Error("parse jetstream event failed", zap.Error(err), zap.String("did", event.Did), zap.Int64("time_us", event.TimeUS))
+
+				return fmt.Errorf("parse jetstream event failed: %w", err)
+			}
+
+			state.JetstreamCursor = event.TimeUS
 
-		if lo.IsNotEmpty(s.state.ListReposCursor) {
-			cursor = s.state.ListReposCursor
+			if message != nil {
+				messages = append(messages, message)
+			}
+
+			if len(messages) >= jetstreamBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return err
+			}
 		}
+	}
+}
+
+// relayCursor returns the cursor of the last event of the relay sent to the indexer,
+// the default relay falls back to the cursor kept before the cursors were kept per relay.
+func (s *dataSource) relayCursor(relay string) int64 {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+
+	if cursor, ok := s.state.RelayCursors[relay]; ok {
+		return cursor
+	}
+
+	if relay == bluesky.BskySubscribeURI {
+		return s.state.SubscribeCursor
+	}
+
+	return 0
+}
+
+// pollListRepos polls the list of repositories and processes them.
+func (s *dataSource) pollListRepos(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+	for {
+		s.stateMutex.RLock()
+		cursor := s.state.ListReposCursor
+		s.stateMutex.RUnlock()
 
 		repos, next, err := s.client.SyncListRepos(ctx, cursor, bluesky.SyncListReposLimit)
 		if err != nil {
@@ -151,13 +347,35 @@ func (s *dataSource) pollListRepos(ctx context.Context, tasksChan chan<- *engine
 		}
 
 		if len(repos) > 0 {
-			tasksChan <- s.buildTasks(ctx, repos)
+			state := listReposState{
+				ListReposCursor: lo.FromPtr(next),
+			}
 
-			s.state.ListReposCursor = lo.FromPtr(next)
+			if err := s.sendTasks(ctx, tasksChan, s.buildTasks(ctx, repos), streamListRepos, state); err != nil {
+				return err
+			}
+
+			s.stateMutex.Lock()
+			s.state.ListReposCursor = state.ListReposCursor
+			s.stateMutex.Unlock()
 		}
 	}
 }
 
+// sendTasks attaches the sub-checkpoint of the stream to the tasks and sends them to the indexer.
+func (s *dataSource) sendTasks(ctx context.Context, tasksChan chan<- *engine.Tasks, tasks *engine.Tasks, stream string, state any) (err error) {
+	if tasks.Checkpoint, err = engine.NewStreamCheckpoint(stream, state); err != nil {
+		return fmt.Errorf("build checkpoint of stream %s: %w", stream, err)
+	}
+
+	select {
+	case tasksChan <- tasks:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // buildTasks constructs tasks from the given messages.
 func (s *dataSource) buildTasks(_ context.Context, messages []*at.Message) *engine.Tasks {
 	var tasks engine.Tasks
@@ -185,9 +403,13 @@ func (s *dataSource) retrySource(ctx context.Context, tasksChan chan<- *engine.T
 		func() error {
 			return sourceFunc(ctx, tasksChan)
 		},
+		retry.Context(ctx),
 		retry.Attempts(0),
-		retry.Delay(5*time.Second),
-		retry.DelayType(retry.BackOffDelay),
+		retry.Delay(time.Second),
+		// Add jitter to the backoff, so the streams do not reconnect to the relays at the same time.
+		retry.DelayType(retry.CombineDelay(retry.BackOffDelay, retry.RandomDelay)),
+		retry.MaxJitter(5*time.Second),
+		retry.MaxDelay(time.Minute),
 		retry.OnRetry(func(n uint, err error) {
 			zap.L().Warn("retry bluesky source", zap.Uint("retry", n), zap.Error(err))
 		}),
@@ -2,10 +2,13 @@ package atproto
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/rss3-network/node/v2/config"
 	"github.com/rss3-network/node/v2/config/parameter"
+	"github.com/rss3-network/node/v2/provider/atproto/bluesky"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -16,13 +19,38 @@ type Option struct {
 	Password string `json:"password" mapstructure:"password"`
 
 	TimestampStart time.Time `json:"timestamp_start" mapstructure:"timestamp_start"`
+
+	// Relays are the relays or PDSes to subscribe to the repository events from, including self-hosted ones.
+	// A relay can be given as a host or as the full URL of its subscribeRepos endpoint.
+	Relays []string `json:"relays" mapstructure:"relays"`
+
+	// Mode selects how the latest repository events are consumed.
+	Mode Mode `json:"mode" mapstructure:"mode"`
+	// Jetstream is the subscription URL of the Jetstream instance consumed in the jetstream mode.
+	Jetstream string `json:"jetstream" mapstructure:"jetstream"`
 }
 
+type Mode string
+
+const (
+	// ModeFirehose decodes the CBOR repository streams of the relays.
+	ModeFirehose Mode = "firehose"
+	// ModeJetstream consumes the JSON events of a Jetstream instance, which is lighter for small nodes.
+	ModeJetstream Mode = "jetstream"
+)
+
+// subscribeReposPath is the path of the firehose endpoint of a relay or PDS.
+const subscribeReposPath = "/xrpc/com.atproto.sync.subscribeRepos"
+
 func NewOption(parameters *config.Parameters) (*Option, error) {
 	var option Option
 
 	if parameters == nil {
-		return &Option{}, nil
+		return &Option{
+			Relays:    []string{bluesky.BskySubscribeURI},
+			Mode:      ModeFirehose,
+			Jetstream: bluesky.JetstreamURI,
+		}, nil
 	}
 
 	if err := parameters.Decode(&option); err != nil {
@@ -31,6 +59,33 @@ func NewOption(parameters *config.Parameters) (*Option, error) {
 		return nil, fmt.Errorf("decode parameters failed: %w", err)
 	}
 
+	switch option.Mode {
+	case "":
+		option.Mode = ModeFirehose
+	case ModeFirehose, ModeJetstream:
+	default:
+		return nil, fmt.Errorf("unsupported mode %s", option.Mode)
+	}
+
+	if option.Jetstream == "" {
+		option.Jetstream = bluesky.JetstreamURI
+	}
+
+	if len(option.Relays) == 0 {
+		option.Relays = []string{bluesky.BskySubscribeURI}
+	}
+
+	for index, relay := range option.Relays {
+		uri, err := buildSubscribeURI(relay)
+		if err != nil {
+			return nil, fmt.Errorf("invalid relay %s: %w", relay, err)
+		}
+
+		option.Relays[index] = uri
+	}
+
+	option.Relays = lo.Uniq(option.Relays)
+
 	if lo.IsEmpty(option.TimestampStart) {
 		if parameter.CurrentNetworkStartBlock[network.Bluesky] == nil {
 			// Default to 90 days ago
@@ -44,3 +99,37 @@ func NewOption(parameters *config.Parameters) (*Option, error) {
 
 	return &option, nil
 }
+
+// buildSubscribeURI builds the websocket URL of the firehose endpoint of a relay or PDS.
+func buildSubscribeURI(relay string) (string, error) {
+	if !strings.Contains(relay, "://") {
+		relay = "wss://" + relay
+	}
+
+	uri, err := url.Parse(relay)
+	if err != nil {
+		return "", fmt.Errorf("parse url: %w", err)
+	}
+
+	switch uri.Scheme {
+	case "wss", "ws":
+	case "https":
+		uri.Scheme = "wss"
+	case "http":
+		uri.Scheme = "ws"
+	default:
+		return "", fmt.Errorf("unsupported scheme %s", uri.Scheme)
+	}
+
+	if uri.Host == "" {
+		return "", fmt.Errorf("missing host")
+	}
+
+	if strings.Trim(uri.Path, "/") == "" {
+		uri.Path = subscribeReposPath
+	}
+
+	uri.RawQuery, uri.Fragment = "", ""
+
+	return uri.String(), nil
+}
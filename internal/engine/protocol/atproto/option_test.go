@@ -0,0 +1,101 @@
+package atproto
+
+import (
+	"testing"
+
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/provider/atproto/bluesky"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSubscribeURI(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		relay     string
+		want      string
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name:      "Host",
+			relay:     "bsky.network",
+			want:      "wss://bsky.network/xrpc/com.atproto.sync.subscribeRepos",
+			wantError: require.NoError,
+		},
+		{
+			name:      "Self-hosted PDS",
+			relay:     "http://localhost:2583/",
+			want:      "ws://localhost:2583/xrpc/com.atproto.sync.subscribeRepos",
+			wantError: require.NoError,
+		},
+		{
+			name:      "Full URL",
+			relay:     "wss://bsky.network/xrpc/com.atproto.sync.subscribeRepos?cursor=1",
+			want:      "wss://bsky.network/xrpc/com.atproto.sync.subscribeRepos",
+			wantError: require.NoError,
+		},
+		{
+			name:      "Unsupported scheme",
+			relay:     "ftp://bsky.network",
+			wantError: require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			uri, err := buildSubscribeURI(testcase.relay)
+			testcase.wantError(t, err)
+			require.Equal(t, testcase.want, uri)
+		})
+	}
+}
+
+func TestNewOption(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		parameters *config.Parameters
+		want       Mode
+		wantError  require.ErrorAssertionFunc
+	}{
+		{
+			name:       "Default mode",
+			parameters: &config.Parameters{},
+			want:       ModeFirehose,
+			wantError:  require.NoError,
+		},
+		{
+			name:       "Jetstream mode",
+			parameters: &config.Parameters{"mode": "jetstream"},
+			want:       ModeJetstream,
+			wantError:  require.NoError,
+		},
+		{
+			name:       "Unsupported mode",
+			parameters: &config.Parameters{"mode": "polling"},
+			wantError:  require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			option, err := NewOption(testcase.parameters)
+			testcase.wantError(t, err)
+
+			if err == nil {
+				require.Equal(t, testcase.want, option.Mode)
+				require.Equal(t, bluesky.JetstreamURI, option.Jetstream)
+			}
+		})
+	}
+}
@@ -1,7 +1,29 @@
 package atproto
 
 type State struct {
-	SubscribeCursor    int64  `json:"subscribe_cursor,omitempty"`
-	SubscribeTimestamp int64  `json:"subscribe_timestamp,omitempty"`
-	ListReposCursor    string `json:"list_repos_cursor,omitempty"`
+	// SubscribeCursor is the cursor of the default relay before the cursors were kept per relay.
+	SubscribeCursor    int64            `json:"subscribe_cursor,omitempty"`
+	SubscribeTimestamp int64            `json:"subscribe_timestamp,omitempty"`
+	RelayCursors       map[string]int64 `json:"relay_cursors,omitempty"`
+	JetstreamCursor    int64            `json:"jetstream_cursor,omitempty"`
+	ListReposCursor    string           `json:"list_repos_cursor,omitempty"`
+}
+
+// subscribeReposState is the sub-checkpoint of the stream subscribing to the repository events of a relay,
+// the sequence numbers are specific to each relay, so every relay keeps its own cursor.
+type subscribeReposState struct {
+	SubscribeTimestamp int64            `json:"subscribe_timestamp,omitempty"`
+	RelayCursors       map[string]int64 `json:"relay_cursors,omitempty"`
+}
+
+// jetstreamState is the sub-checkpoint of the stream consuming the events of a Jetstream instance,
+// the cursor is the time of the last event in microseconds, which is portable across instances.
+type jetstreamState struct {
+	SubscribeTimestamp int64 `json:"subscribe_timestamp,omitempty"`
+	JetstreamCursor    int64 `json:"jetstream_cursor,omitempty"`
+}
+
+// listReposState is the sub-checkpoint of the stream listing the historical repositories.
+type listReposState struct {
+	ListReposCursor string `json:"list_repos_cursor,omitempty"`
 }
@@ -19,7 +19,14 @@ type Task struct {
 }
 
 func (t Task) ID() string {
-	return strings.TrimPrefix(t.Message.URI, "at://")
+	id := strings.TrimPrefix(t.Message.URI, "at://")
+
+	// The deletion of a record must not overwrite the activity of its creation.
+	if t.Message.Deleted {
+		return id + "#delete"
+	}
+
+	return id
 }
 
 func (t Task) GetNetwork() network.Network {
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"sort"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go/v4"
@@ -39,6 +40,7 @@ type dataSource struct {
 	ethereumClient ethereum.Client
 	redisClient    rueidis.Client
 	state          State
+	stateMutex     sync.RWMutex
 }
 
 func (s *dataSource) Network() network.Network {
@@ -46,6 +48,9 @@ func (s *dataSource) Network() network.Network {
 }
 
 func (s *dataSource) State() json.RawMessage {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+
 	return lo.Must(json.Marshal(s.state))
 }
 
@@ -81,9 +86,9 @@ func (s *dataSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks,
 				zap.L().Error("retry ethereum dataSource start", zap.Uint("retry", n), zap.Error(err))
 			}),
 		)
-		if err != nil {
-			errorChan <- err
-		}
+
+		// A nil error means that the specified block range has been indexed.
+		errorChan <- err
 	}()
 
 	zap.L().Info("successfully started ethereum data source")
@@ -103,7 +108,7 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 
 	// Set the block number to the start block number if it is greater than the current block number.
 	if s.option.BlockStart != nil && s.option.BlockStart.Uint64() > s.state.BlockNumber {
-		s.state.BlockNumber = s.option.BlockStart.Uint64()
+		s.skipToBlock(s.option.BlockStart.Uint64())
 		zap.L().Debug("updated block number from start block",
 			zap.Uint64("block.number.start", s.option.BlockStart.Uint64()),
 			zap.Uint64("block.number.current", s.state.BlockNumber))
@@ -112,9 +117,9 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 	for {
 		ctx, span := otel.Tracer("").Start(ctx, "DataSource pollBlocks", trace.WithSpanKind(trace.SpanKindProducer))
 
-		// Stop the dataSource if the block number target is not nil and the current block number is greater than the target
-		// block number. This is useful when the dataSource is used to index a specific range of blocks.
-		if s.option.BlockTarget != nil && s.option.BlockTarget.Uint64() < s.state.BlockNumber {
+		// Stop the dataSource if the block number target is not nil and the target block number has been indexed.
+		// This is useful when the dataSource is used to index a specific range of blocks.
+		if s.option.BlockTarget != nil && s.option.BlockTarget.Uint64() <= s.state.BlockNumber {
 			zap.L().Info("dataSource has indexed the specified block range", zap.Uint64("block.number.local", s.state.BlockNumber), zap.Uint64("block.number.target", s.option.BlockTarget.Uint64()))
 
 			break
@@ -126,7 +131,7 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 		}
 
 		if remoteBlockStart > s.state.BlockNumber {
-			s.state.BlockNumber = remoteBlockStart
+			s.skipToBlock(remoteBlockStart)
 			zap.L().Debug("updated block number from remote", zap.Uint64("newBlockNumber", s.state.BlockNumber))
 		}
 
@@ -144,7 +149,7 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 			zap.L().Debug("retrieved latest block number from remote",
 				zap.Uint64("block.number.remote", blockNumber.Uint64()))
 
-			blockNumberLatestRemote = blockNumber.Uint64()
+			blockNumberLatestRemote = s.confirmedBlockNumber(blockNumber.Uint64())
 
 			// No new block has been mined, or the RPC node is lagging.
 			if blockNumberStart > blockNumberLatestRemote {
@@ -160,9 +165,9 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 			return new(big.Int).SetUint64(blockNumberStart + uint64(item))
 		})
 
-		// Filter block numbers that are less than or equal to the latest remote block number.
+		// Filter block numbers that are less than or equal to the latest remote block number and the target block number.
 		blockNumbers = lo.Filter(blockNumbers, func(blockNumber *big.Int, _ int) bool {
-			return blockNumber.Uint64() <= blockNumberLatestRemote
+			return blockNumber.Uint64() <= blockNumberLatestRemote && (s.option.BlockTarget == nil || blockNumber.Cmp(s.option.BlockTarget) <= 0)
 		})
 
 		zap.L().Debug("processing block range",
@@ -179,6 +184,24 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 			return fmt.Errorf("get blocks by block numbers: %w", err)
 		}
 
+		// The blocks are fetched concurrently, a reorganization may happen in the middle of the requests.
+		for index := 1; index < len(blocks); index++ {
+			if blocks[index].ParentHash != blocks[index-1].Hash {
+				return fmt.Errorf("block %d is not a child of block %d", blocks[index].Number, blocks[index-1].Number)
+			}
+		}
+
+		reorganized, err := s.checkReorganization(ctx, tasksChan, blocks[0].ParentHash)
+		if err != nil {
+			return fmt.Errorf("check reorganization: %w", err)
+		}
+
+		if reorganized {
+			span.End()
+
+			continue
+		}
+
 		receipts, err := s.getReceipts(ctx, blocks)
 		if err != nil {
 			return fmt.Errorf("get receipts: %w", err)
@@ -200,14 +223,13 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 
 		span.End()
 
+		s.recordBlocks(lo.Map(blocks, func(block *ethereum.Block, _ int) BlockRecord {
+			return BlockRecord{Hash: block.Hash, Number: block.Number.Uint64(), Timestamp: block.Timestamp}
+		})...)
+
 		// Push tasks to the dataSource.
 		s.pushTasks(ctx, tasksChan, &tasks)
 
-		latestBlock := lo.Must(lo.Last(blocks))
-
-		s.state.BlockHash = latestBlock.Hash
-		s.state.BlockNumber = latestBlock.Number.Uint64()
-
 		zap.L().Debug("successfully polled blocks",
 			zap.String("block.hash", s.state.BlockHash.String()),
 			zap.Uint64("block.number", s.state.BlockNumber),
@@ -221,7 +243,7 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 	var blockNumberLatestRemote uint64
 
 	if s.option.BlockStart != nil && s.option.BlockStart.Uint64() > s.state.BlockNumber {
-		s.state.BlockNumber = s.option.BlockStart.Uint64()
+		s.skipToBlock(s.option.BlockStart.Uint64())
 		zap.L().Debug("updated initial block number from block start option",
 			zap.Uint64("block.number", s.state.BlockNumber))
 	}
@@ -229,9 +251,9 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 	for {
 		ctx, span := otel.GetTracerProvider().Tracer("").Start(ctx, "DataSource pollLogs", trace.WithSpanKind(trace.SpanKindProducer))
 
-		// Stop the dataSource if the block number target is not nil and the current block number is greater than the target
-		// block number. This is useful when the dataSource is used to index a specific range of blocks.
-		if s.option.BlockTarget != nil && s.option.BlockTarget.Uint64() < s.state.BlockNumber {
+		// Stop the dataSource if the block number target is not nil and the target block number has been indexed.
+		// This is useful when the dataSource is used to index a specific range of blocks.
+		if s.option.BlockTarget != nil && s.option.BlockTarget.Uint64() <= s.state.BlockNumber {
 			zap.L().Debug("dataSource has indexed the specified block range",
 				zap.Uint64("block.number.local", s.state.BlockNumber),
 				zap.Uint64("block.number.target", s.option.BlockTarget.Uint64()))
@@ -245,7 +267,7 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 		}
 
 		if remoteBlockStart > s.state.BlockNumber {
-			s.state.BlockNumber = remoteBlockStart
+			s.skipToBlock(remoteBlockStart)
 			zap.L().Debug("updated block number from remote",
 				zap.Uint64("newBlockNumber", s.state.BlockNumber))
 		}
@@ -261,7 +283,7 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 				return fmt.Errorf("get latest block number: %w", err)
 			}
 
-			blockNumberLatestRemote = blockNumber.Uint64()
+			blockNumberLatestRemote = s.confirmedBlockNumber(blockNumber.Uint64())
 
 			// No new block has been mined, or the RPC node is lagging.
 			if blockNumberStart > blockNumberLatestRemote {
@@ -288,6 +310,31 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 			attribute.String("block.number.end", strconv.FormatUint(blockNumberEnd, 10)),
 		)
 
+		// Every block of the range is recorded, including the blocks without logs, so the activities
+		// of any orphaned block in the range are found if the range is rolled back.
+		headers, err := s.getHeaders(ctx, blockNumberStart, blockNumberEnd)
+		if err != nil {
+			return fmt.Errorf("get headers: %w", err)
+		}
+
+		// The headers are fetched concurrently, a reorganization may happen in the middle of the requests.
+		for index := 1; index < len(headers); index++ {
+			if headers[index].ParentHash != headers[index-1].Hash {
+				return fmt.Errorf("block %d is not a child of block %d", headers[index].Number, headers[index-1].Number)
+			}
+		}
+
+		reorganized, err := s.checkReorganization(ctx, tasksChan, headers[0].ParentHash)
+		if err != nil {
+			return fmt.Errorf("check reorganization: %w", err)
+		}
+
+		if reorganized {
+			span.End()
+
+			continue
+		}
+
 		logFilter := ethereum.Filter{
 			FromBlock: new(big.Int).SetUint64(blockNumberStart),
 			ToBlock:   new(big.Int).SetUint64(blockNumberEnd),
@@ -302,18 +349,27 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 			return fmt.Errorf("get logs by filter: %w", err)
 		}
 
-		var latestBlock *ethereum.Block
+		// The logs must be emitted by the blocks of the headers, which are checked against the indexed blocks.
+		headerHashes := lo.SliceToMap(headers, func(header *ethereum.Header) (uint64, common.Hash) {
+			return header.Number.Uint64(), header.Hash
+		})
+
+		for _, log := range logs {
+			if hash := headerHashes[log.BlockNumber.Uint64()]; log.BlockHash != hash {
+				return fmt.Errorf("log of block %d has hash %s, which does not match the header hash %s", log.BlockNumber, log.BlockHash, hash)
+			}
+		}
+
+		// The blocks are recorded before the tasks are pushed, so the state attached to the tasks covers the range.
+		s.recordBlocks(lo.Map(headers, func(header *ethereum.Header, _ int) BlockRecord {
+			return BlockRecord{Hash: header.Hash, Number: header.Number.Uint64(), Timestamp: header.Timestamp}
+		})...)
 
 		if len(logs) == 0 {
 			zap.L().Debug("no logs found in block range",
 				zap.Uint64("block.start", blockNumberStart),
 				zap.Uint64("block.end", blockNumberEnd))
 
-			latestBlock, err = s.updateLatestBlock(ctx, blockNumberEnd)
-			if err != nil {
-				return err
-			}
-
 			span.End()
 
 			s.pushTasks(ctx, tasksChan, new(engine.Tasks))
@@ -323,29 +379,182 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 				zap.Uint64("block.start", blockNumberStart),
 				zap.Uint64("block.end", blockNumberEnd))
 
-			latestBlock, err = s.processLogs(ctx, logs, tasksChan)
-			if err != nil {
+			if err := s.processLogs(ctx, logs, tasksChan); err != nil {
 				return err
 			}
 		}
+	}
+
+	return nil
+}
+
+// confirmedBlockNumber returns the latest block number that has reached the confirmation depth.
+func (s *dataSource) confirmedBlockNumber(blockNumber uint64) uint64 {
+	return blockNumber - min(blockNumber, *s.option.ConfirmationBlocks)
+}
 
-		s.state.BlockHash = latestBlock.Hash
-		s.state.BlockNumber = latestBlock.Number.Uint64()
+// skipToBlock moves the state to the block number, the hash of the block is unknown,
+// so the reorganization check of the next block will be skipped.
+func (s *dataSource) skipToBlock(blockNumber uint64) {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	s.state.BlockHash = common.Hash{}
+	s.state.BlockNumber = blockNumber
+	s.state.RecentBlocks = nil
+}
+
+// recordBlocks moves the state to the latest block and keeps the recent blocks up to the reorganization depth.
+func (s *dataSource) recordBlocks(blocks ...BlockRecord) {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	for _, block := range blocks {
+		s.state.RecentBlocks = append(s.state.RecentBlocks, block)
+
+		s.state.BlockHash = block.Hash
+		s.state.BlockNumber = block.Number
+	}
+
+	if depth := int(*s.option.ReorganizationDepth); len(s.state.RecentBlocks) > depth {
+		s.state.RecentBlocks = s.state.RecentBlocks[len(s.state.RecentBlocks)-depth:]
 	}
+}
+
+// checkReorganization checks whether the next block is built on top of the latest indexed block,
+// if not, the orphaned blocks are rolled back to the common ancestor.
+func (s *dataSource) checkReorganization(ctx context.Context, tasksChan chan<- *engine.Tasks, parentHash common.Hash) (bool, error) {
+	// The hash of the latest indexed block is unknown, e.g. the data source has just skipped to the start block.
+	if s.state.BlockHash == (common.Hash{}) || parentHash == s.state.BlockHash {
+		return false, nil
+	}
+
+	zap.L().Warn("chain reorganization detected",
+		zap.String("network", s.config.Network.String()),
+		zap.Uint64("block.number", s.state.BlockNumber),
+		zap.Stringer("block.hash.local", s.state.BlockHash),
+		zap.Stringer("block.hash.parent", parentHash))
+
+	if err := s.rollback(ctx, tasksChan); err != nil {
+		return false, fmt.Errorf("rollback orphaned blocks: %w", err)
+	}
+
+	return true, nil
+}
+
+// rollback walks back through the recent blocks to find the common ancestor,
+// then pushes the activities that only exist in the orphaned blocks to the indexer for removal.
+func (s *dataSource) rollback(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+	recentBlocks := s.state.RecentBlocks
+
+	// The state was saved before the recent blocks were tracked.
+	if len(recentBlocks) == 0 {
+		recentBlocks = []BlockRecord{{Hash: s.state.BlockHash, Number: s.state.BlockNumber}}
+	}
+
+	var (
+		ancestorIndex  = -1
+		orphanedBlocks []BlockRecord
+	)
+
+	for index := len(recentBlocks) - 1; index >= 0; index-- {
+		header, err := s.ethereumClient.HeaderByNumber(ctx, new(big.Int).SetUint64(recentBlocks[index].Number))
+		if err != nil {
+			return fmt.Errorf("get header by number %d: %w", recentBlocks[index].Number, err)
+		}
+
+		if header.Hash == recentBlocks[index].Hash {
+			ancestorIndex = index
+
+			break
+		}
+
+		orphanedBlocks = append(orphanedBlocks, recentBlocks[index])
+	}
+
+	// The latest indexed block is still canonical, the parent hash was returned by an RPC node which is out of sync,
+	// so polling is retried instead of rolling back nothing.
+	if len(orphanedBlocks) == 0 {
+		return fmt.Errorf("block %d is still canonical, the rpc endpoint may be out of sync", recentBlocks[len(recentBlocks)-1].Number)
+	}
+
+	ancestor := BlockRecord{Number: recentBlocks[0].Number - 1}
+
+	if ancestorIndex >= 0 {
+		ancestor = recentBlocks[ancestorIndex]
+	} else {
+		zap.L().Warn("chain reorganization is deeper than the recent blocks, rewinding to the oldest recent block",
+			zap.Uint64("reorganization.depth", *s.option.ReorganizationDepth),
+			zap.Uint64("block.number", ancestor.Number))
+	}
+
+	reorganization := engine.Reorganization{
+		BlockNumber: ancestor.Number,
+	}
+
+	for _, orphanedBlock := range orphanedBlocks {
+		activityIDs, timestamp, err := s.findOrphanedTransactions(ctx, orphanedBlock)
+		if err != nil {
+			return err
+		}
+
+		if reorganization.Timestamp == 0 || (timestamp > 0 && timestamp < reorganization.Timestamp) {
+			reorganization.Timestamp = timestamp
+		}
+
+		reorganization.ActivityIDs = append(reorganization.ActivityIDs, activityIDs...)
+	}
+
+	zap.L().Info("found common ancestor of chain reorganization",
+		zap.Uint64("block.number", ancestor.Number),
+		zap.Stringer("block.hash", ancestor.Hash),
+		zap.Int("orphaned.blocks", len(orphanedBlocks)),
+		zap.Int("orphaned.transactions", len(reorganization.ActivityIDs)))
+
+	// Rewind the state before pushing, so the checkpoint saved by the indexer points to the common ancestor.
+	s.stateMutex.Lock()
+	s.state.BlockHash = ancestor.Hash
+	s.state.BlockNumber = ancestor.Number
+	s.state.RecentBlocks = recentBlocks[:ancestorIndex+1]
+	s.stateMutex.Unlock()
+
+	s.pushTasks(ctx, tasksChan, &engine.Tasks{Reorganization: &reorganization})
 
 	return nil
 }
 
-func (s *dataSource) updateLatestBlock(ctx context.Context, blockNumberEnd uint64) (*ethereum.Block, error) {
-	latestBlock, err := s.ethereumClient.BlockByNumber(ctx, new(big.Int).SetUint64(blockNumberEnd))
+// findOrphanedTransactions returns the hashes of transactions in the orphaned block that are not included in the canonical block of the same height.
+func (s *dataSource) findOrphanedTransactions(ctx context.Context, orphanedBlock BlockRecord) ([]string, uint64, error) {
+	block, err := s.ethereumClient.BlockByHash(ctx, orphanedBlock.Hash)
 	if err != nil {
-		return nil, fmt.Errorf("get block by number %d: %w", s.state.BlockNumber, err)
+		// The orphaned block may have been pruned by the RPC node, its activities can not be identified.
+		zap.L().Warn("failed to get orphaned block by hash",
+			zap.Uint64("block.number", orphanedBlock.Number),
+			zap.Stringer("block.hash", orphanedBlock.Hash),
+			zap.Error(err))
+
+		return nil, orphanedBlock.Timestamp, nil
+	}
+
+	canonicalBlock, err := s.ethereumClient.BlockByNumber(ctx, new(big.Int).SetUint64(orphanedBlock.Number))
+	if err != nil {
+		return nil, 0, fmt.Errorf("get block by number %d: %w", orphanedBlock.Number, err)
 	}
 
-	return latestBlock, nil
+	canonicalTransactions := lo.SliceToMap(canonicalBlock.Transactions, func(transaction *ethereum.Transaction) (common.Hash, struct{}) {
+		return transaction.Hash, struct{}{}
+	})
+
+	activityIDs := lo.FilterMap(block.Transactions, func(transaction *ethereum.Transaction, _ int) (string, bool) {
+		_, exists := canonicalTransactions[transaction.Hash]
+
+		return transaction.Hash.String(), !exists
+	})
+
+	return activityIDs, block.Timestamp, nil
 }
 
-func (s *dataSource) processLogs(ctx context.Context, logs []*ethereum.Log, tasksChan chan<- *engine.Tasks) (*ethereum.Block, error) {
+func (s *dataSource) processLogs(ctx context.Context, logs []*ethereum.Log, tasksChan chan<- *engine.Tasks) error {
 	transactionHashes := lo.Map(logs, func(log *ethereum.Log, _ int) common.Hash {
 		return log.TransactionHash
 	})
@@ -370,7 +579,7 @@ func (s *dataSource) processLogs(ctx context.Context, logs []*ethereum.Log, task
 
 	blocks, err := s.getBlocks(ctx, blockNumbers)
 	if err != nil {
-		return nil, fmt.Errorf("get blocks: %w", err)
+		return fmt.Errorf("get blocks: %w", err)
 	}
 
 	blocks = lo.Map(blocks, func(block *ethereum.Block, _ int) *ethereum.Block {
@@ -381,14 +590,13 @@ func (s *dataSource) processLogs(ctx context.Context, logs []*ethereum.Log, task
 		return block
 	})
 
-	latestBlock, exist := lo.Last(blocks)
-	if !exist {
-		return nil, fmt.Errorf("empty blocks")
+	if len(blocks) == 0 {
+		return fmt.Errorf("empty blocks")
 	}
 
 	receipts, err := s.getReceiptsByTransactionHashes(ctx, transactionHashes)
 	if err != nil {
-		return nil, fmt.Errorf("get receipts: %w", err)
+		return fmt.Errorf("get receipts: %w", err)
 	}
 
 	var tasks engine.Tasks
@@ -396,7 +604,7 @@ func (s *dataSource) processLogs(ctx context.Context, logs []*ethereum.Log, task
 	for _, block := range blocks {
 		blockTasks, err := s.buildTasks(block, receipts)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		tasks.Tasks = append(tasks.Tasks, lo.Map(blockTasks, func(blockTask *Task, _ int) engine.Task { return blockTask })...)
@@ -408,7 +616,40 @@ func (s *dataSource) processLogs(ctx context.Context, logs []*ethereum.Log, task
 
 	s.pushTasks(ctx, tasksChan, &tasks)
 
-	return latestBlock, nil
+	return nil
+}
+
+// getHeaders is used to concurrently get the headers of a range of blocks.
+func (s *dataSource) getHeaders(ctx context.Context, blockNumberStart, blockNumberEnd uint64) ([]*ethereum.Header, error) {
+	resultPool := pool.NewWithResults[*ethereum.Header]().
+		WithContext(ctx).
+		WithFirstError().
+		WithCancelOnError()
+
+	for blockNumber := blockNumberStart; blockNumber <= blockNumberEnd; blockNumber++ {
+		blockNumber := blockNumber
+
+		resultPool.Go(func(ctx context.Context) (*ethereum.Header, error) {
+			header, err := s.ethereumClient.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+			if err != nil {
+				return nil, fmt.Errorf("get header by number %d: %w", blockNumber, err)
+			}
+
+			return header, nil
+		})
+	}
+
+	headers, err := resultPool.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort headers by block number in ascending order.
+	sort.SliceStable(headers, func(left, right int) bool {
+		return headers[left].Number.Cmp(headers[right].Number) == -1
+	})
+
+	return headers, nil
 }
 
 // getBlocks is used to concurrently get blocks by block number.
@@ -598,7 +839,11 @@ func (s *dataSource) buildTasks(block *ethereum.Block, receipts []*ethereum.Rece
 	return tasks, nil
 }
 
+// pushTasks attaches the state of the data source to the tasks and pushes them,
+// the state must have been moved past the blocks of the tasks.
 func (s *dataSource) pushTasks(ctx context.Context, tasksChan chan<- *engine.Tasks, tasks *engine.Tasks) {
+	tasks.State = s.State()
+
 	otel.GetTextMapPropagator().Inject(ctx, tasks)
 
 	_, span := otel.Tracer("").Start(ctx, "DataSource pushTasks", trace.WithSpanKind(trace.SpanKindProducer))
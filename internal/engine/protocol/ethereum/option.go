@@ -14,6 +14,8 @@ const (
 	defaultBlockBatchSize          = uint(8)
 	defaultReceiptsBatchSize       = uint(200)
 	defaultBlockReceiptsBatchSize  = uint(8)
+	defaultConfirmationBlocks      = uint64(0)
+	defaultReorganizationDepth     = uint64(64)
 )
 
 type Option struct {
@@ -29,6 +31,10 @@ type Option struct {
 	ReceiptsBatchSize *uint `json:"receipts_batch_size" mapstructure:"receipts_batch_size"`
 	// BlockReceiptsBatchSize is the number of block receipts to fetch in a single batch.
 	BlockReceiptsBatchSize *uint `json:"block_receipts_batch_size" mapstructure:"block_receipts_batch_size"`
+	// ConfirmationBlocks is the number of blocks to wait for before a block is indexed.
+	ConfirmationBlocks *uint64 `json:"confirmation_blocks" mapstructure:"confirmation_blocks"`
+	// ReorganizationDepth is the number of recent blocks kept to find the common ancestor of a chain reorganization.
+	ReorganizationDepth *uint64 `json:"reorganization_depth" mapstructure:"reorganization_depth"`
 }
 
 func NewOption(n network.Network, parameters *config.Parameters) (*Option, error) {
@@ -41,6 +47,8 @@ func NewOption(n network.Network, parameters *config.Parameters) (*Option, error
 			BlockBatchSize:          lo.ToPtr(defaultBlockBatchSize),
 			ReceiptsBatchSize:       lo.ToPtr(defaultReceiptsBatchSize),
 			BlockReceiptsBatchSize:  lo.ToPtr(defaultBlockReceiptsBatchSize),
+			ConfirmationBlocks:      lo.ToPtr(defaultConfirmationBlocks),
+			ReorganizationDepth:     lo.ToPtr(defaultReorganizationDepth),
 		}, nil
 	}
 
@@ -65,6 +73,14 @@ func NewOption(n network.Network, parameters *config.Parameters) (*Option, error
 		option.BlockReceiptsBatchSize = lo.ToPtr(defaultBlockReceiptsBatchSize)
 	}
 
+	if option.ConfirmationBlocks == nil {
+		option.ConfirmationBlocks = lo.ToPtr(defaultConfirmationBlocks)
+	}
+
+	if option.ReorganizationDepth == nil || *option.ReorganizationDepth == 0 {
+		option.ReorganizationDepth = lo.ToPtr(defaultReorganizationDepth)
+	}
+
 	if option.BlockStart == nil {
 		option.BlockStart = parameter.CurrentNetworkStartBlock[n].Block
 	}
@@ -0,0 +1,56 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/provider/ethereum"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/stretchr/testify/require"
+)
+
+// canonicalClient returns the headers of the canonical chain by their numbers.
+type canonicalClient struct {
+	ethereum.Client
+
+	hashes map[uint64]common.Hash
+}
+
+func (c *canonicalClient) HeaderByNumber(_ context.Context, number *big.Int) (*ethereum.Header, error) {
+	return &ethereum.Header{Number: number, Hash: c.hashes[number.Uint64()]}, nil
+}
+
+func TestRollbackWithoutOrphanedBlocks(t *testing.T) {
+	t.Parallel()
+
+	recentBlocks := []BlockRecord{
+		{Hash: common.HexToHash("0x1"), Number: 1},
+		{Hash: common.HexToHash("0x2"), Number: 2},
+	}
+
+	source := dataSource{
+		config: &config.Module{Network: network.Ethereum},
+		ethereumClient: &canonicalClient{
+			hashes: map[uint64]common.Hash{1: recentBlocks[0].Hash, 2: recentBlocks[1].Hash},
+		},
+		state: State{
+			BlockHash:    recentBlocks[1].Hash,
+			BlockNumber:  recentBlocks[1].Number,
+			RecentBlocks: recentBlocks,
+		},
+	}
+
+	tasksChan := make(chan *engine.Tasks, 1)
+
+	// The recent blocks are canonical, so nothing is rolled back and polling is retried.
+	reorganized, err := source.checkReorganization(context.Background(), tasksChan, common.HexToHash("0xff"))
+	require.Error(t, err)
+	require.False(t, reorganized)
+	require.Empty(t, tasksChan)
+	require.Equal(t, recentBlocks[1].Number, source.state.BlockNumber)
+	require.Equal(t, recentBlocks, source.state.RecentBlocks)
+}
@@ -7,4 +7,12 @@ import (
 type State struct {
 	BlockHash   common.Hash `json:"block_hash"`
 	BlockNumber uint64      `json:"block_number"`
+	// RecentBlocks are the latest indexed blocks, used to find the common ancestor when a reorganization occurs.
+	RecentBlocks []BlockRecord `json:"recent_blocks,omitempty"`
+}
+
+type BlockRecord struct {
+	Hash      common.Hash `json:"hash"`
+	Number    uint64      `json:"number"`
+	Timestamp uint64      `json:"timestamp"`
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/rss3-network/node/v2/internal/engine/protocol/ethereum"
 	"github.com/rss3-network/node/v2/internal/engine/protocol/farcaster"
 	"github.com/rss3-network/node/v2/internal/engine/protocol/near"
+	"github.com/rss3-network/node/v2/internal/engine/protocol/rss"
 	"github.com/rss3-network/protocol-go/schema/network"
 )
 
@@ -31,7 +32,31 @@ func New(config *config.Module, sourceFilter engine.DataSourceFilter, checkpoint
 		return near.NewSource(config, sourceFilter, checkpoint, redisClient)
 	case network.ATProtocol:
 		return atproto.NewSource(config, sourceFilter, checkpoint, databaseClient)
+	case network.RSSProtocol:
+		return rss.NewSource(config, checkpoint)
 	default:
 		return nil, fmt.Errorf("unsupported network protocol %s", config.Network)
 	}
 }
+
+// NewTask creates an empty task of the protocol, it is used to decode a task from its JSON payload.
+func NewTask(protocol network.Protocol) (engine.Task, error) {
+	switch protocol {
+	case network.EthereumProtocol:
+		return &ethereum.Task{}, nil
+	case network.ArweaveProtocol:
+		return &arweave.Task{}, nil
+	case network.FarcasterProtocol:
+		return &farcaster.Task{}, nil
+	case network.ActivityPubProtocol:
+		return &activitypub.Task{}, nil
+	case network.NearProtocol:
+		return &near.Task{}, nil
+	case network.ATProtocol:
+		return &atproto.Task{}, nil
+	case network.RSSProtocol:
+		return &rss.Task{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported network protocol %s", protocol)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go/v4"
@@ -23,6 +25,19 @@ import (
 const (
 	// defaultBlockTime is the approximate waiting time for Farcaster Hub to generate new events.
 	defaultBlockTime = 3 * time.Second
+	// defaultStreamBatchSize and defaultStreamFlushInterval bound the events streamed over gRPC
+	// that are built into the same tasks, the batch is sent once either is reached.
+	defaultStreamBatchSize     = 100
+	defaultStreamFlushInterval = time.Second
+	// defaultStreamRetryDelay and defaultStreamMaxRetryDelay bound the jittered backoff between the reconnections of the stream,
+	// the backoff is reset once a stream has stayed up for defaultStreamStableDuration.
+	defaultStreamRetryDelay     = time.Second
+	defaultStreamMaxRetryDelay  = time.Minute
+	defaultStreamStableDuration = time.Minute
+
+	streamEvents    = "events"
+	streamCasts     = "casts"
+	streamReactions = "reactions"
 )
 
 var _ engine.DataSource = (*dataSource)(nil)
@@ -31,10 +46,13 @@ type dataSource struct {
 	config                  *config.Module
 	option                  *Option
 	farcasterClient         farcaster.Client
+	streamClient            farcaster.StreamClient
 	databaseClient          database.Client
-	state                   State
-	pendingState            State
 	startFarcasterTimestamp uint32
+	// The state holds the progress of the streams and is updated by their goroutines, so it is guarded by the mutex.
+	// Each stream attaches its own sub-checkpoint to its tasks, which is committed once the tasks have been saved.
+	state      State
+	stateMutex sync.RWMutex
 }
 
 func (s *dataSource) Network() network.Network {
@@ -42,6 +60,9 @@ func (s *dataSource) Network() network.Network {
 }
 
 func (s *dataSource) State() json.RawMessage {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+
 	return lo.Must(json.Marshal(s.state))
 }
 
@@ -101,20 +122,33 @@ func (s *dataSource) initialize() (err error) {
 
 	s.farcasterClient = client
 
+	if s.option.StreamEndpoint != nil {
+		if s.streamClient, err = farcaster.NewStreamClient(*s.option.StreamEndpoint, farcaster.WithStreamAPIKey(s.option.APIKey)); err != nil {
+			return fmt.Errorf("create farcaster stream client: %w", err)
+		}
+	}
+
 	return nil
 }
 
 // pollCasts polls casts from the Farcaster Hub.
 // It will poll casts by fid from the maximum fid to the minimal fid (1).
 func (s *dataSource) pollCasts(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+	s.stateMutex.RLock()
+	state := castsState{
+		CastsFid:      s.state.CastsFid,
+		CastsBackfill: s.state.CastsBackfill,
+	}
+	s.stateMutex.RUnlock()
+
 	// Check if backfill of casts is complete.
-	if s.state.CastsBackfill {
+	if state.CastsBackfill {
 		zap.L().Debug("casts backfill is already complete")
 		return nil
 	}
 
 	// If fid is 0 and backfill is not complete, fetch the maximum fid from the Farcaster Hub.
-	if s.state.CastsFid == 0 && !s.state.CastsBackfill {
+	if state.CastsFid == 0 {
 		zap.L().Debug("fetching maximum fid from farcaster hub")
 
 		fidsResponse, err := s.farcasterClient.GetFids(ctx, true, lo.ToPtr(1))
@@ -123,37 +157,38 @@ func (s *dataSource) pollCasts(ctx context.Context, tasksChan chan<- *engine.Tas
 			return fmt.Errorf("failed to fetch farcaster max fid: %w", err)
 		}
 
-		s.pendingState.CastsFid = fidsResponse.Fids[0]
+		state.CastsFid = fidsResponse.Fids[0]
 
 		zap.L().Debug("successfully fetched maximum fid", zap.Uint64("fid", fidsResponse.Fids[0]))
 	}
 
 	// Poll casts by fid until backfill is complete.
-	for !s.state.CastsBackfill {
-		zap.L().Debug("polling casts for fid", zap.Uint64("fid", s.pendingState.CastsFid))
+	for ; state.CastsFid > 0; state.CastsFid-- {
+		zap.L().Debug("polling casts for fid", zap.Uint64("fid", state.CastsFid))
 
-		if err := s.pollCastsByFid(ctx, lo.ToPtr(int64(s.pendingState.CastsFid)), "", tasksChan); err != nil {
+		if err := s.pollCastsByFid(ctx, lo.ToPtr(int64(state.CastsFid)), "", tasksChan, streamCasts); err != nil {
 			return err
 		}
 
-		// Update state with pending state and decrement pending fid.
-		s.state = s.pendingState
-		s.pendingState.CastsFid--
-
-		// If pending fid is 0, mark backfill as complete.
-		if s.pendingState.CastsFid == 0 {
-			zap.L().Debug("completed casts backfill")
-
-			s.pendingState.CastsBackfill = true
-			s.state = s.pendingState
+		// The fid is committed once all of its casts have been saved.
+		if err := s.commitStream(ctx, tasksChan, streamCasts, state, func(current *State) {
+			current.CastsFid = state.CastsFid
+		}); err != nil {
+			return err
 		}
 	}
 
-	return nil
+	zap.L().Debug("completed casts backfill")
+
+	state.CastsBackfill = true
+
+	return s.commitStream(ctx, tasksChan, streamCasts, state, func(current *State) {
+		current.CastsFid, current.CastsBackfill = state.CastsFid, state.CastsBackfill
+	})
 }
 
 // pollCastsByFid polls casts by fid from the Farcaster Hub and send tasks to tasksChan.
-func (s *dataSource) pollCastsByFid(ctx context.Context, fid *int64, pageToken string, tasksChan chan<- *engine.Tasks) error {
+func (s *dataSource) pollCastsByFid(ctx context.Context, fid *int64, pageToken string, tasksChan chan<- *engine.Tasks, stream string) error {
 	for {
 		// Fetch casts by fid.
 		zap.L().Debug("fetching casts by fid", zap.Int64("fid", *fid), zap.String("pageToken", pageToken))
@@ -171,10 +206,11 @@ func (s *dataSource) pollCastsByFid(ctx context.Context, fid *int64, pageToken s
 			zap.Int("total", len(castsByFidResponse.Messages)),
 			zap.Int("filtered", len(messages)))
 
-		// Build tasks from the fetched casts and send them to the tasks channel.
-		tasks := s.buildFarcasterMessageTasks(ctx, messages)
-
-		tasksChan <- tasks
+		// Build tasks from the fetched casts and send them to the tasks channel,
+		// the pages of a fid do not advance the cursor of the stream.
+		if err := s.sendTasks(ctx, tasksChan, s.buildFarcasterMessageTasks(ctx, messages), stream, nil); err != nil {
+			return err
+		}
 
 		// If the fetched casts do not have a next page token
 		// or the number of messages is less than the number of fetched messages
@@ -190,14 +226,21 @@ func (s *dataSource) pollCastsByFid(ctx context.Context, fid *int64, pageToken s
 // pollReactions polls reactions from the Farcaster Hub.
 // It will poll reactions by fid from the maximum fid to the minimal fid (1).
 func (s *dataSource) pollReactions(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+	s.stateMutex.RLock()
+	state := reactionsState{
+		ReactionsFid:      s.state.ReactionsFid,
+		ReactionsBackfill: s.state.ReactionsBackfill,
+	}
+	s.stateMutex.RUnlock()
+
 	// Check if backfill of reactions is complete.
-	if s.state.ReactionsBackfill {
+	if state.ReactionsBackfill {
 		zap.L().Debug("reactions backfill is already complete")
 		return nil
 	}
 
 	// If fid is 0 and backfill is not complete, fetch the maximum fid from the Farcaster Hub.
-	if s.state.ReactionsFid == 0 && !s.state.ReactionsBackfill {
+	if state.ReactionsFid == 0 {
 		zap.L().Info("fetching maximum fid from farcaster hub")
 
 		fidsResponse, err := s.farcasterClient.GetFids(ctx, true, lo.ToPtr(1))
@@ -206,36 +249,37 @@ func (s *dataSource) pollReactions(ctx context.Context, tasksChan chan<- *engine
 			return fmt.Errorf("failed to fetch farcaster max fid: %w", err)
 		}
 
-		s.pendingState.ReactionsFid = fidsResponse.Fids[0]
+		state.ReactionsFid = fidsResponse.Fids[0]
 		zap.L().Debug("successfully fetched maximum fid", zap.Uint64("fid", fidsResponse.Fids[0]))
 	}
 
 	// Poll reactions by fid until backfill is complete.
-	for !s.state.ReactionsBackfill {
-		zap.L().Debug("polling reactions for fid", zap.Uint64("fid", s.pendingState.ReactionsFid))
+	for ; state.ReactionsFid > 0; state.ReactionsFid-- {
+		zap.L().Debug("polling reactions for fid", zap.Uint64("fid", state.ReactionsFid))
 
-		if err := s.pollReactionsByFid(ctx, lo.ToPtr(int64(s.pendingState.ReactionsFid)), "", tasksChan); err != nil {
+		if err := s.pollReactionsByFid(ctx, lo.ToPtr(int64(state.ReactionsFid)), "", tasksChan, streamReactions); err != nil {
 			return err
 		}
 
-		// Update state with pending state and decrement pending fid.
-		s.state = s.pendingState
-		s.pendingState.ReactionsFid--
-
-		// If pending fid is 0, mark backfill as complete.
-		if s.pendingState.ReactionsFid == 0 {
-			zap.L().Debug("completed reactions backfill")
-
-			s.pendingState.ReactionsBackfill = true
-			s.state = s.pendingState
+		// The fid is committed once all of its reactions have been saved.
+		if err := s.commitStream(ctx, tasksChan, streamReactions, state, func(current *State) {
+			current.ReactionsFid = state.ReactionsFid
+		}); err != nil {
+			return err
 		}
 	}
 
-	return nil
+	zap.L().Debug("completed reactions backfill")
+
+	state.ReactionsBackfill = true
+
+	return s.commitStream(ctx, tasksChan, streamReactions, state, func(current *State) {
+		current.ReactionsFid, current.ReactionsBackfill = state.ReactionsFid, state.ReactionsBackfill
+	})
 }
 
 // pollReactionsByFid polls reactions by fid from the Farcaster Hub and send tasks to tasksChan.
-func (s *dataSource) pollReactionsByFid(ctx context.Context, fid *int64, pageToken string, tasksChan chan<- *engine.Tasks) error {
+func (s *dataSource) pollReactionsByFid(ctx context.Context, fid *int64, pageToken string, tasksChan chan<- *engine.Tasks, stream string) error {
 	for {
 		// Fetch reactions by fid.
 		zap.L().Debug("fetching reactions by fid", zap.Int64("fid", *fid), zap.String("pageToken", pageToken))
@@ -253,10 +297,12 @@ func (s *dataSource) pollReactionsByFid(ctx context.Context, fid *int64, pageTok
 			zap.Int("total", len(reactionsByFidResponse.Messages)),
 			zap.Int("filtered", len(messages)))
 
-		// Build tasks from the fetched reactions and send them to the tasks channel.
-		tasks := s.buildFarcasterMessageTasks(ctx, messages)
+		// Build tasks from the fetched reactions and send them to the tasks channel,
+		// the pages of a fid do not advance the cursor of the stream.
+		if err := s.sendTasks(ctx, tasksChan, s.buildFarcasterMessageTasks(ctx, messages), stream, nil); err != nil {
+			return err
+		}
 
-		tasksChan <- tasks
 		// If the fetched reactions do not have a next page token
 		// or the number of messages is less than the number of fetched messages
 		if reactionsByFidResponse.NextPageToken == "" || len(messages) < len(reactionsByFidResponse.Messages) {
@@ -327,19 +373,70 @@ func (s *dataSource) buildFarcasterMessageTasks(ctx context.Context, messages []
 }
 
 // pollEvents polls events from the Farcaster Hub.
-// It will poll events from the last event id to the latest event id.
+// The events are streamed over gRPC if a stream endpoint is configured, and the HTTP API is polled
+// to catch up on the events missed while the stream is interrupted. Otherwise, the HTTP API is polled continuously.
 func (s *dataSource) pollEvents(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
-	// Set the cursor to the current event ID in the state.
-	cursor := s.state.EventID
-
-	if cursor == 0 {
-		// If the cursor is 0, fetch the latest event ID from the Farcaster Hub.
-		cursor = farcaster.ConvertTimestampMilliToEventID(time.Now().Add(-10 * time.Second).UnixMilli())
+	s.stateMutex.Lock()
+	if s.state.EventID == 0 {
+		// If the cursor is 0, start from the events of the current timestamp.
+		s.state.EventID = farcaster.ConvertTimestampMilliToEventID(time.Now().Add(-10 * time.Second).UnixMilli())
 		zap.L().Debug("starting event polling from current timestamp",
-			zap.Uint64("event.id", cursor))
+			zap.Uint64("event.id", s.state.EventID))
 	}
+	s.stateMutex.Unlock()
 
+	if s.streamClient == nil {
+		return s.pollEventsByHTTP(ctx, tasksChan, false)
+	}
+
+	for attempts := 0; ; attempts++ {
+		connectedAt := time.Now()
+
+		err := s.streamEvents(ctx, tasksChan)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// A stream that has stayed up was interrupted by the hub rather than failing to connect.
+		if time.Since(connectedAt) >= defaultStreamStableDuration {
+			attempts = 0
+		}
+
+		zap.L().Warn("farcaster event stream interrupted, catching up over http", zap.Error(err))
+
+		if err := s.pollEventsByHTTP(ctx, tasksChan, true); err != nil {
+			return err
+		}
+
+		delay := streamRetryDelay(attempts)
+
+		zap.L().Debug("reconnecting to farcaster event stream", zap.Int("attempts", attempts), zap.Duration("delay", delay))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// streamRetryDelay returns the delay before reconnecting to the stream after the failed attempts,
+// it doubles with each attempt up to the maximum and is jittered, so the reconnections of nodes are spread out.
+func streamRetryDelay(attempts int) time.Duration {
+	delay := min(defaultStreamRetryDelay<<min(attempts, 16), defaultStreamMaxRetryDelay)
+
+	// #nosec
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// pollEventsByHTTP polls events from the HTTP API of the Farcaster Hub from the cursor in the state,
+// it returns once the latest event has been reached if catchUp is set.
+func (s *dataSource) pollEventsByHTTP(ctx context.Context, tasksChan chan<- *engine.Tasks, catchUp bool) error {
 	for {
+		s.stateMutex.RLock()
+		cursor := s.state.EventID
+		s.stateMutex.RUnlock()
+
 		// Fetch events from the Farcaster Hub using the cursor.
 		zap.L().Debug("fetching events from farcaster hub",
 			zap.Uint64("event.from.id", cursor))
@@ -358,6 +455,10 @@ func (s *dataSource) pollEvents(ctx context.Context, tasksChan chan<- *engine.Ta
 
 		// If the fetched events are empty, log an info message, wait for a default block time, and continue to the next iteration.
 		if len(eventsResponse.Events) == 0 {
+			if catchUp {
+				return nil
+			}
+
 			zap.L().Debug("no new events found, waiting for next poll",
 				zap.Uint64("event.from.id", cursor),
 				zap.Duration("block.time", defaultBlockTime))
@@ -367,21 +468,120 @@ func (s *dataSource) pollEvents(ctx context.Context, tasksChan chan<- *engine.Ta
 			continue
 		}
 
-		zap.L().Debug("processing fetched events",
-			zap.Int("events.count", len(eventsResponse.Events)))
+		if err := s.sendEvents(ctx, tasksChan, eventsResponse.Events, eventsResponse.NextPageEventID); err != nil {
+			return err
+		}
+	}
+}
+
+// streamEvents streams events from the gRPC API of the Farcaster Hub from the cursor in the state,
+// the events are sent in batches. It returns once the stream is interrupted.
+func (s *dataSource) streamEvents(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.stateMutex.RLock()
+	cursor := s.state.EventID
+	s.stateMutex.RUnlock()
+
+	zap.L().Debug("subscribing to events of farcaster hub", zap.Uint64("event.from.id", cursor))
+
+	stream, err := s.streamClient.Subscribe(streamCtx, []farcaster.HubEventType{
+		farcaster.HubEventTypeMergeMessage,
+		farcaster.HubEventTypePruneMessage,
+		farcaster.HubEventTypeRevokeMessage,
+		farcaster.HubEventTypeMergeUsernameProof,
+		farcaster.HubEventTypeMergeOnChainEvent,
+	}, &cursor)
+	if err != nil {
+		return fmt.Errorf("subscribe events: %w", err)
+	}
+
+	var (
+		eventsChan = make(chan *farcaster.HubEvent, defaultStreamBatchSize)
+		streamErr  error
+	)
+
+	// The events channel is closed once the stream is interrupted, after the error has been set.
+	go func() {
+		defer close(eventsChan)
+
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				streamErr = err
+
+				return
+			}
+
+			select {
+			case eventsChan <- event:
+			case <-streamCtx.Done():
+				streamErr = streamCtx.Err()
+
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(defaultStreamFlushInterval)
+	defer ticker.Stop()
+
+	var events []farcaster.HubEvent
+
+	for {
+		select {
+		case event, ok := <-eventsChan:
+			if !ok {
+				if len(events) > 0 {
+					if err := s.sendEvents(ctx, tasksChan, events, events[len(events)-1].ID+1); err != nil {
+						return err
+					}
+				}
+
+				return fmt.Errorf("receive event: %w", streamErr)
+			}
 
-		tasks := s.buildFarcasterEventTasks(ctx, eventsResponse.Events, tasksChan)
+			if events = append(events, *event); len(events) < defaultStreamBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(events) == 0 {
+				continue
+			}
+		}
+
+		if err := s.sendEvents(ctx, tasksChan, events, events[len(events)-1].ID+1); err != nil {
+			return err
+		}
+
+		events = nil
+	}
+}
 
-		tasksChan <- tasks
+// sendEvents builds tasks from the events and sends them with the cursor of the next event.
+func (s *dataSource) sendEvents(ctx context.Context, tasksChan chan<- *engine.Tasks, events []farcaster.HubEvent, nextEventID uint64) error {
+	zap.L().Debug("processing fetched events",
+		zap.Int("events.count", len(events)))
 
-		s.state = s.pendingState
-		s.pendingState.EventID = eventsResponse.NextPageEventID
+	tasks := s.buildFarcasterEventTasks(ctx, events, tasksChan)
 
-		cursor = eventsResponse.NextPageEventID
+	state := eventsState{
+		EventID: nextEventID,
+	}
 
-		zap.L().Debug("successfully processed events batch",
-			zap.Uint64("next.event.id", cursor))
+	if err := s.sendTasks(ctx, tasksChan, tasks, streamEvents, state); err != nil {
+		return err
 	}
+
+	s.stateMutex.Lock()
+	s.state.EventID = state.EventID
+	s.stateMutex.Unlock()
+
+	zap.L().Debug("successfully processed events batch",
+		zap.Uint64("next.event.id", nextEventID))
+
+	return nil
 }
 
 // buildFarcasterEventTasks filter different types of events and build tasks from them.
@@ -402,7 +602,8 @@ func (s *dataSource) buildFarcasterEventTasks(ctx context.Context, events []farc
 
 		resultPool.Go(func() *Task {
 			if event.Type != farcaster.HubEventTypeMergeMessage.String() {
-				zap.L().Debug("skipping non-merge message event", zap.String("event.type", event.Type))
+				s.handleFarcasterEvent(ctx, event)
+
 				return nil
 			}
 
@@ -437,6 +638,23 @@ func (s *dataSource) buildFarcasterEventTasks(ctx context.Context, events []farc
 					return nil
 				}
 
+			case farcaster.MessageTypeLinkAdd.String(), farcaster.MessageTypeLinkRemove.String():
+				if message.Data.LinkBody == nil || message.Data.LinkBody.Type != farcaster.LinkTypeFollow {
+					zap.L().Debug("skipping non-follow link", zap.String("hash", message.Hash))
+					return nil
+				}
+
+				if err := s.fillLinkParams(ctx, &message); err != nil {
+					zap.L().Error("failed to fill link parameters",
+						zap.Uint64("fid", message.Data.Fid),
+						zap.String("hash", message.Hash),
+						zap.Error(err))
+
+					return nil
+				}
+
+				zap.L().Debug("successfully filled link parameters", zap.String("hash", message.Hash))
+
 			case farcaster.MessageTypeVerificationRemove.String(),
 				farcaster.MessageTypeVerificationAddEthAddress.String(),
 				farcaster.MessageTypeUserDataAdd.String(),
@@ -453,8 +671,8 @@ func (s *dataSource) buildFarcasterEventTasks(ctx context.Context, events []farc
 					zap.L().Debug("polling casts and reactions for new ETH address verification",
 						zap.Int64("fid", fid))
 
-					_ = s.pollCastsByFid(ctx, &fid, "", tasksChan)
-					_ = s.pollReactionsByFid(ctx, &fid, "", tasksChan)
+					_ = s.pollCastsByFid(ctx, &fid, "", tasksChan, streamEvents)
+					_ = s.pollReactionsByFid(ctx, &fid, "", tasksChan, streamEvents)
 				}
 
 				return nil
@@ -484,6 +702,51 @@ func (s *dataSource) buildFarcasterEventTasks(ctx context.Context, events []farc
 	return &tasks
 }
 
+// handleFarcasterEvent handles the events other than merged messages, which do not produce tasks
+// but may change the profiles: the removal of verifications or user data, the username proofs and the transfers of fids.
+func (s *dataSource) handleFarcasterEvent(ctx context.Context, event farcaster.HubEvent) {
+	var fids []uint64
+
+	switch {
+	case event.PruneMessageBody != nil, event.RevokeMessageBody != nil:
+		message := lo.Ternary(event.PruneMessageBody != nil, lo.FromPtr(event.PruneMessageBody).Message, lo.FromPtr(event.RevokeMessageBody).Message)
+
+		switch message.Data.Type {
+		case farcaster.MessageTypeVerificationAddEthAddress.String(),
+			farcaster.MessageTypeUserDataAdd.String(),
+			farcaster.MessageTypeUsernameProof.String():
+			fids = append(fids, message.Data.Fid)
+		}
+	case event.MergeUserNameProofBody != nil:
+		fids = append(fids, event.MergeUserNameProofBody.UserNameProof.Fid, event.MergeUserNameProofBody.DeletedUserNameProof.Fid)
+	case event.MergeOnChainEventBody != nil:
+		onChainEvent := event.MergeOnChainEventBody.OnChainEvent
+
+		if onChainEvent.Type == farcaster.OnChainEventTypeIDRegister && onChainEvent.IDRegisterEventBody != nil &&
+			onChainEvent.IDRegisterEventBody.EventType == farcaster.IDRegisterEventTypeTransfer {
+			fids = append(fids, onChainEvent.Fid)
+		}
+	}
+
+	fids = lo.Uniq(lo.Compact(fids))
+
+	if len(fids) == 0 {
+		zap.L().Debug("skipping event without profile changes", zap.String("event.type", event.Type), zap.Uint64("event.id", event.ID))
+
+		return
+	}
+
+	for _, fid := range fids {
+		zap.L().Debug("updating profile changed by event",
+			zap.String("event.type", event.Type),
+			zap.Uint64("fid", fid))
+
+		if _, err := s.updateProfileByFid(ctx, lo.ToPtr(int64(fid))); err != nil {
+			zap.L().Error("failed to update profile changed by event", zap.Uint64("fid", fid), zap.Error(err))
+		}
+	}
+}
+
 // updateProfileByFid update profile by fid.
 // It will fetch the username and custody address by fid and update the profile in the database.
 func (s *dataSource) updateProfileByFid(ctx context.Context, fid *int64) (*model.Profile, error) {
@@ -743,6 +1006,51 @@ func (s *dataSource) fillReactionParams(ctx context.Context, message *farcaster.
 	return s.fillProfile(ctx, message)
 }
 
+// fillLinkParams fill the profiles of the follower and the followed account in link message.
+func (s *dataSource) fillLinkParams(ctx context.Context, message *farcaster.Message) error {
+	targetFid := int64(message.Data.LinkBody.TargetFid)
+	zap.L().Debug("fetching target profile for link", zap.Int64("target_fid", targetFid))
+
+	targetProfile, err := s.getProfileByFid(ctx, &targetFid)
+	if err != nil {
+		return fmt.Errorf("failed to fetch farcaster profile for target fid %d: %w", targetFid, err)
+	}
+
+	message.Data.LinkBody.TargetProfile = targetProfile
+
+	return s.fillProfile(ctx, message)
+}
+
+// sendTasks attaches the sub-checkpoint of the stream to the tasks and sends them to the indexer,
+// a nil state sends the tasks without advancing the cursor of the stream.
+func (s *dataSource) sendTasks(ctx context.Context, tasksChan chan<- *engine.Tasks, tasks *engine.Tasks, stream string, state any) (err error) {
+	if tasks.Checkpoint, err = engine.NewStreamCheckpoint(stream, state); err != nil {
+		return fmt.Errorf("build checkpoint of stream %s: %w", stream, err)
+	}
+
+	select {
+	case tasksChan <- tasks:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// commitStream sends the sub-checkpoint of the stream without tasks, it is committed once the tasks
+// sent before it have been saved. The update function applies the sub-checkpoint to the state of the data source.
+func (s *dataSource) commitStream(ctx context.Context, tasksChan chan<- *engine.Tasks, stream string, state any, update func(state *State)) error {
+	if err := s.sendTasks(ctx, tasksChan, new(engine.Tasks), stream, state); err != nil {
+		return err
+	}
+
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	update(&s.state)
+
+	return nil
+}
+
 func retryOperation(ctx context.Context, operation func(ctx context.Context) error) error {
 	return retry.Do(
 		func() error {
@@ -780,7 +1088,6 @@ func NewSource(config *config.Module, checkpoint *engine.Checkpoint, databaseCli
 		databaseClient: databaseClient,
 		config:         config,
 		state:          state,
-		pendingState:   state, // Default pending state is equal to current state.
 	}
 
 	if instance.option, err = NewOption(config.Network, config.Parameters); err != nil {
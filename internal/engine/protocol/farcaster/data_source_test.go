@@ -0,0 +1,32 @@
+package farcaster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: defaultStreamRetryDelay},
+		{attempts: 1, want: 2 * defaultStreamRetryDelay},
+		{attempts: 3, want: 8 * defaultStreamRetryDelay},
+		{attempts: 10, want: defaultStreamMaxRetryDelay},
+		{attempts: 100, want: defaultStreamMaxRetryDelay},
+	}
+
+	for _, testcase := range testcases {
+		for i := 0; i < 100; i++ {
+			delay := streamRetryDelay(testcase.attempts)
+
+			require.GreaterOrEqual(t, delay, testcase.want/2, testcase.attempts)
+			require.LessOrEqual(t, delay, testcase.want, testcase.attempts)
+		}
+	}
+}
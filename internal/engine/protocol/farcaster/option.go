@@ -12,6 +12,9 @@ type Option struct {
 	APIKey *string `json:"api_key" mapstructure:"api_key"`
 	// TimestampStart is the Farcaster seconds timestamp that the worker should start from.
 	TimestampStart *big.Int `json:"timestamp_start" mapstructure:"timestamp_start"`
+	// StreamEndpoint is the gRPC endpoint of the Hub, such as grpc://nemes.farcaster.xyz:2283. If it is set, the latest
+	// events are streamed over gRPC, and the HTTP API is only polled to catch up when the stream is interrupted.
+	StreamEndpoint *string `json:"stream_endpoint" mapstructure:"stream_endpoint"`
 }
 
 func NewOption(n network.Network, parameters *config.Parameters) (*Option, error) {
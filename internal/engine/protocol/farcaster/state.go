@@ -7,3 +7,20 @@ type State struct {
 	ReactionsFid      uint64 `json:"reactions_fid"`      // Reactions ID that has been processed in backfill reactions
 	ReactionsBackfill bool   `json:"reactions_backfill"` // Reactions backfill flag
 }
+
+// eventsState is the sub-checkpoint of the stream polling the latest events.
+type eventsState struct {
+	EventID uint64 `json:"event_id"`
+}
+
+// castsState is the sub-checkpoint of the stream backfilling the casts.
+type castsState struct {
+	CastsFid      uint64 `json:"casts_fid"`
+	CastsBackfill bool   `json:"casts_backfill"`
+}
+
+// reactionsState is the sub-checkpoint of the stream backfilling the reactions.
+type reactionsState struct {
+	ReactionsFid      uint64 `json:"reactions_fid"`
+	ReactionsBackfill bool   `json:"reactions_backfill"`
+}
@@ -187,10 +187,12 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 
 		tasks := s.processBlocks(ctx, blocks)
 
-		tasksChan <- tasks
-
 		s.state.BlockHeight = blockHeightEnd
 		zap.L().Debug("updated block height", zap.Uint64("newHeight", blockHeightEnd))
+
+		tasks.State = s.State()
+
+		tasksChan <- tasks
 	}
 
 	return nil
@@ -0,0 +1,224 @@
+package rss
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/constant"
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/provider/rss"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+var _ engine.DataSource = (*dataSource)(nil)
+
+// overlapWindow is how far before the newest indexed item the items are indexed again,
+// the items sharing a second with it or published late with an earlier date are not missed,
+// and the items indexed again are saved as the same activities.
+const overlapWindow = 10 * time.Minute
+
+type dataSource struct {
+	config    *config.Module
+	option    *Option
+	rssClient rss.Client
+	// The state is updated by the polling goroutine and read by the indexer, so it is guarded by the mutex.
+	state      State
+	stateMutex sync.RWMutex
+}
+
+func (s *dataSource) Network() network.Network {
+	return s.config.Network
+}
+
+func (s *dataSource) State() json.RawMessage {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+
+	return lo.Must(json.Marshal(s.state))
+}
+
+// Start initializes the data source and starts polling the feeds.
+func (s *dataSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks, errorChan chan<- error) {
+	if err := s.initialize(); err != nil {
+		errorChan <- fmt.Errorf("initialize dataSource failed: %w", err)
+
+		return
+	}
+
+	if len(s.option.Feeds) == 0 {
+		zap.L().Warn("no feeds configured, the rss data source is idle")
+
+		return
+	}
+
+	go s.pollFeeds(ctx, tasksChan)
+}
+
+func (s *dataSource) initialize() (err error) {
+	version, _ := constant.BuildVersionDetail()
+	userAgent := fmt.Sprintf("rss3-%s/%s", constant.Name, version)
+
+	if s.rssClient, err = rss.NewClient(rss.WithUserAgent(userAgent)); err != nil {
+		return fmt.Errorf("create rss client: %w", err)
+	}
+
+	return nil
+}
+
+// pollFeeds polls the feeds one by one on every interval until the context is canceled,
+// a feed that fails to be polled does not stop the others.
+func (s *dataSource) pollFeeds(ctx context.Context, tasksChan chan<- *engine.Tasks) {
+	ticker := time.NewTicker(time.Duration(s.option.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		for _, feedURL := range s.option.Feeds {
+			if err := s.pollFeed(ctx, feedURL, tasksChan); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				zap.L().Error("poll feed failed", zap.String("feed", feedURL), zap.Error(err))
+			}
+		}
+
+		s.stateMutex.Lock()
+		s.state.PollTimestamp = time.Now().Unix()
+		s.stateMutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollFeed fetches the feed with a conditional request and pushes the items newer than the last indexed item.
+func (s *dataSource) pollFeed(ctx context.Context, feedURL string, tasksChan chan<- *engine.Tasks) error {
+	feedState := s.feedState(feedURL)
+
+	feed, validator, err := s.rssClient.Fetch(ctx, feedURL, feedState.Validator)
+	if err != nil {
+		if errors.Is(err, rss.ErrNotModified) {
+			zap.L().Debug("feed not modified", zap.String("feed", feedURL))
+
+			return nil
+		}
+
+		return fmt.Errorf("fetch feed: %w", err)
+	}
+
+	tasks, latest := s.buildTasks(feedURL, feed, feedState.Timestamp)
+
+	feedState.Validator = lo.FromPtr(validator)
+	feedState.Timestamp = latest
+
+	// The state is updated before the tasks are pushed, so the state attached to the tasks covers them.
+	s.stateMutex.Lock()
+
+	if s.state.Feeds == nil {
+		s.state.Feeds = make(map[string]FeedState)
+	}
+
+	s.state.Feeds[feedURL] = feedState
+
+	s.stateMutex.Unlock()
+
+	if tasks.Len() == 0 {
+		return nil
+	}
+
+	tasks.State = s.State()
+
+	select {
+	case tasksChan <- tasks:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	zap.L().Info("polled feed", zap.String("feed", feedURL), zap.Int("tasks", tasks.Len()))
+
+	return nil
+}
+
+// buildTasks builds the tasks of the items published after the timestamp minus the overlap window, and returns
+// the timestamp of the newest item. The items without a date can not be ordered, so they are skipped.
+func (s *dataSource) buildTasks(feedURL string, feed *rss.Feed, since int64) (*engine.Tasks, int64) {
+	var (
+		tasks  engine.Tasks
+		latest = since
+		now    = time.Now().Unix()
+	)
+
+	for _, item := range feed.Items {
+		timestamp := item.Timestamp()
+
+		if timestamp.IsZero() {
+			zap.L().Debug("skip item without date", zap.String("feed", feedURL), zap.String("item", item.Key()))
+
+			continue
+		}
+
+		if timestamp.Unix() < since-int64(overlapWindow.Seconds()) {
+			continue
+		}
+
+		tasks.Tasks = append(tasks.Tasks, &Task{
+			Network:   s.config.Network,
+			FeedURL:   feedURL,
+			FeedTitle: feed.Title,
+			FeedLink:  feed.Link,
+			Item:      lo.FromPtr(item),
+		})
+
+		// The items dated in the future must not hold back the items published before them.
+		latest = max(latest, min(timestamp.Unix(), now))
+	}
+
+	return &tasks, latest
+}
+
+func (s *dataSource) feedState(feedURL string) FeedState {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+
+	return s.state.Feeds[feedURL]
+}
+
+func NewSource(config *config.Module, checkpoint *engine.Checkpoint) (engine.DataSource, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+
+	var state State
+
+	// Initialize state from checkpoint.
+	if checkpoint != nil {
+		if err := json.Unmarshal(checkpoint.State, &state); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal checkpoint state: %w", err)
+		}
+	}
+
+	option, err := NewOption(config.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create option: %w", err)
+	}
+
+	instance := &dataSource{
+		config: config,
+		option: option,
+		state:  state,
+	}
+
+	zap.L().Info("initialized data source", zap.Any("option", option))
+
+	return instance, nil
+}
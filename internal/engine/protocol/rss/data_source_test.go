@@ -0,0 +1,42 @@
+package rss
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/provider/rss"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTasks(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	feed := rss.Feed{
+		Title: "Example",
+		Link:  "https://example.com",
+		Items: []*rss.Item{
+			{ID: "newer", PublishedAt: since.Add(time.Minute)},
+			{ID: "same second", PublishedAt: since},
+			{ID: "late", PublishedAt: since.Add(-time.Minute)},
+			{ID: "older", PublishedAt: since.Add(-overlapWindow - time.Second)},
+			{ID: "undated"},
+		},
+	}
+
+	source := dataSource{config: &config.Module{Network: network.RSSHub}}
+
+	tasks, latest := source.buildTasks("https://example.com/feed.xml", &feed, since.Unix())
+
+	keys := make([]string, 0, tasks.Len())
+
+	for _, task := range tasks.Tasks {
+		keys = append(keys, task.(*Task).Item.Key())
+	}
+
+	require.Equal(t, []string{"newer", "same second", "late"}, keys)
+	require.Equal(t, since.Add(time.Minute).Unix(), latest)
+}
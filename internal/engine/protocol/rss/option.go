@@ -0,0 +1,40 @@
+package rss
+
+import (
+	"fmt"
+
+	"github.com/rss3-network/node/v2/config"
+	"go.uber.org/zap"
+)
+
+// defaultInterval is the default seconds between the polls of the feeds.
+const defaultInterval = 10 * 60
+
+type Option struct {
+	// Feeds are the URLs of the RSS, Atom and JSON feeds to index.
+	Feeds []string `json:"feeds" mapstructure:"feeds"`
+	// Interval is the seconds between the polls of the feeds.
+	Interval int64 `json:"interval" mapstructure:"interval"`
+}
+
+func NewOption(parameters *config.Parameters) (*Option, error) {
+	option := Option{
+		Interval: defaultInterval,
+	}
+
+	if parameters == nil {
+		return &option, nil
+	}
+
+	if err := parameters.Decode(&option); err != nil {
+		zap.L().Error("decode parameters failed", zap.Error(err))
+
+		return nil, fmt.Errorf("decode parameters failed: %w", err)
+	}
+
+	if option.Interval <= 0 {
+		option.Interval = defaultInterval
+	}
+
+	return &option, nil
+}
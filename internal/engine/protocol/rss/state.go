@@ -0,0 +1,19 @@
+package rss
+
+import (
+	"github.com/rss3-network/node/v2/provider/rss"
+)
+
+type State struct {
+	// Feeds are the states of the feeds keyed by their URLs.
+	Feeds map[string]FeedState `json:"feeds,omitempty"`
+	// PollTimestamp is the time the feeds were last polled.
+	PollTimestamp int64 `json:"poll_timestamp,omitempty"`
+}
+
+type FeedState struct {
+	rss.Validator
+
+	// Timestamp is the time of the newest item indexed from the feed.
+	Timestamp int64 `json:"timestamp,omitempty"`
+}
@@ -0,0 +1,71 @@
+package rss
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/provider/rss"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+)
+
+var _ engine.Task = (*Task)(nil)
+
+type Task struct {
+	Network   network.Network
+	FeedURL   string
+	FeedTitle string
+	FeedLink  string
+	Item      rss.Item
+}
+
+// ID returns a stable ID derived from the feed URL and the item key,
+// the same item is always indexed as the same activity.
+func (t Task) ID() string {
+	hash := sha256.Sum256([]byte(t.FeedURL + "\n" + t.Item.Key()))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func (t Task) GetNetwork() network.Network {
+	return t.Network
+}
+
+func (t Task) GetTimestamp() uint64 {
+	return uint64(t.Item.Timestamp().Unix())
+}
+
+func (t Task) Validate() error {
+	if t.FeedURL == "" {
+		return fmt.Errorf("missing feed url")
+	}
+
+	if t.Item.Timestamp().IsZero() {
+		return fmt.Errorf("missing timestamp of item %s", t.Item.Key())
+	}
+
+	return nil
+}
+
+func (t Task) BuildActivity(options ...activityx.Option) (*activityx.Activity, error) {
+	activity := activityx.Activity{
+		ID:        t.ID(),
+		Network:   t.Network,
+		Type:      typex.Unknown,
+		Status:    true,
+		Actions:   make([]*activityx.Action, 0),
+		Timestamp: t.GetTimestamp(),
+	}
+
+	// Apply activity options.
+	for _, option := range options {
+		if err := option(&activity); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+
+	return &activity, nil
+}
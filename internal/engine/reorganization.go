@@ -0,0 +1,12 @@
+package engine
+
+// Reorganization describes a chain reorganization detected by a data source.
+// All activities built from blocks after the common ancestor that are not part of the canonical chain must be removed.
+type Reorganization struct {
+	// BlockNumber is the number of the common ancestor block shared by the indexed and the canonical chain.
+	BlockNumber uint64 `json:"block_number"`
+	// Timestamp is the earliest timestamp of the orphaned blocks, used to locate the stored activities.
+	Timestamp uint64 `json:"timestamp"`
+	// ActivityIDs are the IDs of activities built from transactions that only exist in the orphaned blocks.
+	ActivityIDs []string `json:"activity_ids"`
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"encoding/json"
 	"time"
 
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -31,6 +32,18 @@ var _ propagation.TextMapCarrier = (*Tasks)(nil)
 type Tasks struct {
 	Tasks []Task
 
+	// Reorganization is set when the data source has detected a chain reorganization,
+	// the orphaned activities must be removed before the tasks are handled.
+	Reorganization *Reorganization
+
+	// State is the state of the data source once the tasks have been handled, it is saved to the checkpoint with the tasks.
+	// It is attached by the data source when the tasks are pushed, since the data source moves on once they have been received.
+	State json.RawMessage
+
+	// Checkpoint is set by data sources that run concurrent streams, it is committed to the checkpoint
+	// once the tasks have been saved. If it is nil, the State of the tasks is saved instead.
+	Checkpoint *StreamCheckpoint
+
 	// metadata is used to store OpenTelemetry trace context.
 	metadata map[string]string
 }
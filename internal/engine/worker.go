@@ -25,3 +25,10 @@ type Worker interface {
 	// Transform the core logic of the worker and returns the Activity.
 	Transform(ctx context.Context, task Task) (*activityx.Activity, error)
 }
+
+// Tombstoner is implemented by the workers whose tasks remove the activities indexed before, such as deleted posts.
+// The tombstoned activities are deleted in the transaction that saves the activities of the task.
+type Tombstoner interface {
+	// Tombstones returns the ids of the activities removed by the task.
+	Tombstones(ctx context.Context, task Task) ([]string, error)
+}
@@ -12,6 +12,7 @@ import (
 	source "github.com/rss3-network/node/v2/internal/engine/protocol/farcaster"
 	"github.com/rss3-network/node/v2/provider/farcaster"
 	"github.com/rss3-network/node/v2/provider/httpx"
+	workerx "github.com/rss3-network/node/v2/schema/worker"
 	"github.com/rss3-network/node/v2/schema/worker/decentralized"
 	"github.com/rss3-network/protocol-go/schema"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -26,6 +27,9 @@ import (
 
 var _ engine.Worker = (*worker)(nil)
 
+// channelURLPrefix is the prefix of the parent URLs of the casts published in a Warpcast channel.
+const channelURLPrefix = "https://warpcast.com/~/channel/"
+
 type worker struct {
 	httpClient httpx.Client
 }
@@ -55,6 +59,7 @@ func (w *worker) Types() []schema.Type {
 		typex.SocialComment,
 		typex.SocialPost,
 		typex.SocialShare,
+		typex.SocialProfile,
 	}
 }
 
@@ -80,6 +85,10 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		w.handleFarcasterAddCast(ctx, farcasterTask.Message, activity)
 	case farcaster.MessageTypeReactionAdd.String():
 		w.handleFarcasterRecastReaction(ctx, farcasterTask.Message, activity)
+	case farcaster.MessageTypeLinkAdd.String():
+		w.handleFarcasterLink(ctx, farcasterTask.Message, activity, workerx.SocialProfileKeyFollow)
+	case farcaster.MessageTypeLinkRemove.String():
+		w.handleFarcasterLink(ctx, farcasterTask.Message, activity, workerx.SocialProfileKeyUnfollow)
 	default:
 		zap.L().Debug("unsupported farcaster message type", zap.String("type", farcasterTask.Message.Data.Type))
 	}
@@ -176,6 +185,37 @@ func (w *worker) handleFarcasterRecastReaction(ctx context.Context, message farc
 	}
 }
 
+// handleFarcasterLink handles farcaster link message, a follow or an unfollow is indexed as a profile update of the follower.
+func (w *worker) handleFarcasterLink(_ context.Context, message farcaster.Message, activity *activityx.Activity, key string) {
+	if message.Data.LinkBody == nil || message.Data.LinkBody.Type != farcaster.LinkTypeFollow ||
+		message.Data.Profile == nil || message.Data.LinkBody.TargetProfile == nil {
+		return
+	}
+
+	targetProfile := message.Data.LinkBody.TargetProfile
+
+	profile := metadata.SocialProfile{
+		Action:    metadata.ActionSocialProfileUpdate,
+		ProfileID: strconv.FormatUint(message.Data.Fid, 10),
+		Handle:    message.Data.Profile.Username,
+		Key:       key,
+		Value:     lo.Ternary(targetProfile.Username != "", targetProfile.Username, strconv.FormatInt(targetProfile.Fid, 10)),
+	}
+
+	activity.Type = typex.SocialProfile
+	activity.From = message.Data.Profile.CustodyAddress
+	activity.To = targetProfile.CustodyAddress
+
+	// A follow is a single edge between the accounts, so it is indexed once between the custody addresses.
+	activity.Actions = append(activity.Actions, &activityx.Action{
+		Type:     typex.SocialProfile,
+		Platform: w.Platform(),
+		From:     activity.From,
+		To:       activity.To,
+		Metadata: profile,
+	})
+}
+
 // buildPostActions builds post actions from message.
 func (w *worker) buildPostActions(_ context.Context, ethAddresses []string, activity *activityx.Activity, post *metadata.SocialPost, socialType schema.Type) {
 	for _, from := range ethAddresses {
@@ -214,6 +254,15 @@ func (w *worker) buildPost(ctx context.Context, fid int64, hash string, body *fa
 		Timestamp:     uint64(timestamp),
 	}
 
+	// The casts published in a channel have the URL of the channel as their parent URL.
+	if body != nil && body.ParentURL != "" {
+		post.TargetURL = body.ParentURL
+
+		if channel, found := strings.CutPrefix(body.ParentURL, channelURLPrefix); found && channel != "" {
+			post.Tags = append(post.Tags, channel)
+		}
+	}
+
 	w.buildPostMedia(ctx, post, embeds)
 
 	return post
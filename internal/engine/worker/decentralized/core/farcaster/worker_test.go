@@ -418,6 +418,67 @@ func TestWorker(t *testing.T) {
 			},
 			wantError: require.NoError,
 		},
+		{
+			name: "Follow An Account",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Farcaster,
+					Message: message.Message{
+						Data: message.MessageData{
+							Type: message.MessageTypeLinkAdd.String(),
+							Fid:  14142,
+							Profile: &model.Profile{
+								Fid:            14142,
+								Username:       "brucexc.eth",
+								CustodyAddress: "0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55",
+								EthAddresses:   []string{"0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0"},
+							},
+							Timestamp: 88297925,
+							Network:   "FARCASTER_NETWORK_MAINNET",
+							LinkBody: &message.LinkBody{
+								Type:      message.LinkTypeFollow,
+								TargetFid: 23901,
+								TargetProfile: &model.Profile{
+									Fid:            23901,
+									Username:       "henryqw",
+									CustodyAddress: "0xe25228a6525A2090be824d66Bdf6DB8836eCc90C",
+									EthAddresses:   []string{"0x827431510a5D249cE4fdB7F00C83a3353F471848", "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"},
+								},
+							},
+						},
+						Hash:            "0x4a3e1c2b9d72f8030aafa43f4c208b013964a510",
+						HashScheme:      "HASH_SCHEME_BLAKE3",
+						SignatureScheme: "SIGNATURE_SCHEME_ED25519",
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000004a3e1c2b9d72f8030aafa43f4c208b013964a510",
+				Network:  network.Farcaster,
+				From:     "0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55",
+				To:       "0xe25228a6525A2090be824d66Bdf6DB8836eCc90C",
+				Type:     typex.SocialProfile,
+				Status:   true,
+				Platform: workerx.PlatformFarcaster.String(),
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialProfile,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     "0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55",
+						To:       "0xe25228a6525A2090be824d66Bdf6DB8836eCc90C",
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileUpdate,
+							ProfileID: "14142",
+							Handle:    "brucexc.eth",
+							Key:       "follow",
+							Value:     "henryqw",
+						},
+					},
+				},
+				Timestamp: 1697757125,
+			},
+			wantError: require.NoError,
+		},
 	}
 
 	for _, testcase := range testcases {
@@ -6,11 +6,13 @@ import (
 
 	"github.com/bluesky-social/indigo/api/bsky"
 	"github.com/reiver/go-bsky/app/bsky/feed"
+	"github.com/reiver/go-bsky/app/bsky/graph"
 	"github.com/rss3-network/node/v2/internal/database"
 	"github.com/rss3-network/node/v2/internal/database/model"
 	"github.com/rss3-network/node/v2/internal/engine"
 	source "github.com/rss3-network/node/v2/internal/engine/protocol/atproto"
 	at "github.com/rss3-network/node/v2/provider/atproto"
+	workerx "github.com/rss3-network/node/v2/schema/worker"
 	"github.com/rss3-network/node/v2/schema/worker/federated"
 	"github.com/rss3-network/protocol-go/schema"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -22,10 +24,16 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ engine.Worker = (*worker)(nil)
+var (
+	_ engine.Worker      = (*worker)(nil)
+	_ database.TaskSaver = (*worker)(nil)
+)
 
 const (
-	ActorProfile = "app.bsky.actor.profile"
+	ActorProfile  = "app.bsky.actor.profile"
+	GraphBlock    = "app.bsky.graph.block"
+	GraphList     = "app.bsky.graph.list"
+	GraphListitem = "app.bsky.graph.listitem"
 )
 
 type worker struct {
@@ -70,6 +78,10 @@ func (w *worker) Filter() engine.DataSourceFilter {
 			feed.RepostTypeValue,
 			feed.LikeTypeValue,
 			ActorProfile,
+			graph.FollowTypeValue,
+			GraphBlock,
+			GraphList,
+			GraphListitem,
 		},
 	}
 }
@@ -86,6 +98,19 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		return nil, fmt.Errorf("build activity: %w", err)
 	}
 
+	// A deleted record carries its URI only, so only the edges of the social graph can be undone.
+	if atprotoTask.Message.Deleted {
+		if err := w.transformGraphDelete(ctx, atprotoTask.Message, activity); err != nil {
+			return nil, fmt.Errorf("transform graph delete: %w", err)
+		}
+
+		if len(activity.Actions) == 0 {
+			return nil, nil
+		}
+
+		return activity, nil
+	}
+
 	// Handle atproto message.
 	switch atprotoTask.Message.Collection {
 	case feed.PostTypeValue:
@@ -104,6 +129,12 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		}
 	case ActorProfile:
 		w.transformProfile(ctx, atprotoTask.Message, activity)
+	case graph.FollowTypeValue, GraphBlock, GraphListitem:
+		if err := w.transformGraph(ctx, atprotoTask.Message, activity); err != nil {
+			return nil, fmt.Errorf("transform graph: %w", err)
+		}
+	case GraphList:
+		w.transformList(ctx, atprotoTask.Message, activity)
 	default:
 		zap.L().Warn("unsupported type", zap.String("type", atprotoTask.Message.Collection))
 
@@ -185,6 +216,168 @@ func (w *worker) transformProfile(_ context.Context, message at.Message, activit
 	}
 }
 
+// transformGraph transforms a follow, block or list item message into an activity,
+// the edge of the social graph is saved by SaveTasks so that it can be undone when the record is deleted.
+func (w *worker) transformGraph(_ context.Context, message at.Message, activity *activityx.Activity) error {
+	edge, key := w.buildGraphEdge(message)
+	if edge == nil {
+		return nil
+	}
+
+	w.buildGraphActivity(message, edge, key, activity)
+
+	return nil
+}
+
+// transformGraphDelete transforms a deleted follow, block or list item into an activity undoing the edge.
+// The deleted records of other collections, or of edges never indexed, are ignored.
+// An edge saved by the same batch is not indexed yet, so it is deleted by SaveTasks without an activity.
+func (w *worker) transformGraphDelete(ctx context.Context, message at.Message, activity *activityx.Activity) error {
+	var key string
+
+	switch message.Collection {
+	case graph.FollowTypeValue:
+		key = workerx.SocialProfileKeyUnfollow
+	case GraphBlock:
+		key = workerx.SocialProfileKeyUnblock
+	case GraphListitem:
+		key = workerx.SocialProfileKeyListRemove
+	default:
+		return nil
+	}
+
+	edge, err := w.databaseClient.LoadDatasetBlueskyGraph(ctx, message.URI)
+	if err != nil {
+		return fmt.Errorf("load graph: %w", err)
+	}
+
+	if edge == nil {
+		zap.L().Debug("skip deleted record of unknown edge", zap.String("uri", message.URI))
+
+		return nil
+	}
+
+	w.buildGraphActivity(message, edge, key, activity)
+
+	return nil
+}
+
+// SaveTasks saves and deletes the edges of the social graph in the order of the tasks.
+func (w *worker) SaveTasks(ctx context.Context, client database.Client, tasks []engine.Task) error {
+	for _, task := range tasks {
+		atprotoTask, ok := task.(*source.Task)
+		if !ok {
+			return fmt.Errorf("invalid task type: %T", task)
+		}
+
+		message := atprotoTask.Message
+
+		if message.Collection != graph.FollowTypeValue && message.Collection != GraphBlock && message.Collection != GraphListitem {
+			continue
+		}
+
+		if message.Deleted {
+			if err := client.DeleteDatasetBlueskyGraph(ctx, message.URI); err != nil {
+				return fmt.Errorf("delete graph %s: %w", message.URI, err)
+			}
+
+			continue
+		}
+
+		if edge, _ := w.buildGraphEdge(message); edge != nil {
+			if err := client.SaveDatasetBlueskyGraph(ctx, edge); err != nil {
+				return fmt.Errorf("save graph %s: %w", message.URI, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// buildGraphEdge builds the edge of the social graph and the key of the profile update from a follow, block or list item message,
+// the edge is nil if the message has no subject.
+func (w *worker) buildGraphEdge(message at.Message) (*model.BlueskyGraph, string) {
+	edge := model.BlueskyGraph{
+		URI:       message.URI,
+		DID:       message.Did.String(),
+		Kind:      message.Collection,
+		CreatedAt: message.CreatedAt,
+	}
+
+	var key string
+
+	switch {
+	case message.Follow != nil:
+		key, edge.Subject = workerx.SocialProfileKeyFollow, message.Follow.Subject
+	case message.Block != nil:
+		key, edge.Subject = workerx.SocialProfileKeyBlock, message.Block.Subject
+	case message.ListItem != nil:
+		key, edge.Subject, edge.List = workerx.SocialProfileKeyListAdd, message.ListItem.Subject, message.ListItem.List
+	default:
+		return nil, ""
+	}
+
+	if edge.Subject == "" {
+		return nil, ""
+	}
+
+	return &edge, key
+}
+
+// buildGraphActivity builds a profile update activity from the owner of the edge to its subject.
+func (w *worker) buildGraphActivity(message at.Message, edge *model.BlueskyGraph, key string, activity *activityx.Activity) {
+	activity.Type = typex.SocialProfile
+	activity.From = edge.DID
+	activity.To = edge.Subject
+
+	activity.Actions = []*activityx.Action{
+		{
+			Tag:      activity.Type.Tag(),
+			Type:     activity.Type,
+			Platform: w.Platform(),
+			From:     activity.From,
+			To:       activity.To,
+			Metadata: &metadata.SocialProfile{
+				Action:    metadata.ActionSocialProfileUpdate,
+				ProfileID: edge.DID,
+				Handle:    message.Handle,
+				Key:       key,
+				Value:     edge.Subject,
+			},
+			RelatedURLs: lo.Compact([]string{edge.List}),
+		},
+	}
+}
+
+// transformList transforms a list message into an activity, the name of the list is the value of the update.
+func (w *worker) transformList(_ context.Context, message at.Message, activity *activityx.Activity) {
+	if message.List == nil {
+		return
+	}
+
+	activity.Type = typex.SocialProfile
+	activity.From = message.Did.String()
+	activity.To = message.Did.String()
+
+	activity.Actions = []*activityx.Action{
+		{
+			Tag:      activity.Type.Tag(),
+			Type:     activity.Type,
+			Platform: w.Platform(),
+			From:     activity.From,
+			To:       activity.To,
+			Metadata: &metadata.SocialProfile{
+				Action:    metadata.ActionSocialProfileUpdate,
+				ProfileID: message.Did.String(),
+				Handle:    message.Handle,
+				Key:       workerx.SocialProfileKeyList,
+				Value:     message.List.Name,
+			},
+			RelatedURLs: []string{message.URI},
+		},
+	}
+}
+
 // buildPostMetadata constructs metadata for a post message.
 func (w *worker) buildPostMetadata(message at.Message) *metadata.SocialPost {
 	post := &metadata.SocialPost{
@@ -282,20 +475,26 @@ func (w *worker) buildPostMedia(embed *bsky.FeedPost_Embed) []metadata.Media {
 	return media
 }
 
-// saveProfiles saves the profiles to the database.
+// saveProfiles saves the profiles to the database, the profiles without a handle are skipped
+// so that the handles already known are not overwritten.
 func (w *worker) saveProfiles(ctx context.Context, task *source.Task) {
-	profiles := []*model.BlueskyProfile{{
-		DID:    task.Message.Did.String(),
-		Handle: task.Message.Handle,
-	}}
+	profiles := make([]*model.BlueskyProfile, 0, 2)
+
+	for _, message := range []*at.Message{&task.Message, task.Message.RefMessage} {
+		if message == nil || message.Handle == "" {
+			continue
+		}
 
-	if task.Message.RefMessage != nil {
 		profiles = append(profiles, &model.BlueskyProfile{
-			DID:    task.Message.RefMessage.Did.String(),
-			Handle: task.Message.RefMessage.Handle,
+			DID:    message.Did.String(),
+			Handle: message.Handle,
 		})
 	}
 
+	if len(profiles) == 0 {
+		return
+	}
+
 	if err := w.databaseClient.SaveDatasetBlueskyProfiles(ctx, profiles); err != nil {
 		zap.L().Error("save profiles", zap.Error(err))
 	}
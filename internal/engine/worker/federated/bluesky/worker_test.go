@@ -0,0 +1,79 @@
+package bluesky
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/reiver/go-bsky/app/bsky/graph"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/dialer"
+	"github.com/rss3-network/node/v2/internal/engine"
+	source "github.com/rss3-network/node/v2/internal/engine/protocol/atproto"
+	at "github.com/rss3-network/node/v2/provider/atproto"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_SaveTasks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	databaseClient, err := dialer.Dial(ctx, &config.Database{
+		Driver: database.DriverSQLite,
+		URI:    filepath.Join(t.TempDir(), "node.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, databaseClient.Migrate(ctx))
+
+	instance, err := NewWorker(databaseClient)
+	require.NoError(t, err)
+
+	follow := func(rkey string, deleted bool) engine.Task {
+		message := at.Message{
+			URI:        "at://did:plc:owner/app.bsky.graph.follow/" + rkey,
+			Did:        syntax.DID("did:plc:owner"),
+			Collection: graph.FollowTypeValue,
+			Rkey:       rkey,
+			CreatedAt:  time.Now(),
+			Deleted:    deleted,
+		}
+
+		if !deleted {
+			message.Follow = &bsky.GraphFollow{Subject: "did:plc:subject"}
+		}
+
+		return &source.Task{Network: network.Bluesky, Message: message}
+	}
+
+	// The edge followed and unfollowed by the same batch is deleted, since the tasks are saved in order.
+	tasks := []engine.Task{follow("1", false), follow("1", true), follow("2", false)}
+
+	err = databaseClient.WithTransaction(ctx, func(ctx context.Context, client database.Client) error {
+		return instance.(database.TaskSaver).SaveTasks(ctx, client, tasks)
+	})
+	require.NoError(t, err)
+
+	edge, err := databaseClient.LoadDatasetBlueskyGraph(ctx, "at://did:plc:owner/app.bsky.graph.follow/1")
+	require.NoError(t, err)
+	require.Nil(t, edge)
+
+	edge, err = databaseClient.LoadDatasetBlueskyGraph(ctx, "at://did:plc:owner/app.bsky.graph.follow/2")
+	require.NoError(t, err)
+	require.NotNil(t, edge)
+	require.Equal(t, "did:plc:subject", edge.Subject)
+
+	// The transformation of the follow no longer saves the edge concurrently.
+	activity, err := instance.Transform(ctx, follow("3", false))
+	require.NoError(t, err)
+	require.NotNil(t, activity)
+
+	edge, err = databaseClient.LoadDatasetBlueskyGraph(ctx, "at://did:plc:owner/app.bsky.graph.follow/3")
+	require.NoError(t, err)
+	require.Nil(t, edge)
+}
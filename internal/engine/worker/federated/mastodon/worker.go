@@ -19,6 +19,7 @@ import (
 	"github.com/rss3-network/node/v2/provider/activitypub"
 	"github.com/rss3-network/node/v2/provider/activitypub/mastodon"
 	"github.com/rss3-network/node/v2/provider/httpx"
+	workerx "github.com/rss3-network/node/v2/schema/worker"
 	"github.com/rss3-network/node/v2/schema/worker/federated"
 	"github.com/rss3-network/protocol-go/schema"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -30,7 +31,18 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ engine.Worker = (*worker)(nil)
+var (
+	_ engine.Worker     = (*worker)(nil)
+	_ engine.Tombstoner = (*worker)(nil)
+)
+
+// noteObjectTypes are the types of objects indexed as social posts.
+var noteObjectTypes = []string{
+	mastodon.ObjectTypeNote,
+	mastodon.ObjectTypeArticle,
+	mastodon.ObjectTypePage,
+	mastodon.ObjectTypeQuestion,
+}
 
 type worker struct {
 	httpClient     httpx.Client
@@ -61,7 +73,11 @@ func (w *worker) Tags() []tag.Tag {
 func (w *worker) Types() []schema.Type {
 	return []schema.Type{
 		typex.SocialComment,
+		typex.SocialDelete,
+		typex.SocialLike,
 		typex.SocialPost,
+		typex.SocialProfile,
+		typex.SocialRevise,
 		typex.SocialShare,
 	}
 }
@@ -91,6 +107,16 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		err = w.handleActivityPubCreate(ctx, activityPubTask.Message, activity)
 	case mastodon.MessageTypeAnnounce.String():
 		err = w.handleActivityPubAnnounce(ctx, activityPubTask.Message, activity)
+	case mastodon.MessageTypeUpdate.String():
+		err = w.handleActivityPubUpdate(ctx, activityPubTask.Message, activity)
+	case mastodon.MessageTypeDelete.String():
+		err = w.handleActivityPubDelete(ctx, activityPubTask.Message, activity)
+	case mastodon.MessageTypeLike.String():
+		err = w.handleActivityPubLike(ctx, activityPubTask.Message, activity)
+	case mastodon.MessageTypeFollow.String():
+		err = w.handleActivityPubFollow(ctx, activityPubTask.Message, activity, workerx.SocialProfileKeyFollow)
+	case mastodon.MessageTypeUndo.String():
+		err = w.handleActivityPubUndo(ctx, activityPubTask.Message, activity)
 	default:
 		zap.L().Debug("unsupported type", zap.String("type", activityPubTask.Message.Type))
 
@@ -101,6 +127,11 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		return nil, fmt.Errorf("handle %s message: %w", activityPubTask.Message.Type, err)
 	}
 
+	// Messages such as undone likes and deleted accounts do not produce an activity.
+	if len(activity.Actions) == 0 {
+		return nil, nil
+	}
+
 	return activity, nil
 }
 
@@ -241,49 +272,15 @@ func (w *worker) handleSingleActivityPubAnnounce(ctx context.Context, message ac
 	activity.To = convertURLToHandle(sharedID)
 	toUserHandle := activity.To
 
-	result, err := w.getParentStatusByParentID(ctx, sharedID)
-
-	if err != nil {
-		zap.L().Error("failed to get parent status and content by parent ID", zap.String("parentID", sharedID), zap.String("ID", message.ID))
-	}
-
-	var (
-		targetContent string
-		targetTime    uint64
-	)
-
-	if result != nil {
-		targetContent, err = html2text.FromString(result.Content, html2text.Options{
-			PrettyTables: true,
-		})
-
-		if err != nil {
-			zap.L().Error("failed to convert HTML to text", zap.Error(err), zap.String("ID", sharedID))
-
-			targetContent = result.Content
-		}
-
-		targetTime = w.parseTimestamp(result.Timestamp)
-	}
-
 	// Create a SocialPost object with the Announced ID
 	post := &metadata.SocialPost{
 		Handle:        currentUserHandle,
 		PublicationID: publicationID,
 		Timestamp:     w.parseTimestamp(message.Published),
-		Target:        w.buildTarget(sharedID, targetContent, targetTime),
+		Target:        w.buildFetchedTarget(ctx, sharedID, message.ID),
 		TargetURL:     sharedID,
 	}
 
-	// set Attachment and Tags to target metadata
-	if result != nil && result.Attachments != nil {
-		w.buildPostMedia(post.Target, result.Attachments)
-	}
-
-	if result != nil && result.Tags != nil {
-		w.buildPostTags(post.Target, result.Tags)
-	}
-
 	// Remove the "/activity" suffix from the message ID
 	messageID := strings.TrimSuffix(message.ID, "/activity")
 
@@ -299,8 +296,7 @@ func (w *worker) handleSingleActivityPubAnnounce(ctx context.Context, message ac
 	}
 
 	// Store the current user's unique handle
-	err = w.saveMastodonHandles(ctx, handles)
-	if err != nil {
+	if err := w.saveMastodonHandles(ctx, handles); err != nil {
 		zap.L().Error("failed to save mastodon handle", zap.Error(err), zap.String("currentUserHandle", currentUserHandle))
 		return err
 	}
@@ -308,6 +304,254 @@ func (w *worker) handleSingleActivityPubAnnounce(ctx context.Context, message ac
 	return nil
 }
 
+// handleActivityPubUpdate handles Update activities, which revise previously published notes.
+func (w *worker) handleActivityPubUpdate(ctx context.Context, message activitypub.Object, activity *activityx.Activity) error {
+	noteObjects, err := extractNoteObjects(message.Object)
+	if err != nil {
+		return fmt.Errorf("failed to extract Update objects: %w", err)
+	}
+
+	currentUserHandle := convertURLToHandle(message.Actor)
+
+	for _, note := range noteObjects {
+		// Updates of actors and other objects are not social actions.
+		if !lo.Contains(noteObjectTypes, note.Type) || !sameHost(note.ID, message.Actor) {
+			zap.L().Debug("skipping updated object", zap.String("type", note.Type), zap.String("ID", note.ID))
+
+			continue
+		}
+
+		post := w.buildPost(ctx, message, note, activity.Timestamp)
+		post.Handle = currentUserHandle
+
+		activity.Type = typex.SocialRevise
+		activity.Tag = tag.Social
+		activity.From = currentUserHandle
+		activity.To = currentUserHandle
+
+		activity.Actions = append(activity.Actions, w.createAction(activity.Type, activity.Tag, note.ID, currentUserHandle, currentUserHandle, post))
+	}
+
+	activity.TotalActions = uint(len(activity.Actions))
+
+	if len(activity.Actions) == 0 {
+		return nil
+	}
+
+	return w.saveMastodonHandles(ctx, []string{currentUserHandle})
+}
+
+// handleActivityPubDelete handles Delete activities, the deleted posts are tombstoned by Tombstones.
+func (w *worker) handleActivityPubDelete(_ context.Context, message activitypub.Object, activity *activityx.Activity) error {
+	objects, err := extractAnnounceObjects(message.Object)
+	if err != nil {
+		return fmt.Errorf("failed to extract Delete objects: %w", err)
+	}
+
+	currentUserHandle := convertURLToHandle(message.Actor)
+
+	for _, obj := range objects {
+		if !canDelete(message, obj) {
+			zap.L().Debug("skipping deleted object", zap.String("ID", obj.ID), zap.String("actor", message.Actor))
+
+			continue
+		}
+
+		post := &metadata.SocialPost{
+			Handle:        currentUserHandle,
+			PublicationID: ExtractPublicationID(obj.ID),
+			Timestamp:     w.parseTimestamp(message.Published),
+		}
+
+		activity.Actions = append(activity.Actions, w.createAction(typex.SocialDelete, tag.Social, obj.ID, currentUserHandle, currentUserHandle, post))
+	}
+
+	if len(activity.Actions) == 0 {
+		return nil
+	}
+
+	activity.Type = typex.SocialDelete
+	activity.Tag = tag.Social
+	activity.From = currentUserHandle
+	activity.To = currentUserHandle
+	activity.TotalActions = uint(len(activity.Actions))
+
+	return nil
+}
+
+// handleActivityPubLike handles Like activities (favourites) in ActivityPub.
+func (w *worker) handleActivityPubLike(ctx context.Context, message activitypub.Object, activity *activityx.Activity) error {
+	objects, err := extractAnnounceObjects(message.Object)
+	if err != nil {
+		return fmt.Errorf("failed to extract Like objects: %w", err)
+	}
+
+	currentUserHandle := convertURLToHandle(message.Actor)
+	handles := []string{currentUserHandle}
+
+	for _, obj := range objects {
+		likedID := obj.ID
+		toUserHandle := convertURLToHandle(likedID)
+
+		post := &metadata.SocialPost{
+			Handle:    currentUserHandle,
+			Timestamp: w.parseTimestamp(message.Published),
+			Target:    w.buildFetchedTarget(ctx, likedID, message.ID),
+			TargetURL: likedID,
+		}
+
+		activity.Type = typex.SocialLike
+		activity.Tag = tag.Social
+		activity.From = currentUserHandle
+		activity.To = toUserHandle
+
+		activity.Actions = append(activity.Actions, w.createAction(activity.Type, activity.Tag, message.ID, currentUserHandle, toUserHandle, post))
+		handles = append(handles, toUserHandle)
+	}
+
+	activity.TotalActions = uint(len(activity.Actions))
+
+	return w.saveMastodonHandles(ctx, handles)
+}
+
+// handleActivityPubFollow handles Follow activities and undone follows,
+// which are indexed as profile updates of the follower keyed by follow or unfollow.
+func (w *worker) handleActivityPubFollow(ctx context.Context, message activitypub.Object, activity *activityx.Activity, key string) error {
+	objects, err := extractAnnounceObjects(message.Object)
+	if err != nil {
+		return fmt.Errorf("failed to extract Follow objects: %w", err)
+	}
+
+	currentUserHandle := convertURLToHandle(message.Actor)
+	handles := []string{currentUserHandle}
+
+	for _, obj := range objects {
+		followedHandle := convertURLToHandle(obj.ID)
+		if followedHandle == "" {
+			continue
+		}
+
+		profile := &metadata.SocialProfile{
+			Action: metadata.ActionSocialProfileUpdate,
+			Handle: currentUserHandle,
+			Key:    key,
+			Value:  followedHandle,
+		}
+
+		activity.Type = typex.SocialProfile
+		activity.Tag = tag.Social
+		activity.From = currentUserHandle
+		activity.To = followedHandle
+
+		activity.Actions = append(activity.Actions, w.createAction(activity.Type, activity.Tag, obj.ID, currentUserHandle, followedHandle, profile))
+		handles = append(handles, followedHandle)
+	}
+
+	activity.TotalActions = uint(len(activity.Actions))
+
+	if len(activity.Actions) == 0 {
+		return nil
+	}
+
+	return w.saveMastodonHandles(ctx, handles)
+}
+
+// handleActivityPubUndo handles Undo activities, an undone Follow is indexed as an unfollow,
+// and the activities of undone Likes and Announces are tombstoned by Tombstones.
+func (w *worker) handleActivityPubUndo(ctx context.Context, message activitypub.Object, activity *activityx.Activity) error {
+	objects, err := extractAnnounceObjects(message.Object)
+	if err != nil {
+		return fmt.Errorf("failed to extract Undo objects: %w", err)
+	}
+
+	for _, obj := range objects {
+		if !canUndo(message, obj) || obj.Type != mastodon.MessageTypeFollow.String() {
+			continue
+		}
+
+		follow := activitypub.Object{
+			ID:        obj.ID,
+			Type:      obj.Type,
+			Actor:     message.Actor,
+			Object:    obj.Object,
+			Published: message.Published,
+		}
+
+		if err := w.handleActivityPubFollow(ctx, follow, activity, workerx.SocialProfileKeyUnfollow); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Tombstones returns the ids of the posts removed by Delete activities, and of the likes and shares removed by Undo activities.
+func (w *worker) Tombstones(_ context.Context, task engine.Task) ([]string, error) {
+	activityPubTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	message := activityPubTask.Message
+
+	var ids []string
+
+	switch message.Type {
+	case mastodon.MessageTypeDelete.String():
+		objects, err := extractAnnounceObjects(message.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract Delete objects: %w", err)
+		}
+
+		for _, obj := range objects {
+			if canDelete(message, obj) {
+				ids = append(ids, obj.ID)
+			}
+		}
+	case mastodon.MessageTypeUndo.String():
+		objects, err := extractAnnounceObjects(message.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract Undo objects: %w", err)
+		}
+
+		for _, obj := range objects {
+			if !canUndo(message, obj) {
+				zap.L().Debug("skipping undone object", zap.String("ID", obj.ID), zap.String("actor", message.Actor))
+
+				continue
+			}
+
+			// Undone follows are indexed as unfollows.
+			if obj.Type != mastodon.MessageTypeFollow.String() {
+				// The ids of Announce activities are stored without the activity suffix.
+				ids = append(ids, strings.TrimSuffix(obj.ID, mastodon.ActivitySuffix))
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// canDelete reports whether the object is a post deleted by the actor of the message,
+// deleting the actor removes the account, and an actor can only delete the posts under its own URL.
+func canDelete(message, obj activitypub.Object) bool {
+	return obj.ID != message.Actor && isOwnedBy(obj.ID, message.Actor)
+}
+
+// canUndo reports whether the object is undone by the actor of the message, an actor can only undo its own activities.
+// The ids of follows are not under the URL of the actor, but undoing a follow only changes the profile of the actor itself.
+func canUndo(message, obj activitypub.Object) bool {
+	if obj.Actor == "" || obj.Actor != message.Actor {
+		return false
+	}
+
+	if obj.Type == mastodon.MessageTypeFollow.String() {
+		return sameHost(obj.ID, message.Actor)
+	}
+
+	return isOwnedBy(obj.ID, message.Actor)
+}
+
 // saveMastodonHandles store the unique handles into the relevant DB table
 func (w *worker) saveMastodonHandles(ctx context.Context, handles []string) error {
 	// Find all unique handles
@@ -374,6 +618,46 @@ func (w *worker) buildTarget(parentID string, content string, timeStamp uint64)
 	return post
 }
 
+// buildFetchedTarget constructs the target of a share or like from the status fetched by its ID
+func (w *worker) buildFetchedTarget(ctx context.Context, targetID string, messageID string) *metadata.SocialPost {
+	result, err := w.getParentStatusByParentID(ctx, targetID)
+	if err != nil {
+		zap.L().Error("failed to get parent status and content by parent ID", zap.String("parentID", targetID), zap.String("ID", messageID))
+	}
+
+	var (
+		targetContent string
+		targetTime    uint64
+	)
+
+	if result != nil {
+		targetContent, err = html2text.FromString(result.Content, html2text.Options{
+			PrettyTables: true,
+		})
+
+		if err != nil {
+			zap.L().Error("failed to convert HTML to text", zap.Error(err), zap.String("ID", targetID))
+
+			targetContent = result.Content
+		}
+
+		targetTime = w.parseTimestamp(result.Timestamp)
+	}
+
+	target := w.buildTarget(targetID, targetContent, targetTime)
+
+	// set Attachment and Tags to target metadata
+	if result != nil && result.Attachments != nil {
+		w.buildPostMedia(target, result.Attachments)
+	}
+
+	if result != nil && result.Tags != nil {
+		w.buildPostTags(target, result.Tags)
+	}
+
+	return target
+}
+
 // buildPost constructs a SocialPost object from ActivityPub object and note
 func (w *worker) buildPost(_ context.Context, obj activitypub.Object, note activitypub.Note, timestamp uint64) *metadata.SocialPost {
 	// Create a new SocialPost with the content, profile ID, publication ID, and timestamp
@@ -676,6 +960,43 @@ func ExtractPublicationID(url string) string {
 	return ""
 }
 
+// sameHost checks if the URLs belong to the same server
+func sameHost(a, b string) bool {
+	aURL, err := url.Parse(a)
+	if err != nil || aURL.Host == "" {
+		return false
+	}
+
+	bURL, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(aURL.Host, bURL.Host)
+}
+
+// isOwnedBy reports whether the id is under the URL of the actor, for example,
+// https://host/users/alice/statuses/1 and https://host/users/alice#likes/1 are owned by https://host/users/alice.
+func isOwnedBy(id, actor string) bool {
+	idURL, err := url.Parse(id)
+	if err != nil || idURL.Host == "" {
+		return false
+	}
+
+	actorURL, err := url.Parse(actor)
+	if err != nil || strings.Trim(actorURL.Path, "/") == "" {
+		return false
+	}
+
+	if !strings.EqualFold(idURL.Scheme, actorURL.Scheme) || !strings.EqualFold(idURL.Host, actorURL.Host) {
+		return false
+	}
+
+	actorPath := strings.TrimSuffix(actorURL.Path, "/")
+
+	return idURL.Path == actorPath || strings.HasPrefix(idURL.Path, actorPath+"/")
+}
+
 // isNumeric checks if a string contains only digits
 func isNumeric(s string) bool {
 	_, err := strconv.Atoi(s)
@@ -20,6 +20,7 @@ import (
 	"github.com/rss3-network/node/v2/internal/engine/protocol/activitypub"
 	message "github.com/rss3-network/node/v2/provider/activitypub"
 	redisx "github.com/rss3-network/node/v2/provider/redis"
+	workerx "github.com/rss3-network/node/v2/schema/worker"
 	"github.com/rss3-network/node/v2/schema/worker/federated"
 	"github.com/rss3-network/protocol-go/schema/activity"
 	"github.com/rss3-network/protocol-go/schema/metadata"
@@ -555,6 +556,172 @@ func TestWorker(t *testing.T) {
 			},
 			wantError: require.NoError,
 		},
+		{
+			name: "Delete A Post",
+			arguments: arguments{
+				task: &activitypub.Task{
+					Network: network.Mastodon,
+					Message: message.Object{
+						Context: []interface{}{
+							"https://www.w3.org/ns/activitystreams",
+						},
+						ID:        "https://fosstodon.org/users/bert_hubert/statuses/113287843427757001#delete",
+						Type:      "Delete",
+						Actor:     "https://fosstodon.org/users/bert_hubert",
+						Published: "2024-10-11T08:30:00Z",
+						Object: map[string]interface{}{
+							"id":      "https://fosstodon.org/users/bert_hubert/statuses/113287843427757001",
+							"type":    "Tombstone",
+							"atomUri": "https://fosstodon.org/users/bert_hubert/statuses/113287843427757001",
+						},
+					},
+				},
+			},
+			want: &activity.Activity{
+				ID:           "https://fosstodon.org/users/bert_hubert/statuses/113287843427757001#delete",
+				Network:      network.Mastodon,
+				Platform:     federated.PlatformMastodon.String(),
+				From:         "@bert_hubert@fosstodon.org",
+				To:           "@bert_hubert@fosstodon.org",
+				Type:         typex.SocialDelete,
+				Tag:          tag.Social,
+				TotalActions: 1,
+				Status:       true,
+				Actions: []*activity.Action{
+					{
+						Type:     typex.SocialDelete,
+						Tag:      tag.Social,
+						Platform: federated.PlatformMastodon.String(),
+						From:     "@bert_hubert@fosstodon.org",
+						To:       "@bert_hubert@fosstodon.org",
+						Metadata: &metadata.SocialPost{
+							PublicationID: "113287843427757001",
+							Handle:        "@bert_hubert@fosstodon.org",
+							Timestamp:     1728635400,
+						},
+						RelatedURLs: []string{"https://fosstodon.org/users/bert_hubert/statuses/113287843427757001"},
+					},
+				},
+				Timestamp: 1728635400,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Delete An Account",
+			arguments: arguments{
+				task: &activitypub.Task{
+					Network: network.Mastodon,
+					Message: message.Object{
+						ID:        "https://fosstodon.org/users/bert_hubert#delete",
+						Type:      "Delete",
+						Actor:     "https://fosstodon.org/users/bert_hubert",
+						Published: "2024-10-11T08:30:00Z",
+						Object:    "https://fosstodon.org/users/bert_hubert",
+					},
+				},
+			},
+			want:      nil,
+			wantError: require.NoError,
+		},
+		{
+			name: "Follow An Account",
+			arguments: arguments{
+				task: &activitypub.Task{
+					Network: network.Mastodon,
+					Message: message.Object{
+						Context: []interface{}{
+							"https://www.w3.org/ns/activitystreams",
+						},
+						ID:        "https://fosstodon.org/6c1f0c52-2b5e-4d0b-9d5b-0a5c0e0e4f11",
+						Type:      "Follow",
+						Actor:     "https://fosstodon.org/users/bert_hubert",
+						Published: "2024-10-11T08:30:00Z",
+						Object:    "https://infosec.exchange/users/ravirockks",
+					},
+				},
+			},
+			want: &activity.Activity{
+				ID:           "https://fosstodon.org/6c1f0c52-2b5e-4d0b-9d5b-0a5c0e0e4f11",
+				Network:      network.Mastodon,
+				Platform:     federated.PlatformMastodon.String(),
+				From:         "@bert_hubert@fosstodon.org",
+				To:           "@ravirockks@infosec.exchange",
+				Type:         typex.SocialProfile,
+				Tag:          tag.Social,
+				TotalActions: 1,
+				Status:       true,
+				Actions: []*activity.Action{
+					{
+						Type:     typex.SocialProfile,
+						Tag:      tag.Social,
+						Platform: federated.PlatformMastodon.String(),
+						From:     "@bert_hubert@fosstodon.org",
+						To:       "@ravirockks@infosec.exchange",
+						Metadata: &metadata.SocialProfile{
+							Action: metadata.ActionSocialProfileUpdate,
+							Handle: "@bert_hubert@fosstodon.org",
+							Key:    workerx.SocialProfileKeyFollow,
+							Value:  "@ravirockks@infosec.exchange",
+						},
+						RelatedURLs: []string{"https://infosec.exchange/users/ravirockks"},
+					},
+				},
+				Timestamp: 1728635400,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Unfollow An Account",
+			arguments: arguments{
+				task: &activitypub.Task{
+					Network: network.Mastodon,
+					Message: message.Object{
+						Context: []interface{}{
+							"https://www.w3.org/ns/activitystreams",
+						},
+						ID:        "https://fosstodon.org/users/bert_hubert#follows/1234/undo",
+						Type:      "Undo",
+						Actor:     "https://fosstodon.org/users/bert_hubert",
+						Published: "2024-10-11T08:30:00Z",
+						Object: map[string]interface{}{
+							"id":     "https://fosstodon.org/6c1f0c52-2b5e-4d0b-9d5b-0a5c0e0e4f11",
+							"type":   "Follow",
+							"actor":  "https://fosstodon.org/users/bert_hubert",
+							"object": "https://infosec.exchange/users/ravirockks",
+						},
+					},
+				},
+			},
+			want: &activity.Activity{
+				ID:           "https://fosstodon.org/users/bert_hubert#follows/1234/undo",
+				Network:      network.Mastodon,
+				Platform:     federated.PlatformMastodon.String(),
+				From:         "@bert_hubert@fosstodon.org",
+				To:           "@ravirockks@infosec.exchange",
+				Type:         typex.SocialProfile,
+				Tag:          tag.Social,
+				TotalActions: 1,
+				Status:       true,
+				Actions: []*activity.Action{
+					{
+						Type:     typex.SocialProfile,
+						Tag:      tag.Social,
+						Platform: federated.PlatformMastodon.String(),
+						From:     "@bert_hubert@fosstodon.org",
+						To:       "@ravirockks@infosec.exchange",
+						Metadata: &metadata.SocialProfile{
+							Action: metadata.ActionSocialProfileUpdate,
+							Handle: "@bert_hubert@fosstodon.org",
+							Key:    workerx.SocialProfileKeyUnfollow,
+							Value:  "@ravirockks@infosec.exchange",
+						},
+						RelatedURLs: []string{"https://infosec.exchange/users/ravirockks"},
+					},
+				},
+				Timestamp: 1728635400,
+			},
+			wantError: require.NoError,
+		},
 	}
 	for _, testcase := range testcases {
 		testcase := testcase
@@ -579,3 +746,124 @@ func TestWorker(t *testing.T) {
 		})
 	}
 }
+
+func TestTombstones(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		message message.Object
+		want    []string
+	}{
+		{
+			name: "Delete A Post",
+			message: message.Object{
+				ID:     "https://fosstodon.org/users/bert_hubert/statuses/113287843427757001#delete",
+				Type:   "Delete",
+				Actor:  "https://fosstodon.org/users/bert_hubert",
+				Object: "https://fosstodon.org/users/bert_hubert/statuses/113287843427757001",
+			},
+			want: []string{"https://fosstodon.org/users/bert_hubert/statuses/113287843427757001"},
+		},
+		{
+			name: "Delete An Account",
+			message: message.Object{
+				ID:     "https://fosstodon.org/users/bert_hubert#delete",
+				Type:   "Delete",
+				Actor:  "https://fosstodon.org/users/bert_hubert",
+				Object: "https://fosstodon.org/users/bert_hubert",
+			},
+			want: nil,
+		},
+		{
+			name: "Delete A Post Of Another Instance",
+			message: message.Object{
+				ID:     "https://fosstodon.org/users/bert_hubert#delete",
+				Type:   "Delete",
+				Actor:  "https://fosstodon.org/users/bert_hubert",
+				Object: "https://infosec.exchange/users/ravirockks/statuses/1",
+			},
+			want: nil,
+		},
+		{
+			name: "Delete A Post Of Another User",
+			message: message.Object{
+				ID:     "https://fosstodon.org/users/bert_hubert#delete",
+				Type:   "Delete",
+				Actor:  "https://fosstodon.org/users/bert_hubert",
+				Object: "https://fosstodon.org/users/ravirockks/statuses/1",
+			},
+			want: nil,
+		},
+		{
+			name: "Undo An Announce Of Another User",
+			message: message.Object{
+				ID:    "https://fosstodon.org/users/bert_hubert#announces/1/undo",
+				Type:  "Undo",
+				Actor: "https://fosstodon.org/users/bert_hubert",
+				Object: map[string]interface{}{
+					"id":     "https://fosstodon.org/users/ravirockks/statuses/1/activity",
+					"type":   "Announce",
+					"actor":  "https://fosstodon.org/users/bert_hubert",
+					"object": "https://infosec.exchange/users/ravirockks/statuses/1",
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "Undo A Like Without An Actor",
+			message: message.Object{
+				ID:    "https://fosstodon.org/users/bert_hubert#likes/1234/undo",
+				Type:  "Undo",
+				Actor: "https://fosstodon.org/users/bert_hubert",
+				Object: map[string]interface{}{
+					"id":     "https://fosstodon.org/users/bert_hubert#likes/1234",
+					"type":   "Like",
+					"object": "https://infosec.exchange/users/ravirockks/statuses/1",
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "Undo A Like And A Follow",
+			message: message.Object{
+				ID:    "https://fosstodon.org/users/bert_hubert#likes/1234/undo",
+				Type:  "Undo",
+				Actor: "https://fosstodon.org/users/bert_hubert",
+				Object: []interface{}{
+					map[string]interface{}{
+						"id":     "https://fosstodon.org/users/bert_hubert#likes/1234",
+						"type":   "Like",
+						"actor":  "https://fosstodon.org/users/bert_hubert",
+						"object": "https://infosec.exchange/users/ravirockks/statuses/1",
+					},
+					map[string]interface{}{
+						"id":     "https://fosstodon.org/6c1f0c52-2b5e-4d0b-9d5b-0a5c0e0e4f11",
+						"type":   "Follow",
+						"actor":  "https://fosstodon.org/users/bert_hubert",
+						"object": "https://infosec.exchange/users/ravirockks",
+					},
+				},
+			},
+			want: []string{"https://fosstodon.org/users/bert_hubert#likes/1234"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance, err := NewWorker(nil, nil)
+			require.NoError(t, err)
+
+			tombstones, err := instance.(*worker).Tombstones(context.Background(), &activitypub.Task{
+				Network: network.Mastodon,
+				Message: testcase.message,
+			})
+			require.NoError(t, err)
+			require.Equal(t, testcase.want, tombstones)
+		})
+	}
+}
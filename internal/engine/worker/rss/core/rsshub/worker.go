@@ -3,79 +3,117 @@ package rsshub
 import (
 	"context"
 	"fmt"
-	"net/http"
-	"time"
 
-	"github.com/rss3-network/node/v2/config"
 	"github.com/rss3-network/node/v2/internal/engine"
+	source "github.com/rss3-network/node/v2/internal/engine/protocol/rss"
 	"github.com/rss3-network/node/v2/schema/worker/rss"
 	"github.com/rss3-network/protocol-go/schema"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/rss3-network/protocol-go/schema/tag"
 	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
 )
 
-type Worker struct {
-	config     *config.File
-	httpClient *http.Client
-	rsshub     *configx
-}
+var _ engine.Worker = (*worker)(nil)
 
-type configx struct {
-	id       string
-	network  network.Network
-	endpoint string
-}
+// worker transforms the items of the feeds polled by the RSS data source into social posts.
+type worker struct{}
 
-func (w *Worker) Name() string {
-	return "RSSHub"
+func (w *worker) Name() string {
+	return rss.Core.String()
 }
 
-func (w *Worker) Platform() string {
-	return rss.PlatformRSSHub.String()
+func (w *worker) Platform() string {
+	return rss.PlatformRSS.String()
 }
 
-func (w *Worker) Network() []network.Network {
-	return []network.Network{w.rsshub.network}
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		network.RSSHub,
+	}
 }
 
-func (w *Worker) Tags() []tag.Tag {
-	return []tag.Tag{tag.Social}
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Social,
+	}
 }
 
-func (w *Worker) Types() []schema.Type {
-	return []schema.Type{typex.SocialPost}
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.SocialPost,
+	}
 }
 
-func (w *Worker) Filter() engine.DataSourceFilter {
+func (w *worker) Filter() engine.DataSourceFilter {
 	return nil
 }
 
-func (w *Worker) Transform(_ context.Context, _ engine.Task) (*activityx.Activity, error) {
-	// Not implemented
-	return nil, fmt.Errorf("Transform method not implemented for RSSHub worker")
-}
+// Transform transforms a feed item into a social post published by the feed.
+func (w *worker) Transform(_ context.Context, task engine.Task) (*activityx.Activity, error) {
+	rssTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	activity, err := task.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, fmt.Errorf("build activity: %w", err)
+	}
 
-func NewWorker(config *config.File) (engine.Worker, error) {
-	w := &Worker{
-		config: config,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+	activity.Type = typex.SocialPost
+	activity.From = rssTask.FeedURL
+	activity.To = rssTask.FeedURL
+
+	activity.Actions = []*activityx.Action{
+		{
+			Tag:         activity.Type.Tag(),
+			Type:        activity.Type,
+			Platform:    w.Platform(),
+			From:        activity.From,
+			To:          activity.To,
+			Metadata:    w.buildPostMetadata(rssTask),
+			RelatedURLs: lo.Compact([]string{rssTask.Item.Link}),
 		},
 	}
 
-	if config.Component.RSS != nil && config.Component.RSS.Network == network.RSSHub {
-		w.rsshub = &configx{
-			id:       config.Component.RSS.ID,
-			network:  config.Component.RSS.Network,
-			endpoint: config.Component.RSS.Endpoint.URL,
-		}
+	return activity, nil
+}
+
+// buildPostMetadata builds the post metadata of a feed item, the content is preferred over
+// the summary as the body, and the summary is kept only if both are present.
+func (w *worker) buildPostMetadata(task *source.Task) *metadata.SocialPost {
+	item := task.Item
+
+	post := metadata.SocialPost{
+		Handle:        lo.Ternary(len(item.Authors) > 0, lo.FirstOrEmpty(item.Authors), task.FeedTitle),
+		Title:         item.Title,
+		Summary:       item.Summary,
+		Body:          item.Content,
+		ProfileID:     task.FeedURL,
+		PublicationID: item.Key(),
+		ContentURI:    item.Link,
+		Tags:          item.Categories,
+		AuthorURL:     task.FeedLink,
+		Timestamp:     uint64(item.Timestamp().Unix()),
+	}
+
+	if post.Body == "" {
+		post.Body, post.Summary = post.Summary, ""
 	}
 
-	if w.rsshub == nil {
-		return nil, fmt.Errorf("missing configuration for Component RSS")
+	for _, enclosure := range item.Enclosures {
+		post.Media = append(post.Media, metadata.Media{
+			Address:  enclosure.URL,
+			MimeType: enclosure.MimeType,
+		})
 	}
 
-	return w, nil
+	return &post
+}
+
+func NewWorker() (engine.Worker, error) {
+	return &worker{}, nil
 }
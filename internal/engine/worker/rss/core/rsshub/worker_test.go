@@ -0,0 +1,162 @@
+package rsshub
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	source "github.com/rss3-network/node/v2/internal/engine/protocol/rss"
+	"github.com/rss3-network/node/v2/provider/rss"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker(t *testing.T) {
+	t.Parallel()
+
+	type arguments struct {
+		task *source.Task
+	}
+
+	published := time.Date(2024, time.October, 17, 8, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      *activityx.Activity
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Post With Content",
+			arguments: arguments{
+				task: &source.Task{
+					Network:   network.RSSHub,
+					FeedURL:   "https://rss3.io/blog/rss.xml",
+					FeedTitle: "RSS3 Blog",
+					FeedLink:  "https://rss3.io/blog",
+					Item: rss.Item{
+						ID:          "post-2",
+						Link:        "https://rss3.io/blog/native-feeds",
+						Title:       "Introducing Native Feeds",
+						Summary:     "Feeds are indexed natively.",
+						Content:     "<p>Feeds are indexed <b>natively</b>.</p>",
+						Authors:     []string{"Alice"},
+						Categories:  []string{"Node"},
+						Enclosures:  []rss.Enclosure{{URL: "https://rss3.io/blog/native-feeds.png", MimeType: "image/png"}},
+						PublishedAt: published,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "061c782cecfc55f4f2c7ea4878546cb3c123b9e3c78462e8e80efa5b9aaef25a",
+				Network:  network.RSSHub,
+				From:     "https://rss3.io/blog/rss.xml",
+				To:       "https://rss3.io/blog/rss.xml",
+				Type:     typex.SocialPost,
+				Platform: "RSS",
+				Status:   true,
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialPost,
+						Tag:      tag.Social,
+						Platform: "RSS",
+						From:     "https://rss3.io/blog/rss.xml",
+						To:       "https://rss3.io/blog/rss.xml",
+						Metadata: &metadata.SocialPost{
+							Handle:        "Alice",
+							Title:         "Introducing Native Feeds",
+							Summary:       "Feeds are indexed natively.",
+							Body:          "<p>Feeds are indexed <b>natively</b>.</p>",
+							Media:         []metadata.Media{{Address: "https://rss3.io/blog/native-feeds.png", MimeType: "image/png"}},
+							ProfileID:     "https://rss3.io/blog/rss.xml",
+							PublicationID: "post-2",
+							ContentURI:    "https://rss3.io/blog/native-feeds",
+							Tags:          []string{"Node"},
+							AuthorURL:     "https://rss3.io/blog",
+							Timestamp:     uint64(published.Unix()),
+						},
+						RelatedURLs: []string{"https://rss3.io/blog/native-feeds"},
+					},
+				},
+				Timestamp: uint64(published.Unix()),
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Post With Summary",
+			arguments: arguments{
+				task: &source.Task{
+					Network:   network.RSSHub,
+					FeedURL:   "https://rss3.io/blog/rss.xml",
+					FeedTitle: "RSS3 Blog",
+					FeedLink:  "https://rss3.io/blog",
+					Item: rss.Item{
+						Link:        "https://rss3.io/blog/hello",
+						Title:       "Hello",
+						Summary:     "Welcome to the blog.",
+						PublishedAt: published,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "a7ef0c3d467b6a5b741c3afba0a97f4b0fccac9ec722e296443a0d3af76a5801",
+				Network:  network.RSSHub,
+				From:     "https://rss3.io/blog/rss.xml",
+				To:       "https://rss3.io/blog/rss.xml",
+				Type:     typex.SocialPost,
+				Platform: "RSS",
+				Status:   true,
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialPost,
+						Tag:      tag.Social,
+						Platform: "RSS",
+						From:     "https://rss3.io/blog/rss.xml",
+						To:       "https://rss3.io/blog/rss.xml",
+						Metadata: &metadata.SocialPost{
+							Handle:        "RSS3 Blog",
+							Title:         "Hello",
+							Body:          "Welcome to the blog.",
+							ProfileID:     "https://rss3.io/blog/rss.xml",
+							PublicationID: "https://rss3.io/blog/hello",
+							ContentURI:    "https://rss3.io/blog/hello",
+							AuthorURL:     "https://rss3.io/blog",
+							Timestamp:     uint64(published.Unix()),
+						},
+						RelatedURLs: []string{"https://rss3.io/blog/hello"},
+					},
+				},
+				Timestamp: uint64(published.Unix()),
+			},
+			wantError: require.NoError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			instance, err := NewWorker()
+			require.NoError(t, err)
+
+			transformedActivity, err := instance.Transform(ctx, testcase.arguments.task)
+			testcase.wantError(t, err)
+
+			data, err := json.MarshalIndent(transformedActivity, "", "\x20\x20")
+			require.NoError(t, err)
+
+			t.Log(string(data))
+
+			require.Equal(t, testcase.want, transformedActivity)
+		})
+	}
+}
@@ -1,12 +1,19 @@
 package worker
 
 import (
+	"fmt"
+
 	"github.com/rss3-network/node/v2/config"
 	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/internal/engine/worker/rss/core/rsshub"
+	"github.com/rss3-network/node/v2/schema/worker/rss"
 )
 
-func New(_ *config.Module) (engine.Worker, error) {
-	// Not implemented
-	// If the rsshub worker exists, it will be started by default, and there will not be a separate worker instance
-	return nil, nil
+func New(config *config.Module) (engine.Worker, error) {
+	switch config.Worker.(rss.Worker) {
+	case rss.Core:
+		return rsshub.NewWorker()
+	default:
+		return nil, fmt.Errorf("[rss/factory.go] unsupported worker %s", config.Worker)
+	}
 }
@@ -37,6 +37,10 @@ func (c Component) GetDecentralizedNetwork(ctx echo.Context, network network.Net
 	return c.Decentralized.GetNetworkActivities(ctx, network, params)
 }
 
+func (c Component) GetDecentralizedSearch(ctx echo.Context, params docs.GetDecentralizedSearchParams) error {
+	return c.Decentralized.GetSearchActivities(ctx, params)
+}
+
 func (c Component) GetDecentralizedPlatform(ctx echo.Context, platform decentralizedx.Platform, params docs.GetDecentralizedPlatformParams) error {
 	return c.Decentralized.GetPlatformActivities(ctx, platform, params)
 }
@@ -59,6 +63,10 @@ func (c Component) GetFederatedNetwork(ctx echo.Context, network network.Network
 	return c.Federated.GetNetworkActivities(ctx, network, params)
 }
 
+func (c Component) GetFederatedSearch(ctx echo.Context, params docs.GetFederatedSearchParams) error {
+	return c.Federated.GetSearchActivities(ctx, params)
+}
+
 func (c Component) GetFederatedPlatform(ctx echo.Context, platform federatedx.Platform, params docs.GetFederatedPlatformParams) error {
 	return c.Federated.GetPlatformActivities(ctx, platform, params)
 }
@@ -80,6 +88,10 @@ func (c Component) GetRSS(ctx echo.Context, _ string) error {
 	return c.RSS.Handler(ctx)
 }
 
+func (c Component) GetRSSFeeds(ctx echo.Context, params docs.GetRSSFeedsParams) error {
+	return c.RSS.GetFeedActivities(ctx, params)
+}
+
 // AI Interface
 
 // GetAgentData ignore the second parameter
@@ -40,7 +40,7 @@ func (h *Component) Name() string {
 
 var _ component.Component = (*Component)(nil)
 
-func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File) *Component {
+func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File, authenticator *middleware.Authenticator) *Component {
 	RecentRequests = cb.New(MaxRecentRequests)
 
 	c := &Component{
@@ -51,7 +51,7 @@ func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File)
 	group := apiServer.Group(fmt.Sprintf("/%s", Name))
 
 	// Add middleware for bearer token authentication
-	group.Use(middleware.BearerAuth(config.Discovery.Server.AccessToken))
+	group.Use(authenticator.Middleware(middleware.ScopeAI))
 
 	group.GET("/*", c.Handler)
 
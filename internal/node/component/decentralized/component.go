@@ -2,6 +2,7 @@ package decentralized
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -13,6 +14,9 @@ import (
 	"github.com/rss3-network/node/v2/internal/database"
 	"github.com/rss3-network/node/v2/internal/node/component"
 	"github.com/rss3-network/node/v2/internal/node/component/middleware"
+	"github.com/rss3-network/node/v2/internal/node/component/subscription"
+	"github.com/rss3-network/node/v2/internal/node/resolver"
+	"github.com/rss3-network/node/v2/internal/stream/pubsub"
 	"github.com/rss3-network/node/v2/provider/ethereum/etherface"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
@@ -28,6 +32,8 @@ type Component struct {
 	databaseClient  database.Client
 	etherfaceClient etherface.Client
 	redisClient     rueidis.Client
+	resolver        *resolver.Resolver
+	subscription    *subscription.Server
 }
 
 const Name = "decentralized"
@@ -45,19 +51,38 @@ func (c *Component) Name() string {
 
 var _ component.Component = (*Component)(nil)
 
-func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File, databaseClient database.Client, redisClient rueidis.Client) *Component {
+func NewComponent(ctx context.Context, apiServer *echo.Echo, config *config.File, databaseClient database.Client, redisClient rueidis.Client, authenticator *middleware.Authenticator) *Component {
 	RecentRequests = cb.New(MaxRecentRequests)
 
 	c := &Component{
 		config:         config,
 		databaseClient: databaseClient,
 		redisClient:    redisClient,
+		resolver:       resolver.NewResolver(ctx, config.Endpoints, databaseClient, redisClient),
 	}
 
 	group := apiServer.Group(fmt.Sprintf("/%s", Name))
 
 	// Add middleware for bearer token authentication
-	group.Use(middleware.BearerAuth(config.Discovery.Server.AccessToken))
+	group.Use(authenticator.Middleware(middleware.ScopeDecentralized))
+
+	apiServer.GET("/resolve/:name", c.ResolveName, authenticator.Middleware(middleware.ScopeDecentralized))
+	apiServer.GET("/reverse/:address", c.ReverseAddress, authenticator.Middleware(middleware.ScopeDecentralized))
+
+	// Subscriptions receive the activities committed by the workers through Redis.
+	if redisClient != nil {
+		hub := pubsub.NewHub(redisClient, pubsub.ChannelDecentralized)
+
+		go func() {
+			if err := hub.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				zap.L().Error("failed to run subscription hub", zap.Error(err))
+			}
+		}()
+
+		c.subscription = subscription.NewServer(hub, databaseClient, c.prepareSubscriptionFilter, c.TransformActivities)
+
+		group.GET("/subscribe", c.Subscribe)
+	}
 
 	if err := c.InitMeter(); err != nil {
 		panic(err)
@@ -43,6 +43,20 @@ func (c *Component) getActivitiesMetadata(ctx context.Context, request model.Act
 	return nil, "", nil
 }
 
+func (c *Component) searchActivities(ctx context.Context, request model.ActivitiesSearchQuery) ([]*activityx.Activity, string, error) {
+	activities, err := c.databaseClient.SearchActivities(ctx, request)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to search activities: %w", err)
+	}
+
+	last, exist := lo.Last(activities)
+	if exist {
+		return activities, c.transformCursor(ctx, last), nil
+	}
+
+	return nil, "", nil
+}
+
 func (c *Component) getCursor(ctx context.Context, cursor *string) (*activityx.Activity, error) {
 	if cursor == nil {
 		return nil, nil
@@ -6,7 +6,6 @@ import (
 	"strconv"
 
 	"github.com/creasty/defaults"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/labstack/echo/v4"
 	"github.com/rss3-network/node/v2/common/http/response"
 	"github.com/rss3-network/node/v2/docs"
@@ -95,9 +94,22 @@ func (c *Component) GetAccountActivities(ctx echo.Context, account string, reque
 		return response.ValidationFailedError(ctx, err)
 	}
 
-	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, common.HexToAddress(account).String())
+	address, err := c.resolveAccount(ctx.Request().Context(), account)
+	if err != nil {
+		if isResolveRequestError(err) {
+			return response.BadRequestError(ctx, err)
+		}
+
+		zap.L().Error("failed to resolve decentralized account",
+			zap.String("account", account),
+			zap.Error(err))
 
-	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, common.HexToAddress(account).String())
+		return response.InternalError(ctx)
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, address)
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, address)
 
 	addRecentRequest(ctx.Request().RequestURI)
 
@@ -117,7 +129,7 @@ func (c *Component) GetAccountActivities(ctx echo.Context, account string, reque
 		Cursor:         cursor,
 		StartTimestamp: request.SinceTimestamp,
 		EndTimestamp:   request.UntilTimestamp,
-		Owner:          lo.ToPtr(common.HexToAddress(account).String()),
+		Owner:          lo.ToPtr(address),
 		Limit:          lo.FromPtr(request.Limit),
 		ActionLimit:    lo.FromPtr(request.ActionLimit),
 		Status:         request.Status,
@@ -159,12 +171,6 @@ func (c *Component) BatchGetAccountsActivities(ctx echo.Context) (err error) {
 		return response.BadRequestError(ctx, err)
 	}
 
-	for i := range request.Accounts {
-		if common.IsHexAddress(request.Accounts[i]) {
-			request.Accounts[i] = common.HexToAddress(request.Accounts[i]).String()
-		}
-	}
-
 	types, err := utils.ParseTypes(request.Type, request.Tag)
 	if err != nil {
 		return response.BadRequestError(ctx, err)
@@ -178,6 +184,18 @@ func (c *Component) BatchGetAccountsActivities(ctx echo.Context) (err error) {
 		return response.ValidationFailedError(ctx, err)
 	}
 
+	if err = c.resolveAccounts(ctx.Request().Context(), request.Accounts); err != nil {
+		if isResolveRequestError(err) {
+			return response.BadRequestError(ctx, err)
+		}
+
+		zap.L().Error("failed to resolve decentralized accounts",
+			zap.Strings("accounts", request.Accounts),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
 	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, strconv.Itoa(len(request.Accounts)))
 
 	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, strconv.Itoa(len(request.Accounts)))
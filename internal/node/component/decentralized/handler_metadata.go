@@ -7,7 +7,6 @@ import (
 	"strconv"
 
 	"github.com/creasty/defaults"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/labstack/echo/v4"
 	"github.com/rss3-network/node/v2/common/http/response"
 	"github.com/rss3-network/node/v2/docs"
@@ -41,12 +40,6 @@ func (c *Component) BatchGetMetadataActivities(ctx echo.Context) (err error) {
 
 	request.Type = lo.ToPtr(typex)
 
-	for i := range request.Accounts {
-		if common.IsHexAddress(request.Accounts[i]) {
-			request.Accounts[i] = common.HexToAddress(request.Accounts[i]).String()
-		}
-	}
-
 	// Parse the metadata
 	if request.RawMetadata == nil || len(request.RawMetadata) == 0 || string(request.RawMetadata) == "{}" {
 		return response.BadRequestError(ctx, fmt.Errorf("empty metadata"))
@@ -67,6 +60,18 @@ func (c *Component) BatchGetMetadataActivities(ctx echo.Context) (err error) {
 		return response.ValidationFailedError(ctx, err)
 	}
 
+	if err = c.resolveAccounts(ctx.Request().Context(), request.Accounts); err != nil {
+		if isResolveRequestError(err) {
+			return response.BadRequestError(ctx, err)
+		}
+
+		zap.L().Error("failed to resolve decentralized accounts",
+			zap.Strings("accounts", request.Accounts),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
 	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, strconv.Itoa(len(request.Accounts)))
 
 	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, strconv.Itoa(len(request.Accounts)))
@@ -0,0 +1,123 @@
+package decentralized
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/v2/common/http/response"
+	"github.com/rss3-network/node/v2/internal/node/resolver"
+	"go.uber.org/zap"
+)
+
+// ResolveName resolves an ENS name, a Farcaster username, a Lens or Crossbell handle, or a Bluesky handle.
+func (c *Component) ResolveName(ctx echo.Context) error {
+	name := ctx.Param("name")
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, name)
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, name)
+
+	addRecentRequest(ctx.Request().RequestURI)
+
+	zap.L().Debug("processing resolve name request",
+		zap.String("name", name))
+
+	result, err := c.resolver.Resolve(ctx.Request().Context(), name)
+	if err != nil {
+		if isResolveRequestError(err) {
+			return response.BadRequestError(ctx, err)
+		}
+
+		zap.L().Error("failed to resolve name",
+			zap.String("name", name),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, ResolveResponse{
+		Data: result,
+	})
+}
+
+// ReverseAddress resolves an address into the Farcaster usernames of the profiles that have verified it.
+func (c *Component) ReverseAddress(ctx echo.Context) error {
+	address := ctx.Param("address")
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, address)
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, address)
+
+	addRecentRequest(ctx.Request().RequestURI)
+
+	zap.L().Debug("processing reverse address request",
+		zap.String("address", address))
+
+	results, err := c.resolver.ReverseFarcaster(ctx.Request().Context(), address)
+	if err != nil {
+		if isResolveRequestError(err) {
+			return response.BadRequestError(ctx, err)
+		}
+
+		zap.L().Error("failed to reverse address",
+			zap.String("address", address),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, ReverseResponse{
+		Data: results,
+	})
+}
+
+// resolveAccount resolves an account into the EVM address that owns the activities, the accounts that are not
+// names of an EVM name service, such as Arweave addresses or NEAR names, are passed through as they are.
+func (c *Component) resolveAccount(ctx context.Context, account string) (string, error) {
+	if common.IsHexAddress(account) {
+		return common.HexToAddress(account).String(), nil
+	}
+
+	if !resolver.IsAddressName(account) {
+		return account, nil
+	}
+
+	result, err := c.resolver.Resolve(ctx, account)
+	if err != nil {
+		return "", err
+	}
+
+	return result.Address, nil
+}
+
+// resolveAccounts resolves the accounts of a batch request in place.
+func (c *Component) resolveAccounts(ctx context.Context, accounts []string) error {
+	for index := range accounts {
+		address, err := c.resolveAccount(ctx, accounts[index])
+		if err != nil {
+			return err
+		}
+
+		accounts[index] = address
+	}
+
+	return nil
+}
+
+// isResolveRequestError returns whether a resolution failed because of the name rather than the node.
+func isResolveRequestError(err error) bool {
+	return errors.Is(err, resolver.ErrUnsupportedName) ||
+		errors.Is(err, resolver.ErrUnresolvableName) ||
+		errors.Is(err, resolver.ErrUnavailableService)
+}
+
+type ResolveResponse struct {
+	Data *resolver.Result `json:"data"`
+}
+
+type ReverseResponse struct {
+	Data []*resolver.Result `json:"data"`
+}
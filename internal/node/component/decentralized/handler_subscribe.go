@@ -0,0 +1,28 @@
+package decentralized
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/v2/internal/node/component/subscription"
+	"go.uber.org/zap"
+)
+
+// Subscribe streams the activities committed by the workers over WebSocket or Server-Sent Events.
+func (c *Component) Subscribe(ctx echo.Context) error {
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().URL.Path, ctx.QueryString())
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().URL.Path, ctx.QueryString())
+
+	addRecentRequest(ctx.Request().RequestURI)
+
+	zap.L().Debug("processing decentralized subscription request",
+		zap.String("query", ctx.QueryString()))
+
+	return c.subscription.Serve(ctx)
+}
+
+// prepareSubscriptionFilter resolves the names of the owners as the account endpoints do.
+func (c *Component) prepareSubscriptionFilter(ctx context.Context, filter *subscription.Filter) error {
+	return c.resolveAccounts(ctx, filter.Owners)
+}
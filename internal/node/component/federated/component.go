@@ -2,6 +2,7 @@ package federated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -13,11 +14,14 @@ import (
 	"github.com/rss3-network/node/v2/internal/database"
 	"github.com/rss3-network/node/v2/internal/node/component"
 	"github.com/rss3-network/node/v2/internal/node/component/middleware"
+	"github.com/rss3-network/node/v2/internal/node/component/subscription"
+	"github.com/rss3-network/node/v2/internal/stream/pubsub"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
 type Component struct {
@@ -25,6 +29,7 @@ type Component struct {
 	counter        metric.Int64Counter
 	databaseClient database.Client
 	redisClient    rueidis.Client
+	subscription   *subscription.Server
 }
 
 const Name = "federated"
@@ -41,7 +46,7 @@ func (c *Component) Name() string {
 
 var _ component.Component = (*Component)(nil)
 
-func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File, databaseClient database.Client, redisClient rueidis.Client) *Component {
+func NewComponent(ctx context.Context, apiServer *echo.Echo, config *config.File, databaseClient database.Client, redisClient rueidis.Client, authenticator *middleware.Authenticator) *Component {
 	RecentRequests = cb.New(MaxRecentRequests)
 
 	c := &Component{
@@ -53,9 +58,26 @@ func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File,
 	group := apiServer.Group(fmt.Sprintf("/%s", Name))
 
 	// Add middleware for bearer token authentication
-	group.Use(middleware.BearerAuth(config.Discovery.Server.AccessToken))
+	group.Use(authenticator.Middleware(middleware.ScopeFederated))
 
 	group.GET("/handles", c.GetHandles)
+	group.GET("/graph/:account/following", c.GetFollowing)
+	group.GET("/graph/:account/followers", c.GetFollowers)
+
+	// Subscriptions receive the activities committed by the workers through Redis.
+	if redisClient != nil {
+		hub := pubsub.NewHub(redisClient, pubsub.ChannelFederated)
+
+		go func() {
+			if err := hub.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				zap.L().Error("failed to run subscription hub", zap.Error(err))
+			}
+		}()
+
+		c.subscription = subscription.NewServer(hub, databaseClient, nil, c.TransformActivities)
+
+		group.GET("/subscribe", c.Subscribe)
+	}
 
 	if err := c.InitMeter(); err != nil {
 		panic(err)
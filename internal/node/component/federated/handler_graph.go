@@ -0,0 +1,141 @@
+package federated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/creasty/defaults"
+	"github.com/labstack/echo/v4"
+	"github.com/reiver/go-bsky/app/bsky/graph"
+	"github.com/rss3-network/node/v2/common/http/response"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// GetFollowing retrieves the AT Protocol accounts followed by an account.
+func (c *Component) GetFollowing(ctx echo.Context) error {
+	return c.getGraph(ctx, false)
+}
+
+// GetFollowers retrieves the AT Protocol accounts following an account.
+func (c *Component) GetFollowers(ctx echo.Context) error {
+	return c.getGraph(ctx, true)
+}
+
+// getGraph retrieves the follow edges of an account, the edges pointing to the account if reverse is set.
+func (c *Component) getGraph(ctx echo.Context, reverse bool) error {
+	var request GraphRequest
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := defaults.Set(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	zap.L().Debug("processing get graph request", zap.Any("request", request), zap.Bool("reverse", reverse))
+
+	// Validate request
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	did, err := c.resolveBlueskyDID(ctx.Request().Context(), request.Account)
+	if err != nil {
+		zap.L().Error("failed to resolve bluesky account", zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	if did == "" {
+		return response.BadRequestError(ctx, fmt.Errorf("unknown account %s", request.Account))
+	}
+
+	query := model.QueryBlueskyGraphs{
+		Kind:   graph.FollowTypeValue,
+		Limit:  lo.ToPtr(request.Limit),
+		Cursor: request.Cursor,
+	}
+
+	if reverse {
+		query.Subject = lo.ToPtr(did)
+	} else {
+		query.DID = lo.ToPtr(did)
+	}
+
+	edges, err := c.databaseClient.LoadDatasetBlueskyGraphs(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.BadRequestError(ctx, fmt.Errorf("invalid cursor: %w", err))
+		}
+
+		zap.L().Error("failed to load bluesky graphs", zap.Error(err), zap.Any("query", query))
+
+		return response.InternalError(ctx)
+	}
+
+	accounts := make([]GraphAccount, 0, len(edges))
+
+	for _, edge := range edges {
+		accounts = append(accounts, GraphAccount{
+			DID:       lo.Ternary(reverse, edge.DID, edge.Subject),
+			URI:       edge.URI,
+			Timestamp: uint64(edge.CreatedAt.Unix()),
+		})
+	}
+
+	var cursor string
+
+	if last, exist := lo.Last(edges); exist && len(edges) == request.Limit {
+		cursor = last.URI
+	}
+
+	return ctx.JSON(http.StatusOK, PaginatedGraphResponse{
+		Account:    did,
+		Accounts:   accounts,
+		Cursor:     cursor,
+		TotalCount: int64(len(accounts)),
+	})
+}
+
+// resolveBlueskyDID resolves an account to its DID, the handles are looked up in the indexed profiles.
+func (c *Component) resolveBlueskyDID(ctx context.Context, account string) (string, error) {
+	if strings.HasPrefix(account, "did:") {
+		return account, nil
+	}
+
+	profiles, err := c.loadBlueskyProfiles(ctx, model.QueryBlueskyProfiles{Handles: []string{strings.TrimPrefix(account, "@")}})
+	if err != nil {
+		return "", err
+	}
+
+	if len(profiles) == 0 {
+		return "", nil
+	}
+
+	return profiles[0].DID, nil
+}
+
+type GraphRequest struct {
+	Account string  `param:"account" validate:"required"`
+	Limit   int     `query:"limit" default:"100" validate:"omitempty,min=1,max=500"`
+	Cursor  *string `query:"cursor"`
+}
+
+type GraphAccount struct {
+	DID       string `json:"did"`
+	URI       string `json:"uri"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+type PaginatedGraphResponse struct {
+	Account    string         `json:"account"`
+	Accounts   []GraphAccount `json:"accounts"`
+	Cursor     string         `json:"cursor,omitempty"`
+	TotalCount int64          `json:"total_count"`
+}
@@ -0,0 +1,104 @@
+package federated
+
+import (
+	"net/http"
+
+	"github.com/creasty/defaults"
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/v2/common/http/response"
+	"github.com/rss3-network/node/v2/docs"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/utils"
+	"github.com/rss3-network/node/v2/schema/worker/federated"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// searchProtocols are the protocols of the federated networks whose activities can be searched.
+var searchProtocols = []network.Protocol{
+	network.ActivityPubProtocol,
+	network.ATProtocol,
+}
+
+func (c *Component) GetSearchActivities(ctx echo.Context, request docs.GetFederatedSearchParams) (err error) {
+	if request.Type, err = utils.ParseTypes(ctx.QueryParams()["type"], request.Tag); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := defaults.Set(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, request.Keyword)
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, request.Keyword)
+
+	addRecentRequest(ctx.Request().RequestURI)
+
+	zap.L().Debug("processing federated search activities request",
+		zap.String("keyword", request.Keyword),
+		zap.Int("limit", lo.FromPtr(request.Limit)),
+		zap.String("cursor", lo.FromPtr(request.Cursor)))
+
+	// Only the federated networks are searched, the decentralized networks are searched by the decentralized component.
+	networks := lo.FlatMap(searchProtocols, func(protocol network.Protocol, _ int) []network.Network {
+		return protocol.Networks()
+	})
+
+	if len(request.Network) > 0 {
+		if networks = lo.Intersect(networks, lo.Uniq(request.Network)); len(networks) == 0 {
+			return ctx.JSON(http.StatusOK, ActivitiesResponse{})
+		}
+	}
+
+	cursor, err := c.getCursor(ctx.Request().Context(), request.Cursor)
+	if err != nil {
+		zap.L().Error("failed to get federated search activities cursor",
+			zap.String("keyword", request.Keyword),
+			zap.String("cursor", lo.FromPtr(request.Cursor)),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	databaseRequest := model.ActivitiesSearchQuery{
+		Keyword:        request.Keyword,
+		Cursor:         cursor,
+		StartTimestamp: request.SinceTimestamp,
+		EndTimestamp:   request.UntilTimestamp,
+		Limit:          lo.FromPtr(request.Limit),
+		ActionLimit:    lo.FromPtr(request.ActionLimit),
+		Status:         request.Status,
+		Network:        networks,
+		Tags:           lo.Uniq(request.Tag),
+		Types:          lo.Uniq(request.Type),
+		Platforms: lo.Map(lo.Uniq(request.Platform), func(platform federated.Platform, _ int) string {
+			return platform.String()
+		}),
+	}
+
+	activities, last, err := c.searchActivities(ctx.Request().Context(), databaseRequest)
+	if err != nil {
+		zap.L().Error("failed to search federated activities",
+			zap.String("keyword", request.Keyword),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	zap.L().Info("successfully searched federated activities",
+		zap.String("keyword", request.Keyword),
+		zap.Int("count", len(activities)))
+
+	return ctx.JSON(http.StatusOK, ActivitiesResponse{
+		Data: c.TransformActivities(ctx.Request().Context(), activities),
+		Meta: lo.Ternary(len(activities) < databaseRequest.Limit, nil, &MetaCursor{
+			Cursor: last,
+		}),
+	})
+}
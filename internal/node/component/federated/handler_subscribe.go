@@ -0,0 +1,20 @@
+package federated
+
+import (
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// Subscribe streams the activities committed by the workers over WebSocket or Server-Sent Events.
+func (c *Component) Subscribe(ctx echo.Context) error {
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().URL.Path, ctx.QueryString())
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().URL.Path, ctx.QueryString())
+
+	addRecentRequest(ctx.Request().RequestURI)
+
+	zap.L().Debug("processing federated subscription request",
+		zap.String("query", ctx.QueryString()))
+
+	return c.subscription.Serve(ctx)
+}
@@ -16,7 +16,7 @@ import (
 // TransformSocialType adds author url and note url to social actions based on type, network and platform
 func (c *Component) TransformSocialType(ctx context.Context, network network.Network, platform string, action activity.Action) (activity.Action, error) {
 	switch action.Type {
-	case typex.SocialPost, typex.SocialComment, typex.SocialShare, typex.SocialLike:
+	case typex.SocialPost, typex.SocialComment, typex.SocialShare, typex.SocialLike, typex.SocialRevise, typex.SocialDelete:
 		return c.TransformSocialPost(ctx, network, platform, action)
 	}
 
@@ -0,0 +1,207 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	// DefaultMaxDepth is the maximum depth of the fields of a query.
+	DefaultMaxDepth = 12
+	// DefaultMaxComplexity is the maximum number of fields a query may resolve, a query of 100 activities
+	// with 10 actions each is within the limit unless it selects most of the fields of the metadata.
+	DefaultMaxComplexity = 20000
+)
+
+// Limit is the limit of the depth and the complexity of queries, which is checked before a query is executed.
+type Limit struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// Complexity is the estimated cost of a query.
+type Complexity struct {
+	Depth      int
+	Complexity int
+}
+
+// Check returns an error if the complexity of an operation exceeds the limit.
+func (l Limit) Check(document *ast.Document, operationName string, variables map[string]interface{}) (*Complexity, error) {
+	complexity, err := Estimate(document, operationName, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if complexity.Depth > l.MaxDepth {
+		return complexity, fmt.Errorf("query depth %d exceeds the limit of %d", complexity.Depth, l.MaxDepth)
+	}
+
+	if complexity.Complexity > l.MaxComplexity {
+		return complexity, fmt.Errorf("query complexity %d exceeds the limit of %d", complexity.Complexity, l.MaxComplexity)
+	}
+
+	return complexity, nil
+}
+
+// Estimate estimates the depth and the complexity of an operation. Each field costs one, and the fields
+// of a list are multiplied by the number of items the list may have, which is the limit of the activities
+// and the action limit of the actions.
+func Estimate(document *ast.Document, operationName string, variables map[string]interface{}) (*Complexity, error) {
+	var (
+		operation *ast.OperationDefinition
+		fragments = make(map[string]*ast.FragmentDefinition)
+	)
+
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.OperationDefinition:
+			if operationName == "" && operation != nil {
+				return nil, fmt.Errorf("operation name is required for multiple operations")
+			}
+
+			if operationName == "" || definition.Name != nil && definition.Name.Value == operationName {
+				operation = definition
+			}
+		case *ast.FragmentDefinition:
+			fragments[definition.Name.Value] = definition
+		}
+	}
+
+	if operation == nil {
+		return nil, fmt.Errorf("operation %q is not found", operationName)
+	}
+
+	estimator := estimator{
+		fragments: fragments,
+		variables: withDefaultValues(operation, variables),
+		visiting:  make(map[string]bool),
+	}
+
+	cost, depth, err := estimator.selectionSet(operation.SelectionSet, DefaultActionLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Complexity{Depth: depth, Complexity: cost}, nil
+}
+
+// withDefaultValues returns the variables with the default values of the variables which are not provided.
+func withDefaultValues(operation *ast.OperationDefinition, variables map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(variables))
+
+	for name, value := range variables {
+		result[name] = value
+	}
+
+	for _, definition := range operation.VariableDefinitions {
+		name := definition.Variable.Name.Value
+
+		if value, found := result[name]; found && value != nil {
+			continue
+		}
+
+		if value, ok := definition.DefaultValue.(*ast.IntValue); ok {
+			if defaultValue, err := strconv.Atoi(value.Value); err == nil {
+				result[name] = defaultValue
+			}
+		}
+	}
+
+	return result
+}
+
+type estimator struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	// visiting are the fragments being estimated, a cycle of fragments is invalid.
+	visiting map[string]bool
+}
+
+func (e *estimator) selectionSet(selectionSet *ast.SelectionSet, actionLimit int) (cost int, depth int, err error) {
+	if selectionSet == nil {
+		return 0, 0, nil
+	}
+
+	for _, selection := range selectionSet.Selections {
+		var selectionCost, selectionDepth int
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			selectionCost, selectionDepth, err = e.field(selection, actionLimit)
+		case *ast.InlineFragment:
+			selectionCost, selectionDepth, err = e.selectionSet(selection.SelectionSet, actionLimit)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+
+			fragment, found := e.fragments[name]
+			if !found {
+				return 0, 0, fmt.Errorf("fragment %q is not found", name)
+			}
+
+			if e.visiting[name] {
+				return 0, 0, fmt.Errorf("fragment %q spreads itself", name)
+			}
+
+			e.visiting[name] = true
+			selectionCost, selectionDepth, err = e.selectionSet(fragment.SelectionSet, actionLimit)
+			e.visiting[name] = false
+		}
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		cost += selectionCost
+		depth = max(depth, selectionDepth)
+	}
+
+	return cost, depth, nil
+}
+
+func (e *estimator) field(field *ast.Field, actionLimit int) (int, int, error) {
+	multiplier := 1
+
+	switch field.Name.Value {
+	case "activities":
+		multiplier = e.intArgument(field, "limit", DefaultLimit)
+		actionLimit = e.intArgument(field, "action_limit", DefaultActionLimit)
+	case "activity":
+		actionLimit = e.intArgument(field, "action_limit", DefaultActionLimit)
+	case "actions":
+		multiplier = actionLimit
+	}
+
+	cost, depth, err := e.selectionSet(field.SelectionSet, actionLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return 1 + max(multiplier, 1)*cost, depth + 1, nil
+}
+
+// intArgument returns the value of an integer argument, which is a literal or a variable.
+func (e *estimator) intArgument(field *ast.Field, name string, fallback int) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != name {
+			continue
+		}
+
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if result, err := strconv.Atoi(value.Value); err == nil {
+				return result
+			}
+		case *ast.Variable:
+			switch variable := e.variables[value.Name.Value].(type) {
+			case int:
+				return variable
+			case float64:
+				return int(variable)
+			}
+		}
+	}
+
+	return fallback
+}
@@ -0,0 +1,108 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	graphqlx "github.com/graphql-go/graphql"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/constant"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/node/component"
+	"github.com/rss3-network/node/v2/internal/node/component/aggregator"
+	"github.com/rss3-network/node/v2/internal/node/component/middleware"
+	"github.com/rss3-network/node/v2/internal/node/resolver"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Component struct {
+	config           *config.File
+	counter          metric.Int64Counter
+	databaseClient   database.Client
+	authenticator    *middleware.Authenticator
+	resolver         *resolver.Resolver
+	components       aggregator.Component
+	schema           graphqlx.Schema
+	limit            Limit
+	persistedQueries *PersistedQueries
+}
+
+const Name = "graphql"
+
+func (c *Component) Name() string {
+	return Name
+}
+
+var _ component.Component = (*Component)(nil)
+
+// NewComponent creates the GraphQL API over the activity store, the workers and networks are resolved by the
+// info component, and the activities are transformed by the decentralized and federated components if they are enabled.
+func NewComponent(ctx context.Context, apiServer *echo.Echo, config *config.File, databaseClient database.Client, redisClient rueidis.Client, authenticator *middleware.Authenticator, components aggregator.Component) (*Component, error) {
+	c := &Component{
+		config:         config,
+		databaseClient: databaseClient,
+		authenticator:  authenticator,
+		resolver:       resolver.NewResolver(ctx, config.Endpoints, databaseClient, redisClient),
+		components:     components,
+		limit: Limit{
+			MaxDepth:      DefaultMaxDepth,
+			MaxComplexity: DefaultMaxComplexity,
+		},
+		persistedQueries: NewPersistedQueries(redisClient),
+	}
+
+	schema, err := c.buildSchema()
+	if err != nil {
+		return nil, fmt.Errorf("build schema: %w", err)
+	}
+
+	c.schema = schema
+
+	// The fields serve the scopes of the other components, so the scopes are checked by the resolvers.
+	apiServer.GET(fmt.Sprintf("/%s", Name), c.Query, authenticator.KeyMiddleware())
+	apiServer.POST(fmt.Sprintf("/%s", Name), c.Query, authenticator.KeyMiddleware())
+
+	if err := c.InitMeter(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Component) InitMeter() (err error) {
+	meter := otel.GetMeterProvider().Meter(constant.Name)
+
+	if c.counter, err = meter.Int64Counter(c.Name()); err != nil {
+		return fmt.Errorf("failed to init meter for component %s: %w", c.Name(), err)
+	}
+
+	return nil
+}
+
+func (c *Component) CollectMetric(ctx context.Context, path, value string) {
+	measurementOption := metric.WithAttributes(
+		attribute.String("component", c.Name()),
+		attribute.String("path", path),
+		attribute.String("value", value),
+	)
+
+	c.counter.Add(ctx, int64(1), measurementOption)
+}
+
+func (c *Component) CollectTrace(ctx context.Context, path, value string) {
+	spanStartOptions := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("path", path),
+			attribute.String("value", value),
+		),
+	}
+
+	_, span := otel.Tracer("").Start(ctx, "GraphQL API Query", spanStartOptions...)
+	defer span.End()
+}
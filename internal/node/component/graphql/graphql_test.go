@@ -0,0 +1,443 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/dialer"
+	"github.com/rss3-network/node/v2/internal/node/component/aggregator"
+	"github.com/rss3-network/node/v2/internal/node/component/graphql"
+	"github.com/rss3-network/node/v2/internal/node/component/middleware"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+type result struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func TestGraphQL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	databaseClient, err := dialer.Dial(ctx, &config.Database{
+		Driver: database.DriverSQLite,
+		URI:    filepath.Join(t.TempDir(), "node.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, databaseClient.Migrate(ctx))
+
+	owner := "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"
+
+	require.NoError(t, databaseClient.SaveActivities(ctx, []*activityx.Activity{
+		{
+			ID:       "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+			Network:  network.Ethereum,
+			From:     owner,
+			To:       "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+			Tag:      tag.Transaction,
+			Type:     typex.TransactionTransfer,
+			Platform: "Uniswap",
+			Actions: []*activityx.Action{
+				{
+					Tag:  tag.Transaction,
+					Type: typex.TransactionTransfer,
+					From: owner,
+					To:   "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+					Metadata: &metadata.TransactionTransfer{
+						Name:  "Wrapped Ether",
+						Value: lo.ToPtr(decimal.NewFromInt(1)),
+					},
+				},
+			},
+			Status:    true,
+			Timestamp: 1729000000,
+		},
+		{
+			ID:      "0xedc029f7c7acce7b72939f8bfff44c5fdc7e64e3e2ba650d195799db8fec4c90",
+			Network: network.Farcaster,
+			From:    owner,
+			To:      owner,
+			Tag:     tag.Social,
+			Type:    typex.SocialPost,
+			Actions: []*activityx.Action{
+				{
+					Tag:  tag.Social,
+					Type: typex.SocialPost,
+					From: owner,
+					To:   owner,
+					Metadata: &metadata.SocialPost{
+						Handle: "vitalik.eth",
+						Body:   "Hello, GraphQL!",
+					},
+				},
+			},
+			Status:    true,
+			Timestamp: 1728990000,
+		},
+	}, true))
+
+	authenticator, err := middleware.NewAuthenticator(&config.Server{}, nil)
+	require.NoError(t, err)
+
+	apiServer := echo.New()
+
+	_, err = graphql.NewComponent(ctx, apiServer, &config.File{}, databaseClient, nil, authenticator, aggregator.Component{})
+	require.NoError(t, err)
+
+	post := func(t *testing.T, body any) (int, result) {
+		t.Helper()
+
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		request := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(data)))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		recorder := httptest.NewRecorder()
+		apiServer.ServeHTTP(recorder, request)
+
+		var response result
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+
+		return recorder.Code, response
+	}
+
+	t.Run("Activities With Typed Metadata", func(t *testing.T) {
+		t.Parallel()
+
+		status, response := post(t, map[string]any{
+			"query": `query Activities($owner: String!) {
+				activities(owners: [$owner], limit: 1) {
+					edges {
+						cursor
+						node {
+							id
+							network
+							type
+							success
+							timestamp
+							actions {
+								tag
+								metadata {
+									__typename
+									... on TransactionTransfer { name value }
+								}
+							}
+						}
+					}
+					page_info { has_next_page end_cursor }
+				}
+			}`,
+			"variables": map[string]any{"owner": owner},
+		})
+		require.Equal(t, http.StatusOK, status)
+		require.Empty(t, response.Errors)
+
+		require.JSONEq(t, `{
+			"activities": {
+				"edges": [{
+					"cursor": "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101:ethereum",
+					"node": {
+						"id": "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+						"network": "ethereum",
+						"type": "transfer",
+						"success": true,
+						"timestamp": 1729000000,
+						"actions": [{
+							"tag": "transaction",
+							"metadata": {"__typename": "TransactionTransfer", "name": "Wrapped Ether", "value": "1"}
+						}]
+					}
+				}],
+				"page_info": {
+					"has_next_page": true,
+					"end_cursor": "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101:ethereum"
+				}
+			}
+		}`, string(response.Data))
+
+		status, response = post(t, map[string]any{
+			"query": `{
+				activities(owners: ["` + owner + `"], limit: 1, after: "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101:ethereum") {
+					edges { node { id actions { metadata { ... on SocialPost { handle body } } } } }
+				}
+			}`,
+		})
+		require.Equal(t, http.StatusOK, status)
+		require.Empty(t, response.Errors)
+
+		require.JSONEq(t, `{
+			"activities": {
+				"edges": [{
+					"node": {
+						"id": "0xedc029f7c7acce7b72939f8bfff44c5fdc7e64e3e2ba650d195799db8fec4c90",
+						"actions": [{"metadata": {"handle": "vitalik.eth", "body": "Hello, GraphQL!"}}]
+					}
+				}]
+			}
+		}`, string(response.Data))
+	})
+
+	t.Run("Invalid Argument", func(t *testing.T) {
+		t.Parallel()
+
+		status, response := post(t, map[string]any{
+			"query": `{ activities(network: ["invalid"]) { edges { cursor } } }`,
+		})
+		require.Equal(t, http.StatusOK, status)
+		require.Len(t, response.Errors, 1)
+		require.Contains(t, response.Errors[0].Message, "invalid network")
+	})
+
+	t.Run("Complexity Limit", func(t *testing.T) {
+		t.Parallel()
+
+		status, response := post(t, map[string]any{
+			"query": `{
+				activities(limit: 100, action_limit: 20) {
+					edges { node { actions { from to tag type platform related_urls metadata { ... on SocialPost {
+						handle title summary body profile_id publication_id content_uri tags author_url timestamp target_url
+					} } } } }
+				}
+			}`,
+		})
+		require.Equal(t, http.StatusBadRequest, status)
+		require.Len(t, response.Errors, 1)
+		require.Contains(t, response.Errors[0].Message, "exceeds the limit")
+	})
+
+	t.Run("Persisted Query", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ activity(id: "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101") { id platform } }`
+		extensions := map[string]any{
+			"persistedQuery": map[string]any{"version": 1, "sha256Hash": graphql.HashQuery(query)},
+		}
+
+		// The query is not persisted until it is sent with its hash.
+		status, response := post(t, map[string]any{"extensions": extensions})
+		require.Equal(t, http.StatusOK, status)
+		require.Len(t, response.Errors, 1)
+		require.Equal(t, graphql.ErrorCodePersistedQueryNotFound, response.Errors[0].Extensions["code"])
+
+		status, response = post(t, map[string]any{"query": query, "extensions": extensions})
+		require.Equal(t, http.StatusOK, status)
+		require.Empty(t, response.Errors)
+
+		data, err := json.Marshal(extensions)
+		require.NoError(t, err)
+
+		// The persisted query is sent by its hash in a GET request.
+		request := httptest.NewRequest(http.MethodGet, "/graphql?extensions="+url.QueryEscape(string(data)), nil)
+		recorder := httptest.NewRecorder()
+		apiServer.ServeHTTP(recorder, request)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.JSONEq(t, `{"data": {"activity": {"id": "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101", "platform": "Uniswap"}}}`, recorder.Body.String())
+
+		status, response = post(t, map[string]any{"query": query + " ", "extensions": extensions})
+		require.Equal(t, http.StatusBadRequest, status)
+		require.Len(t, response.Errors, 1)
+
+		// The invalid queries are not persisted.
+		invalidQuery := `{ activity(id: "id") { unknown } }`
+		invalidExtensions := map[string]any{
+			"persistedQuery": map[string]any{"version": 1, "sha256Hash": graphql.HashQuery(invalidQuery)},
+		}
+
+		status, _ = post(t, map[string]any{"query": invalidQuery, "extensions": invalidExtensions})
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, response = post(t, map[string]any{"extensions": invalidExtensions})
+		require.Equal(t, http.StatusOK, status)
+		require.Len(t, response.Errors, 1)
+		require.Equal(t, graphql.ErrorCodePersistedQueryNotFound, response.Errors[0].Extensions["code"])
+	})
+}
+
+func TestEstimate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		want      graphql.Complexity
+	}{
+		{
+			name:  "Fields",
+			query: `{ node { version { tag commit } } }`,
+			want:  graphql.Complexity{Depth: 3, Complexity: 4},
+		},
+		{
+			name:  "Limit",
+			query: `{ activities(limit: 10) { edges { cursor } } }`,
+			want:  graphql.Complexity{Depth: 3, Complexity: 21},
+		},
+		{
+			name:      "Action Limit Of Variable",
+			query:     `query ($limit: Int) { activities(limit: 2, action_limit: $limit) { edges { node { actions { tag } } } } }`,
+			variables: map[string]interface{}{"limit": float64(5)},
+			want:      graphql.Complexity{Depth: 5, Complexity: 17},
+		},
+		{
+			name:  "Limit Of Default Variable",
+			query: `query ($limit: Int = 50) { activities(limit: $limit) { edges { cursor } } }`,
+			want:  graphql.Complexity{Depth: 3, Complexity: 101},
+		},
+		{
+			name:  "Fragment",
+			query: `query { activity(id: "id") { ...fields } } fragment fields on Activity { id actions { tag } }`,
+			want:  graphql.Complexity{Depth: 3, Complexity: 13},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			document, err := parser.Parse(parser.ParseParams{Source: testcase.query})
+			require.NoError(t, err)
+
+			complexity, err := graphql.Estimate(document, "", testcase.variables)
+			require.NoError(t, err)
+			require.Equal(t, testcase.want, *complexity)
+		})
+	}
+}
+
+func TestGraphQLScopes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	databaseClient, err := dialer.Dial(ctx, &config.Database{
+		Driver: database.DriverSQLite,
+		URI:    filepath.Join(t.TempDir(), "node.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, databaseClient.Migrate(ctx))
+
+	require.NoError(t, databaseClient.SaveActivities(ctx, []*activityx.Activity{
+		{
+			ID:        "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+			Network:   network.Ethereum,
+			From:      "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+			To:        "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+			Tag:       tag.Transaction,
+			Type:      typex.TransactionTransfer,
+			Status:    true,
+			Timestamp: 1729000000,
+		},
+		{
+			ID:        "https://mastodon.social/users/Gargron/statuses/113386226233185404",
+			Network:   network.Mastodon,
+			From:      "Gargron@mastodon.social",
+			To:        "Gargron@mastodon.social",
+			Tag:       tag.Social,
+			Type:      typex.SocialPost,
+			Status:    true,
+			Timestamp: 1729000100,
+		},
+	}, true))
+
+	authenticator, err := middleware.NewAuthenticator(&config.Server{
+		APIKeys: []*config.APIKey{
+			{
+				Name:   "decentralized",
+				Key:    "decentralized-api-key",
+				Scopes: []string{"decentralized"},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	apiServer := echo.New()
+
+	_, err = graphql.NewComponent(ctx, apiServer, &config.File{}, databaseClient, nil, authenticator, aggregator.Component{})
+	require.NoError(t, err)
+
+	post := func(t *testing.T, token, query string) (int, result) {
+		t.Helper()
+
+		data, err := json.Marshal(map[string]any{"query": query})
+		require.NoError(t, err)
+
+		request := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(data)))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		recorder := httptest.NewRecorder()
+		apiServer.ServeHTTP(recorder, request)
+
+		var response result
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		}
+
+		return recorder.Code, response
+	}
+
+	t.Run("Missing Access Token", func(t *testing.T) {
+		t.Parallel()
+
+		status, _ := post(t, "", `{ activities { edges { cursor } } }`)
+		require.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("Activities Of The Allowed Networks", func(t *testing.T) {
+		t.Parallel()
+
+		status, response := post(t, "decentralized-api-key", `{ activities { edges { node { network } } } }`)
+		require.Equal(t, http.StatusOK, status)
+		require.Empty(t, response.Errors)
+		require.JSONEq(t, `{"activities": {"edges": [{"node": {"network": "ethereum"}}]}}`, string(response.Data))
+	})
+
+	t.Run("Forbidden Fields", func(t *testing.T) {
+		t.Parallel()
+
+		testcases := []struct {
+			query string
+			scope string
+		}{
+			{query: `{ activities(network: ["mastodon"]) { edges { cursor } } }`, scope: "federated"},
+			{query: `{ activity(id: "https://mastodon.social/users/Gargron/statuses/113386226233185404") { id } }`, scope: "federated"},
+			{query: `{ node { version { tag } } }`, scope: "info"},
+		}
+
+		for _, testcase := range testcases {
+			status, response := post(t, "decentralized-api-key", testcase.query)
+			require.Equal(t, http.StatusOK, status)
+			require.Len(t, response.Errors, 1)
+			require.Contains(t, response.Errors[0].Message, "not allowed to access "+testcase.scope)
+		}
+	})
+}
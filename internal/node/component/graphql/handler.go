@@ -0,0 +1,141 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	graphqlx "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// Request is a GraphQL request, which is the JSON body of a POST request or the parameters of a GET request.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+	Extensions    Extensions             `json:"extensions"`
+}
+
+type Extensions struct {
+	PersistedQuery *PersistedQuery `json:"persistedQuery,omitempty"`
+}
+
+// Query executes a GraphQL query, the errors of the query are returned in the result as the specification requires.
+func (c *Component) Query(ctx echo.Context) error {
+	request, err := parseRequest(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, newErrorResult(err, ""))
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, request.OperationName)
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, request.OperationName)
+
+	zap.L().Debug("processing graphql request",
+		zap.String("operation_name", request.OperationName))
+
+	status, result := c.execute(ctx.Request().Context(), request)
+
+	return ctx.JSON(status, result)
+}
+
+// execute parses, validates and checks the complexity of a query before it is executed.
+func (c *Component) execute(ctx context.Context, request *Request) (int, *graphqlx.Result) {
+	query, err := c.persistedQueries.Resolve(ctx, request.Query, request.Extensions.PersistedQuery)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrPersistedQueryNotFound):
+			// The client sends the query again once the persisted query is not found.
+			return http.StatusOK, newErrorResult(err, ErrorCodePersistedQueryNotFound)
+		case errors.Is(err, ErrPersistedQueryNotSupported):
+			return http.StatusOK, newErrorResult(err, ErrorCodePersistedQueryNotSupported)
+		default:
+			return http.StatusBadRequest, newErrorResult(err, "")
+		}
+	}
+
+	if query == "" {
+		return http.StatusBadRequest, newErrorResult(errors.New("query is required"), "")
+	}
+
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(query),
+			Name: "GraphQL request",
+		}),
+	})
+	if err != nil {
+		return http.StatusBadRequest, &graphqlx.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	if validation := graphqlx.ValidateDocument(&c.schema, document, nil); !validation.IsValid {
+		return http.StatusBadRequest, &graphqlx.Result{Errors: validation.Errors}
+	}
+
+	if _, err := c.limit.Check(document, request.OperationName, request.Variables); err != nil {
+		return http.StatusBadRequest, newErrorResult(err, "")
+	}
+
+	// The query is persisted only if it is valid and within the limit.
+	c.persistedQueries.Save(ctx, request.Query, request.Extensions.PersistedQuery)
+
+	return http.StatusOK, graphqlx.Execute(graphqlx.ExecuteParams{
+		Schema:        c.schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       ctx,
+	})
+}
+
+// parseRequest parses a request from the body of a POST request, or from the parameters of a GET request
+// whose variables and extensions are encoded in JSON.
+func parseRequest(ctx echo.Context) (*Request, error) {
+	var request Request
+
+	if ctx.Request().Method == http.MethodPost {
+		if err := json.NewDecoder(ctx.Request().Body).Decode(&request); err != nil {
+			return nil, fmt.Errorf("invalid request body: %w", err)
+		}
+
+		return &request, nil
+	}
+
+	request.Query = ctx.QueryParam("query")
+	request.OperationName = ctx.QueryParam("operationName")
+
+	if variables := ctx.QueryParam("variables"); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+			return nil, fmt.Errorf("invalid variables: %w", err)
+		}
+	}
+
+	if extensions := ctx.QueryParam("extensions"); extensions != "" {
+		if err := json.Unmarshal([]byte(extensions), &request.Extensions); err != nil {
+			return nil, fmt.Errorf("invalid extensions: %w", err)
+		}
+	}
+
+	return &request, nil
+}
+
+func newErrorResult(err error, code string) *graphqlx.Result {
+	formattedError := gqlerrors.NewFormattedError(err.Error())
+
+	if code != "" {
+		formattedError.Extensions = map[string]interface{}{
+			"code": code,
+		}
+	}
+
+	return &graphqlx.Result{
+		Errors: []gqlerrors.FormattedError{formattedError},
+	}
+}
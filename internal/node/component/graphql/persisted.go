@@ -0,0 +1,148 @@
+package graphql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/redis/rueidis"
+	"go.uber.org/zap"
+)
+
+const (
+	// persistedQueryCacheSize is the number of queries persisted in memory.
+	persistedQueryCacheSize = 1024
+	// persistedQueryExpiration is the expiration of a persisted query since it is last registered.
+	persistedQueryExpiration = 7 * 24 * time.Hour
+	// persistedQueryMaxSize is the maximum size in bytes of a persisted query, the larger queries are executed but not persisted.
+	persistedQueryMaxSize = 16 * 1024
+
+	ErrorCodePersistedQueryNotFound     = "PERSISTED_QUERY_NOT_FOUND"
+	ErrorCodePersistedQueryNotSupported = "PERSISTED_QUERY_NOT_SUPPORTED"
+)
+
+var (
+	ErrPersistedQueryNotFound     = errors.New("PersistedQueryNotFound")
+	ErrPersistedQueryNotSupported = errors.New("PersistedQueryNotSupported")
+	ErrPersistedQueryHashMismatch = errors.New("provided sha does not match query")
+)
+
+// PersistedQuery is the extension of automatic persisted queries, a client sends the hash of a query
+// and sends the query again only if it is not persisted.
+type PersistedQuery struct {
+	Version    int    `json:"version"`
+	SHA256Hash string `json:"sha256Hash"`
+}
+
+// PersistedQueries persists the queries by their hashes, in Redis if it is available so the
+// queries are shared by the Core instances, or in memory otherwise.
+type PersistedQueries struct {
+	redisClient rueidis.Client
+	cache       *expirable.LRU[string, string]
+}
+
+// Resolve returns the query of a request, which is loaded by its hash if the request has no query.
+// The query sent with its hash is checked against the hash, and is persisted by Save once it is validated.
+func (p *PersistedQueries) Resolve(ctx context.Context, query string, persistedQuery *PersistedQuery) (string, error) {
+	if persistedQuery == nil {
+		return query, nil
+	}
+
+	if persistedQuery.Version != 1 {
+		return "", ErrPersistedQueryNotSupported
+	}
+
+	hash := strings.ToLower(persistedQuery.SHA256Hash)
+
+	if query == "" {
+		query, found := p.load(ctx, hash)
+		if !found {
+			return "", ErrPersistedQueryNotFound
+		}
+
+		return query, nil
+	}
+
+	if HashQuery(query) != hash {
+		return "", ErrPersistedQueryHashMismatch
+	}
+
+	return query, nil
+}
+
+// Save persists a query sent with its hash, the queries sent by their hashes only are already persisted.
+func (p *PersistedQueries) Save(ctx context.Context, query string, persistedQuery *PersistedQuery) {
+	if persistedQuery == nil || query == "" {
+		return
+	}
+
+	if len(query) > persistedQueryMaxSize {
+		zap.L().Debug("skipping the persisted query exceeding the size limit", zap.Int("size", len(query)))
+
+		return
+	}
+
+	p.save(ctx, strings.ToLower(persistedQuery.SHA256Hash), query)
+}
+
+func (p *PersistedQueries) load(ctx context.Context, hash string) (string, bool) {
+	if p.redisClient == nil {
+		return p.cache.Get(hash)
+	}
+
+	command := p.redisClient.B().Get().Key(buildPersistedQueryKey(hash)).Build()
+
+	query, err := p.redisClient.Do(ctx, command).ToString()
+	if err != nil {
+		if !errors.Is(err, rueidis.Nil) {
+			zap.L().Warn("failed to load persisted query", zap.String("hash", hash), zap.Error(err))
+		}
+
+		return "", false
+	}
+
+	return query, true
+}
+
+// save persists a query, failures are only logged since the client sends the query again if it is not found.
+func (p *PersistedQueries) save(ctx context.Context, hash, query string) {
+	if p.redisClient == nil {
+		p.cache.Add(hash, query)
+
+		return
+	}
+
+	command := p.redisClient.B().Set().
+		Key(buildPersistedQueryKey(hash)).
+		Value(query).
+		Ex(persistedQueryExpiration).
+		Build()
+
+	if err := p.redisClient.Do(ctx, command).Error(); err != nil {
+		zap.L().Warn("failed to save persisted query", zap.String("hash", hash), zap.Error(err))
+	}
+}
+
+// HashQuery returns the hex encoded SHA-256 hash of a query.
+func HashQuery(query string) string {
+	hash := sha256.Sum256([]byte(query))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// buildPersistedQueryKey builds the key of a persisted query
+func buildPersistedQueryKey(hash string) string {
+	return fmt.Sprintf("graphql:persisted_query:%s", hash)
+}
+
+func NewPersistedQueries(redisClient rueidis.Client) *PersistedQueries {
+	return &PersistedQueries{
+		redisClient: redisClient,
+		cache:       expirable.NewLRU[string, string](persistedQueryCacheSize, nil, persistedQueryExpiration),
+	}
+}
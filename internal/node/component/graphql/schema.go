@@ -0,0 +1,504 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	graphqlx "github.com/graphql-go/graphql"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/node/component/info"
+	"github.com/rss3-network/node/v2/internal/node/component/middleware"
+	"github.com/rss3-network/node/v2/internal/node/resolver"
+	"github.com/rss3-network/node/v2/internal/utils"
+	"github.com/rss3-network/node/v2/schema/worker/decentralized"
+	"github.com/rss3-network/node/v2/schema/worker/federated"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultLimit       = 100
+	MaxLimit           = 100
+	DefaultActionLimit = 10
+	MaxActionLimit     = 20
+)
+
+var (
+	ErrDatabaseUnavailable = errors.New("activities are not available without a database")
+	ErrInfoUnavailable     = errors.New("node info is not available")
+	ErrForbidden           = errors.New("the access token is not allowed to access")
+	// ErrInternal is returned instead of the errors of the node, which should not be returned to the clients for safety reasons.
+	ErrInternal = errors.New("an internal error has occurred, please try again later")
+)
+
+// ActivityConnection is a page of activities, the end cursor of the page is the cursor of the next page.
+type ActivityConnection struct {
+	Edges    []*ActivityEdge `json:"edges"`
+	PageInfo PageInfo        `json:"page_info"`
+}
+
+type ActivityEdge struct {
+	Cursor string              `json:"cursor"`
+	Node   *activityx.Activity `json:"node"`
+}
+
+type PageInfo struct {
+	HasNextPage bool    `json:"has_next_page"`
+	EndCursor   *string `json:"end_cursor"`
+}
+
+// buildSchema builds the schema, the types of the activities, the workers and the networks are built from
+// the Go types, so they have the same fields as the JSON responses of the REST API.
+func (c *Component) buildSchema() (graphqlx.Schema, error) {
+	builder := newTypeBuilder()
+
+	builder.aliases[reflect.TypeOf(info.ComponentInfo{})] = "Workers"
+	builder.aliases[reflect.TypeOf(info.NetworkConfig{})] = "Networks"
+
+	activity := builder.Output(reflect.TypeOf(activityx.Activity{}))
+
+	query := graphqlx.NewObject(graphqlx.ObjectConfig{
+		Name: "Query",
+		Fields: graphqlx.Fields{
+			"activity": &graphqlx.Field{
+				Type:        activity,
+				Description: "Retrieve an activity by its ID, the actions are paginated by the action limit.",
+				Args: graphqlx.FieldConfigArgument{
+					"id":           &graphqlx.ArgumentConfig{Type: graphqlx.NewNonNull(graphqlx.String)},
+					"action_limit": &graphqlx.ArgumentConfig{Type: graphqlx.Int, DefaultValue: DefaultActionLimit},
+					"action_page":  &graphqlx.ArgumentConfig{Type: graphqlx.Int, DefaultValue: 1},
+				},
+				Resolve: c.resolveActivity,
+			},
+			"activities": &graphqlx.Field{
+				Type:        graphqlx.NewNonNull(builder.Output(reflect.TypeOf(ActivityConnection{}))),
+				Description: "Retrieve the activities from the latest, the owners may be addresses or names resolved by the name services.",
+				Args: graphqlx.FieldConfigArgument{
+					"owners":          &graphqlx.ArgumentConfig{Type: graphqlx.NewList(graphqlx.NewNonNull(graphqlx.String))},
+					"network":         &graphqlx.ArgumentConfig{Type: graphqlx.NewList(graphqlx.NewNonNull(graphqlx.String))},
+					"tag":             &graphqlx.ArgumentConfig{Type: graphqlx.NewList(graphqlx.NewNonNull(graphqlx.String))},
+					"type":            &graphqlx.ArgumentConfig{Type: graphqlx.NewList(graphqlx.NewNonNull(graphqlx.String))},
+					"platform":        &graphqlx.ArgumentConfig{Type: graphqlx.NewList(graphqlx.NewNonNull(graphqlx.String))},
+					"status":          &graphqlx.ArgumentConfig{Type: graphqlx.Boolean},
+					"direction":       &graphqlx.ArgumentConfig{Type: graphqlx.String},
+					"since_timestamp": &graphqlx.ArgumentConfig{Type: Int64},
+					"until_timestamp": &graphqlx.ArgumentConfig{Type: Int64},
+					"limit":           &graphqlx.ArgumentConfig{Type: graphqlx.Int, DefaultValue: DefaultLimit},
+					"action_limit":    &graphqlx.ArgumentConfig{Type: graphqlx.Int, DefaultValue: DefaultActionLimit},
+					"after":           &graphqlx.ArgumentConfig{Type: graphqlx.String},
+				},
+				Resolve: c.resolveActivities,
+			},
+			"workers": &graphqlx.Field{
+				Type:        builder.Output(reflect.TypeOf(info.ComponentInfo{})),
+				Description: "Retrieve the status of the workers.",
+				Resolve: func(p graphqlx.ResolveParams) (interface{}, error) {
+					if err := c.authorize(p.Context, middleware.ScopeInfo); err != nil {
+						return nil, err
+					}
+
+					if c.components.Info == nil {
+						return nil, ErrInfoUnavailable
+					}
+
+					return c.components.Info.WorkersStatus(p.Context), nil
+				},
+			},
+			"networks": &graphqlx.Field{
+				Type:        builder.Output(reflect.TypeOf(info.NetworkConfig{})),
+				Description: "Retrieve the configuration of the supported networks.",
+				Resolve: func(p graphqlx.ResolveParams) (interface{}, error) {
+					if err := c.authorize(p.Context, middleware.ScopeInfo); err != nil {
+						return nil, err
+					}
+
+					if c.components.Info == nil {
+						return nil, ErrInfoUnavailable
+					}
+
+					return c.components.Info.NetworkConfig(), nil
+				},
+			},
+			"node": &graphqlx.Field{
+				Type:        builder.Output(reflect.TypeOf(info.NodeInfo{})),
+				Description: "Retrieve the version, operator, coverage and records of the node.",
+				Resolve: func(p graphqlx.ResolveParams) (interface{}, error) {
+					if err := c.authorize(p.Context, middleware.ScopeInfo); err != nil {
+						return nil, err
+					}
+
+					if c.components.Info == nil {
+						return nil, ErrInfoUnavailable
+					}
+
+					nodeInfo, err := c.components.Info.NodeInfo(p.Context)
+					if err != nil {
+						zap.L().Error("failed to get node info", zap.Error(err))
+
+						return nil, ErrInternal
+					}
+
+					return nodeInfo, nil
+				},
+			},
+		},
+	})
+
+	return graphqlx.NewSchema(graphqlx.SchemaConfig{
+		Query: query,
+	})
+}
+
+func (c *Component) resolveActivity(p graphqlx.ResolveParams) (interface{}, error) {
+	if c.databaseClient == nil {
+		return nil, ErrDatabaseUnavailable
+	}
+
+	query := model.ActivityQuery{
+		ID:          lo.ToPtr(p.Args["id"].(string)),
+		ActionLimit: intArgument(p.Args, "action_limit", DefaultActionLimit),
+		ActionPage:  intArgument(p.Args, "action_page", 1),
+	}
+
+	if query.ActionLimit < 1 || query.ActionLimit > MaxActionLimit {
+		return nil, fmt.Errorf("action_limit must be between 1 and %d", MaxActionLimit)
+	}
+
+	if query.ActionPage < 1 {
+		return nil, fmt.Errorf("action_page must be positive")
+	}
+
+	activity, _, err := c.databaseClient.FindActivity(p.Context, query)
+	if err != nil {
+		zap.L().Error("failed to get activity",
+			zap.String("id", lo.FromPtr(query.ID)),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	if activity == nil {
+		return nil, nil
+	}
+
+	if err := c.authorize(p.Context, networkScope(activity.Network)); err != nil {
+		return nil, err
+	}
+
+	return c.transformActivities(p.Context, []*activityx.Activity{activity})[0], nil
+}
+
+func (c *Component) resolveActivities(p graphqlx.ResolveParams) (interface{}, error) {
+	if c.databaseClient == nil {
+		return nil, ErrDatabaseUnavailable
+	}
+
+	query, err := c.buildActivitiesQuery(p.Context, p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := c.databaseClient.FindActivities(p.Context, *query)
+	if err != nil {
+		zap.L().Error("failed to get activities", zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	connection := ActivityConnection{
+		Edges: make([]*ActivityEdge, 0, len(activities)),
+		PageInfo: PageInfo{
+			// The activities are paginated as the REST API does, a full page may be followed by an empty page.
+			HasNextPage: len(activities) >= query.Limit,
+		},
+	}
+
+	for index, activity := range c.transformActivities(p.Context, activities) {
+		connection.Edges = append(connection.Edges, &ActivityEdge{
+			Cursor: transformCursor(activities[index]),
+			Node:   activity,
+		})
+	}
+
+	if last, exist := lo.Last(activities); exist {
+		connection.PageInfo.EndCursor = lo.ToPtr(transformCursor(last))
+	}
+
+	return &connection, nil
+}
+
+// buildActivitiesQuery builds the query of the activities from the arguments, which are parsed as the parameters of the REST API.
+func (c *Component) buildActivitiesQuery(ctx context.Context, args map[string]interface{}) (*model.ActivitiesQuery, error) {
+	query := model.ActivitiesQuery{
+		Limit:       intArgument(args, "limit", DefaultLimit),
+		ActionLimit: intArgument(args, "action_limit", DefaultActionLimit),
+	}
+
+	if query.Limit < 1 || query.Limit > MaxLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d", MaxLimit)
+	}
+
+	if query.ActionLimit < 1 || query.ActionLimit > MaxActionLimit {
+		return nil, fmt.Errorf("action_limit must be between 1 and %d", MaxActionLimit)
+	}
+
+	for _, value := range stringsArgument(args, "network") {
+		networkValue, err := network.NetworkString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %s: %w", value, err)
+		}
+
+		query.Network = append(query.Network, networkValue)
+	}
+
+	query.Network = lo.Uniq(query.Network)
+
+	if err := c.authorizeNetworks(ctx, &query); err != nil {
+		return nil, err
+	}
+
+	for _, owner := range stringsArgument(args, "owners") {
+		result, err := c.resolver.Resolve(ctx, owner)
+
+		switch {
+		case err == nil:
+			query.Owners = append(query.Owners, result.Address)
+		case errors.Is(err, resolver.ErrUnsupportedName):
+			// The handles of the federated accounts are not names of the name services.
+			query.Owners = append(query.Owners, owner)
+		case errors.Is(err, resolver.ErrUnresolvableName), errors.Is(err, resolver.ErrUnavailableService):
+			return nil, err
+		default:
+			zap.L().Error("failed to resolve owner",
+				zap.String("owner", owner),
+				zap.Error(err))
+
+			return nil, ErrInternal
+		}
+	}
+
+	for _, value := range stringsArgument(args, "tag") {
+		tagValue, err := tag.TagString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag %s: %w", value, err)
+		}
+
+		query.Tags = append(query.Tags, tagValue)
+	}
+
+	types, err := utils.ParseTypes(stringsArgument(args, "type"), query.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Types = types
+
+	for _, value := range stringsArgument(args, "platform") {
+		platform, err := parsePlatform(value)
+		if err != nil {
+			return nil, err
+		}
+
+		query.Platforms = append(query.Platforms, platform)
+	}
+
+	if status, ok := args["status"].(bool); ok {
+		query.Status = lo.ToPtr(status)
+	}
+
+	if value, ok := args["direction"].(string); ok {
+		direction, err := activityx.DirectionString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid direction %s: %w", value, err)
+		}
+
+		query.Direction = lo.ToPtr(direction)
+	}
+
+	if timestamp, ok := args["since_timestamp"].(int64); ok {
+		query.StartTimestamp = lo.ToPtr(uint64(timestamp))
+	}
+
+	if timestamp, ok := args["until_timestamp"].(int64); ok {
+		query.EndTimestamp = lo.ToPtr(uint64(timestamp))
+	}
+
+	if cursor, ok := args["after"].(string); ok {
+		if query.Cursor, err = c.getCursor(ctx, cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	query.Tags = lo.Uniq(query.Tags)
+	query.Types = lo.Uniq(query.Types)
+	query.Platforms = lo.Uniq(query.Platforms)
+
+	return &query, nil
+}
+
+// authorize returns an error if the key of the request is not allowed to access the scope.
+func (c *Component) authorize(ctx context.Context, scope middleware.Scope) error {
+	if !c.authenticator.Allowed(ctx, scope) {
+		return fmt.Errorf("%w %s", ErrForbidden, scope)
+	}
+
+	return nil
+}
+
+// authorizeNetworks checks the scopes of the queried networks, the query of all networks
+// is limited to the networks of the scopes the key of the request is allowed to access.
+func (c *Component) authorizeNetworks(ctx context.Context, query *model.ActivitiesQuery) error {
+	if len(query.Network) > 0 {
+		for _, networkValue := range query.Network {
+			if err := c.authorize(ctx, networkScope(networkValue)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if lo.EveryBy([]middleware.Scope{middleware.ScopeDecentralized, middleware.ScopeFederated, middleware.ScopeRSS}, func(scope middleware.Scope) bool {
+		return c.authenticator.Allowed(ctx, scope)
+	}) {
+		return nil
+	}
+
+	query.Network = lo.Filter(network.NetworkValues(), func(networkValue network.Network, _ int) bool {
+		scope := networkScope(networkValue)
+
+		return scope != "" && c.authenticator.Allowed(ctx, scope)
+	})
+
+	if len(query.Network) == 0 {
+		return fmt.Errorf("%w the activities of any network", ErrForbidden)
+	}
+
+	return nil
+}
+
+// networkScope returns the scope of the component serving the activities of a network.
+func networkScope(networkValue network.Network) middleware.Scope {
+	switch networkValue.Protocol() {
+	case network.ArweaveProtocol, network.EthereumProtocol, network.FarcasterProtocol, network.NearProtocol:
+		return middleware.ScopeDecentralized
+	case network.ActivityPubProtocol, network.ATProtocol:
+		return middleware.ScopeFederated
+	case network.RSSProtocol:
+		return middleware.ScopeRSS
+	default:
+		return ""
+	}
+}
+
+// transformActivities transforms the activities by the components of their networks as the REST API does,
+// the activities of the components that are not enabled are returned as they are stored.
+func (c *Component) transformActivities(ctx context.Context, activities []*activityx.Activity) []*activityx.Activity {
+	var decentralizedIndexes, federatedIndexes []int
+
+	for index, activity := range activities {
+		switch activity.Network.Protocol() {
+		case network.ArweaveProtocol, network.EthereumProtocol, network.FarcasterProtocol, network.NearProtocol:
+			if c.components.Decentralized != nil {
+				decentralizedIndexes = append(decentralizedIndexes, index)
+			}
+		case network.ActivityPubProtocol, network.ATProtocol:
+			if c.components.Federated != nil {
+				federatedIndexes = append(federatedIndexes, index)
+			}
+		}
+	}
+
+	results := append([]*activityx.Activity(nil), activities...)
+
+	transform := func(indexes []int, transformer func(context.Context, []*activityx.Activity) []*activityx.Activity) {
+		if len(indexes) == 0 {
+			return
+		}
+
+		transformed := transformer(ctx, lo.Map(indexes, func(index int, _ int) *activityx.Activity {
+			return activities[index]
+		}))
+
+		// The activities failed to be transformed are returned as they are stored.
+		for i, index := range indexes {
+			if transformed[i] != nil {
+				results[index] = transformed[i]
+			}
+		}
+	}
+
+	if c.components.Decentralized != nil {
+		transform(decentralizedIndexes, c.components.Decentralized.TransformActivities)
+	}
+
+	if c.components.Federated != nil {
+		transform(federatedIndexes, c.components.Federated.TransformActivities)
+	}
+
+	return results
+}
+
+func (c *Component) getCursor(ctx context.Context, cursor string) (*activityx.Activity, error) {
+	id, value, found := strings.Cut(cursor, ":")
+	if !found {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	networkValue, err := network.NetworkString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	activity, _, err := c.databaseClient.FindActivity(ctx, model.ActivityQuery{ID: lo.ToPtr(id), Network: lo.ToPtr(networkValue)})
+	if err != nil {
+		zap.L().Error("failed to get activities cursor",
+			zap.String("cursor", cursor),
+			zap.Error(err))
+
+		return nil, ErrInternal
+	}
+
+	return activity, nil
+}
+
+func transformCursor(activity *activityx.Activity) string {
+	return fmt.Sprintf("%s:%s", activity.ID, activity.Network)
+}
+
+// parsePlatform returns the name of a decentralized or federated platform, which is case-insensitive.
+func parsePlatform(value string) (string, error) {
+	if platform, err := decentralized.PlatformString(value); err == nil {
+		return platform.String(), nil
+	}
+
+	if platform, err := federated.PlatformString(value); err == nil {
+		return platform.String(), nil
+	}
+
+	return "", fmt.Errorf("invalid platform %s", value)
+}
+
+func intArgument(args map[string]interface{}, name string, fallback int) int {
+	if value, ok := args[name].(int); ok {
+		return value
+	}
+
+	return fallback
+}
+
+func stringsArgument(args map[string]interface{}, name string) []string {
+	values, _ := args[name].([]interface{})
+
+	return lo.FilterMap(values, func(value interface{}, _ int) (string, bool) {
+		result, ok := value.(string)
+
+		return result, ok
+	})
+}
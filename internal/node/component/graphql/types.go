@@ -0,0 +1,455 @@
+package graphql
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	graphqlx "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/rss3-network/protocol-go/schema"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/tag"
+)
+
+var (
+	// Int64 is a 64-bit integer, such as a timestamp or a block number, which does not fit the Int of GraphQL.
+	Int64 = graphqlx.NewScalar(graphqlx.ScalarConfig{
+		Name:        "Int64",
+		Description: "A 64-bit integer.",
+		Serialize: func(value interface{}) interface{} {
+			return value
+		},
+		ParseValue: func(value interface{}) interface{} {
+			switch value := value.(type) {
+			case int:
+				return int64(value)
+			case int64:
+				return value
+			case float64:
+				return int64(value)
+			case string:
+				result, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return nil
+				}
+
+				return result
+			default:
+				return nil
+			}
+		},
+		ParseLiteral: func(value ast.Value) interface{} {
+			switch value := value.(type) {
+			case *ast.IntValue:
+				result, err := strconv.ParseInt(value.Value, 10, 64)
+				if err != nil {
+					return nil
+				}
+
+				return result
+			case *ast.StringValue:
+				result, err := strconv.ParseInt(value.Value, 10, 64)
+				if err != nil {
+					return nil
+				}
+
+				return result
+			default:
+				return nil
+			}
+		},
+	})
+
+	// JSON is an arbitrary value, it is the fallback of the values without a GraphQL type.
+	JSON = graphqlx.NewScalar(graphqlx.ScalarConfig{
+		Name:        "JSON",
+		Description: "An arbitrary JSON value.",
+		Serialize: func(value interface{}) interface{} {
+			return value
+		},
+		ParseValue: func(value interface{}) interface{} {
+			return value
+		},
+		ParseLiteral: func(_ ast.Value) interface{} {
+			return nil
+		},
+	})
+)
+
+var (
+	typeType              = reflect.TypeOf((*schema.Type)(nil)).Elem()
+	typeMetadata          = reflect.TypeOf((*metadata.Metadata)(nil)).Elem()
+	typeJSONMarshaler     = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	typeTextMarshaler     = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	typeJSONRawMessage    = reflect.TypeOf(json.RawMessage(nil))
+	errUnsupportedMapping = errors.New("unsupported type")
+)
+
+// mapping is the GraphQL type of a Go type and the conversion of its values to the values of the GraphQL type.
+type mapping struct {
+	output  graphqlx.Output
+	convert func(value reflect.Value) interface{}
+}
+
+// typeBuilder builds the GraphQL types of Go types by their exported fields and JSON tags,
+// so the types follow the JSON responses of the REST API.
+type typeBuilder struct {
+	mappings map[reflect.Type]*mapping
+	names    map[string]reflect.Type
+	// aliases are the names of the objects of the Go types whose names are not descriptive in the schema.
+	aliases  map[reflect.Type]string
+	metadata *graphqlx.Union
+	// objects are the objects of the metadata types, which are members of the metadata union.
+	objects map[reflect.Type]*graphqlx.Object
+}
+
+// Output returns the GraphQL type of a Go type.
+func (b *typeBuilder) Output(valueType reflect.Type) graphqlx.Output {
+	return b.mapping(valueType, "").output
+}
+
+func (b *typeBuilder) mapping(valueType reflect.Type, name string) *mapping {
+	if result, found := b.mappings[valueType]; found {
+		return result
+	}
+
+	result := b.build(valueType, name)
+	b.mappings[valueType] = result
+
+	return result
+}
+
+func (b *typeBuilder) build(valueType reflect.Type, name string) *mapping {
+	if valueType.Kind() == reflect.Pointer {
+		element := b.mapping(valueType.Elem(), name)
+
+		return &mapping{
+			output: element.output,
+			convert: func(value reflect.Value) interface{} {
+				if value.IsNil() {
+					return nil
+				}
+
+				return element.convert(value.Elem())
+			},
+		}
+	}
+
+	switch {
+	case valueType == typeType:
+		return &mapping{output: graphqlx.String, convert: convertType}
+	case valueType == typeMetadata:
+		return &mapping{output: b.metadata, convert: convertInterface}
+	case valueType == typeJSONRawMessage:
+		return &mapping{output: JSON, convert: convertInterface}
+	case valueType.Implements(typeJSONMarshaler), valueType.Implements(typeTextMarshaler),
+		valueType.Kind() != reflect.Interface && (reflect.PointerTo(valueType).Implements(typeJSONMarshaler) || reflect.PointerTo(valueType).Implements(typeTextMarshaler)):
+		// The values with their own encoding, such as enums, addresses, decimals and times, are encoded as in the REST API.
+		if valueType.Kind() == reflect.Interface {
+			return &mapping{output: JSON, convert: convertInterface}
+		}
+
+		return &mapping{output: graphqlx.String, convert: convertMarshaler}
+	}
+
+	switch valueType.Kind() {
+	case reflect.Bool:
+		return &mapping{output: graphqlx.Boolean, convert: func(value reflect.Value) interface{} { return value.Bool() }}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int:
+		return &mapping{output: graphqlx.Int, convert: func(value reflect.Value) interface{} { return int(value.Int()) }}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint:
+		return &mapping{output: graphqlx.Int, convert: func(value reflect.Value) interface{} { return int(value.Uint()) }}
+	case reflect.Int64:
+		return &mapping{output: Int64, convert: func(value reflect.Value) interface{} { return value.Int() }}
+	case reflect.Uint64, reflect.Uintptr:
+		return &mapping{output: Int64, convert: func(value reflect.Value) interface{} { return value.Uint() }}
+	case reflect.Float32, reflect.Float64:
+		return &mapping{output: graphqlx.Float, convert: func(value reflect.Value) interface{} { return value.Float() }}
+	case reflect.String:
+		return &mapping{output: graphqlx.String, convert: func(value reflect.Value) interface{} { return value.String() }}
+	case reflect.Slice, reflect.Array:
+		element := b.mapping(valueType.Elem(), name)
+
+		return &mapping{
+			output: graphqlx.NewList(element.output),
+			convert: func(value reflect.Value) interface{} {
+				if value.Kind() == reflect.Slice && value.IsNil() {
+					return nil
+				}
+
+				result := make([]interface{}, value.Len())
+
+				for index := range result {
+					result[index] = element.convert(value.Index(index))
+				}
+
+				return result
+			},
+		}
+	case reflect.Struct:
+		object, err := b.object(valueType, name)
+		if err != nil {
+			return &mapping{output: JSON, convert: convertInterface}
+		}
+
+		return &mapping{output: object, convert: convertInterface}
+	default:
+		// The maps and the interfaces are not typed by GraphQL, they are returned as they are encoded to JSON.
+		return &mapping{output: JSON, convert: convertInterface}
+	}
+}
+
+// object builds the object of a struct, the fields are built lazily since the structs may be recursive.
+func (b *typeBuilder) object(valueType reflect.Type, name string) (*graphqlx.Object, error) {
+	if valueType.Name() != "" {
+		name = b.objectName(valueType)
+	}
+
+	if name == "" || len(structFields(valueType, nil)) == 0 {
+		return nil, fmt.Errorf("build object of %s: %w", valueType, errUnsupportedMapping)
+	}
+
+	b.names[name] = valueType
+
+	return graphqlx.NewObject(graphqlx.ObjectConfig{
+		Name: name,
+		Fields: (graphqlx.FieldsThunk)(func() graphqlx.Fields {
+			fields := make(graphqlx.Fields)
+
+			for _, field := range structFields(valueType, nil) {
+				element := b.mapping(field.Type, name+exportedName(field.Name))
+				index := field.Index
+
+				fields[field.jsonName] = &graphqlx.Field{
+					Type: element.output,
+					Resolve: func(p graphqlx.ResolveParams) (interface{}, error) {
+						value := reflect.ValueOf(p.Source)
+
+						for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+							if value.IsNil() {
+								return nil, nil
+							}
+
+							value = value.Elem()
+						}
+
+						// The fields of nil embedded structs are null.
+						fieldValue, err := value.FieldByIndexErr(index)
+						if err != nil {
+							return nil, nil
+						}
+
+						return element.convert(fieldValue), nil
+					},
+				}
+			}
+
+			return fields
+		}),
+	}), nil
+}
+
+// objectName returns the name of the object of a named struct, the name is qualified by the package if it is already taken.
+func (b *typeBuilder) objectName(valueType reflect.Type) string {
+	if alias, found := b.aliases[valueType]; found {
+		return alias
+	}
+
+	name := exportedName(valueType.Name())
+
+	if existing, found := b.names[name]; found && existing != valueType {
+		name = exportedName(valueType.PkgPath()[strings.LastIndex(valueType.PkgPath(), "/")+1:]) + name
+	}
+
+	return name
+}
+
+type structField struct {
+	reflect.StructField
+	jsonName string
+}
+
+// fields returns the fields of a struct as they are encoded to JSON, the fields of the embedded structs are promoted.
+func structFields(valueType reflect.Type, index []int) []structField {
+	var fields []structField
+
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		field.Index = append(append([]int(nil), index...), i)
+
+		if field.Anonymous && name == "" {
+			embeddedType := field.Type
+			if embeddedType.Kind() == reflect.Pointer {
+				embeddedType = embeddedType.Elem()
+			}
+
+			if embeddedType.Kind() == reflect.Struct {
+				fields = append(fields, structFields(embeddedType, field.Index)...)
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields = append(fields, structField{StructField: field, jsonName: fieldName(name)})
+	}
+
+	return fields
+}
+
+// buildMetadata builds the union of the metadata of all types, the members are found by decoding empty metadata of each type.
+func (b *typeBuilder) buildMetadata() {
+	raw := graphqlx.NewObject(graphqlx.ObjectConfig{
+		Name:        "RawMetadata",
+		Description: "The metadata of the types without a GraphQL type.",
+		Fields: graphqlx.Fields{
+			"data": &graphqlx.Field{
+				Type: JSON,
+				Resolve: func(p graphqlx.ResolveParams) (interface{}, error) {
+					return p.Source, nil
+				},
+			},
+		},
+	})
+
+	members := []*graphqlx.Object{raw}
+
+	for _, tagValue := range tag.TagValues() {
+		for _, typeValue := range schema.GetTypesByTag(tagValue) {
+			value, err := metadata.Unmarshal(typeValue, json.RawMessage("{}"))
+			if err != nil || value == nil {
+				continue
+			}
+
+			valueType := reflect.TypeOf(value)
+			for valueType.Kind() == reflect.Pointer {
+				valueType = valueType.Elem()
+			}
+
+			if _, found := b.objects[valueType]; found {
+				continue
+			}
+
+			object, ok := b.mapping(valueType, "").output.(*graphqlx.Object)
+			if !ok {
+				continue
+			}
+
+			b.objects[valueType] = object
+			members = append(members, object)
+		}
+	}
+
+	b.metadata = graphqlx.NewUnion(graphqlx.UnionConfig{
+		Name:        "Metadata",
+		Description: "The metadata of an action, which is typed by the tag and the type of the action.",
+		Types:       members,
+		ResolveType: func(p graphqlx.ResolveTypeParams) *graphqlx.Object {
+			valueType := reflect.TypeOf(p.Value)
+			for valueType != nil && valueType.Kind() == reflect.Pointer {
+				valueType = valueType.Elem()
+			}
+
+			if object, found := b.objects[valueType]; found {
+				return object
+			}
+
+			return raw
+		},
+	})
+}
+
+func newTypeBuilder() *typeBuilder {
+	builder := typeBuilder{
+		mappings: make(map[reflect.Type]*mapping),
+		names:    make(map[string]reflect.Type),
+		aliases:  make(map[reflect.Type]string),
+		objects:  make(map[reflect.Type]*graphqlx.Object),
+	}
+
+	builder.buildMetadata()
+
+	return &builder
+}
+
+func convertInterface(value reflect.Value) interface{} {
+	if (value.Kind() == reflect.Interface || value.Kind() == reflect.Map) && value.IsNil() {
+		return nil
+	}
+
+	return value.Interface()
+}
+
+func convertType(value reflect.Value) interface{} {
+	if value.IsNil() {
+		return nil
+	}
+
+	return value.Interface().(schema.Type).Name()
+}
+
+// convertMarshaler encodes a value as it is encoded to JSON, the strings are unquoted.
+func convertMarshaler(value reflect.Value) interface{} {
+	if value.Kind() == reflect.Interface && value.IsNil() {
+		return nil
+	}
+
+	// The methods with pointer receivers are only called on addressable values.
+	if !value.CanAddr() {
+		pointer := reflect.New(value.Type())
+		pointer.Elem().Set(value)
+
+		value = pointer.Elem()
+	}
+
+	data, err := json.Marshal(value.Addr().Interface())
+	if err != nil {
+		return nil
+	}
+
+	var result string
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return string(data)
+	}
+
+	return result
+}
+
+// fieldName returns a valid GraphQL name of a JSON field.
+func fieldName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+
+		return '_'
+	}, name)
+}
+
+func exportedName(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/rss3-network/node/v2/internal/constant"
 	"github.com/rss3-network/node/v2/internal/database"
 	"github.com/rss3-network/node/v2/internal/node/component"
+	"github.com/rss3-network/node/v2/internal/node/component/middleware"
 	"github.com/rss3-network/node/v2/provider/ethereum/contract/vsl"
 	"github.com/rss3-network/node/v2/provider/httpx"
 	"go.opentelemetry.io/otel"
@@ -35,7 +36,7 @@ func (c *Component) Name() string {
 
 var _ component.Component = (*Component)(nil)
 
-func NewComponent(_ context.Context, _ *echo.Echo, config *config.File, databaseClient database.Client, redisClient rueidis.Client, networkParamsCaller *vsl.NetworkParamsCaller) *Component {
+func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File, databaseClient database.Client, redisClient rueidis.Client, networkParamsCaller *vsl.NetworkParamsCaller, authenticator *middleware.Authenticator) *Component {
 	httpxClient, err := httpx.NewHTTPClient()
 	if err != nil {
 		return nil
@@ -49,6 +50,23 @@ func NewComponent(_ context.Context, _ *echo.Echo, config *config.File, database
 		httpClient:          httpxClient,
 	}
 
+	// The operator endpoints expose the raw payloads of failed tasks and make the node post activities
+	// to arbitrary endpoints, so they are not available if the requests are not authenticated.
+	if authenticator.Enabled() {
+		apiServer.GET("/operators/dead_letters", c.GetDeadLetters, authenticator.Middleware(middleware.ScopeOperator))
+	}
+
+	if databaseClient != nil && authenticator.Enabled() {
+		webhooks := apiServer.Group("/operators/webhooks", authenticator.Middleware(middleware.ScopeOperator))
+
+		webhooks.GET("", c.GetWebhooks)
+		webhooks.POST("", c.PostWebhook)
+		webhooks.GET("/:id", c.GetWebhook)
+		webhooks.PUT("/:id", c.PutWebhook)
+		webhooks.DELETE("/:id", c.DeleteWebhook)
+		webhooks.GET("/:id/deliveries", c.GetWebhookDeliveries)
+	}
+
 	if err := c.InitMeter(); err != nil {
 		panic(err)
 	}
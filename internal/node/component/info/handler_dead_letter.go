@@ -0,0 +1,138 @@
+package info
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/creasty/defaults"
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/v2/common/http/response"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type DeadLettersRequest struct {
+	Network *network.Network `query:"network"`
+	Worker  *string          `query:"worker"`
+	Limit   int              `query:"limit" default:"100" validate:"omitempty,min=1,max=500"`
+	Cursor  *string          `query:"cursor"`
+}
+
+type DeadLettersResponse struct {
+	Data []*model.DeadLetter `json:"data"`
+	Meta *MetaCursor         `json:"meta,omitempty"`
+}
+
+type MetaCursor struct {
+	Cursor string `json:"cursor"`
+}
+
+// GetDeadLetters returns the tasks that failed to be transformed by the workers.
+func (c *Component) GetDeadLetters(ctx echo.Context) error {
+	var request DeadLettersRequest
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := defaults.Set(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, "dead_letters")
+
+	zap.L().Debug("processing get dead letters request", zap.Any("request", request))
+
+	if c.databaseClient == nil {
+		zap.L().Debug("database client is not initialized, returning empty dead letters")
+
+		return ctx.JSON(http.StatusOK, DeadLettersResponse{Data: []*model.DeadLetter{}})
+	}
+
+	cursor, err := parseDeadLetterCursor(request.Cursor)
+	if err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	query := model.DeadLettersQuery{
+		Network: request.Network,
+		Worker:  request.Worker,
+		Cursor:  cursor,
+		Limit:   request.Limit,
+	}
+
+	deadLetters, err := c.databaseClient.FindDeadLetters(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.BadRequestError(ctx, fmt.Errorf("invalid cursor: %w", err))
+		}
+
+		zap.L().Error("failed to find dead letters",
+			zap.Any("query", query),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	var meta *MetaCursor
+
+	if len(deadLetters) > 0 && len(deadLetters) == request.Limit {
+		meta = &MetaCursor{
+			Cursor: transformDeadLetterCursor(deadLetters[len(deadLetters)-1]),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, DeadLettersResponse{
+		Data: deadLetters,
+		Meta: meta,
+	})
+}
+
+// parseDeadLetterCursor parses a cursor in the format of <id>:<network>:<worker>,
+// the id is split from the right since the task ids of some networks contain colons.
+func parseDeadLetterCursor(cursor *string) (*model.DeadLetterCursor, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	rest, worker, found := cut(*cursor)
+	if !found {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	id, value, found := cut(rest)
+	if !found || id == "" {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	networkValue, err := network.NetworkString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	return &model.DeadLetterCursor{
+		ID:      id,
+		Network: networkValue,
+		Worker:  worker,
+	}, nil
+}
+
+func transformDeadLetterCursor(deadLetter *model.DeadLetter) string {
+	return fmt.Sprintf("%s:%s:%s", deadLetter.ID, deadLetter.Network, deadLetter.Worker)
+}
+
+// cut slices the value around the last colon.
+func cut(value string) (before, after string, found bool) {
+	if index := strings.LastIndex(value, ":"); index >= 0 {
+		return value[:index], value[index+1:], true
+	}
+
+	return value, "", false
+}
@@ -41,12 +41,7 @@ func (c *Component) GetNetworkConfig(ctx echo.Context) error {
 
 	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, "config")
 
-	config := NetworkConfig{
-		RSS:           getNetworkConfigDetailForRSS(network.RSSProtocol),
-		Decentralized: getNetworkConfigDetail(network.ArweaveProtocol, network.EthereumProtocol, network.FarcasterProtocol, network.NearProtocol),
-		Federated:     getNetworkConfigDetail(network.ActivityPubProtocol),
-		AI:            getNetworkConfigDetailForAI(),
-	}
+	config := c.NetworkConfig()
 
 	zap.L().Debug("successfully retrieved network configuration")
 
@@ -55,6 +50,16 @@ func (c *Component) GetNetworkConfig(ctx echo.Context) error {
 	})
 }
 
+// NetworkConfig returns the configuration for all supported networks.
+func (c *Component) NetworkConfig() NetworkConfig {
+	return NetworkConfig{
+		RSS:           getNetworkConfigDetailForRSS(network.RSSProtocol),
+		Decentralized: getNetworkConfigDetail(network.ArweaveProtocol, network.EthereumProtocol, network.FarcasterProtocol, network.NearProtocol),
+		Federated:     getNetworkConfigDetail(network.ActivityPubProtocol),
+		AI:            getNetworkConfigDetailForAI(),
+	}
+}
+
 func getNetworkConfigDetailForRSS(protocol network.Protocol) NetworkConfigDetailForSingleWorker {
 	n := protocol.Networks()[0]
 
@@ -122,6 +122,18 @@ func (c *Component) GetNodeInfo(ctx echo.Context) error {
 	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, "info")
 	zap.L().Debug("getting node info")
 
+	nodeInfo, err := c.NodeInfo(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, NodeInfoResponse{
+		Data: *nodeInfo,
+	})
+}
+
+// NodeInfo returns the version, operator, coverage and records of the node.
+func (c *Component) NodeInfo(ctx context.Context) (*NodeInfo, error) {
 	// Get Version info
 	version := c.buildVersion()
 	zap.L().Debug("retrieved version info",
@@ -144,7 +156,7 @@ func (c *Component) GetNodeInfo(ctx echo.Context) error {
 	if err != nil {
 		zap.L().Error("failed to get node uptime",
 			zap.Error(err))
-		return err
+		return nil, err
 	}
 
 	zap.L().Debug("retrieved node uptime",
@@ -159,23 +171,23 @@ func (c *Component) GetNodeInfo(ctx echo.Context) error {
 		zap.Int("ai_supported", len(workerCoverage.AI.Supported)))
 
 	// get reward info
-	rewards, err := c.getNodeRewards(ctx.Request().Context(), evmAddress)
+	rewards, err := c.getNodeRewards(ctx, evmAddress)
 	if err != nil {
 		zap.L().Error("failed to get node rewards",
 			zap.Error(err))
-		return err
+		return nil, err
 	}
 
 	zap.L().Debug("retrieved node rewards",
 		zap.Int("reward_count", len(rewards)))
 
 	// get last heartbeat and slashed tokens
-	lastHeartbeat, slashedTokens, err := c.getNodeBasicInfo(ctx.Request().Context(), evmAddress)
+	lastHeartbeat, slashedTokens, err := c.getNodeBasicInfo(ctx, evmAddress)
 	if err != nil {
 		zap.L().Error("failed to get node basic info",
 			zap.Error(err))
 
-		return err
+		return nil, err
 	}
 
 	zap.L().Debug("retrieved node basic info",
@@ -209,20 +221,18 @@ func (c *Component) GetNodeInfo(ctx echo.Context) error {
 		zap.String("operator", evmAddress.String()),
 		zap.Int("total_recent_requests", len(recentRequests)))
 
-	return ctx.JSON(http.StatusOK, NodeInfoResponse{
-		Data: NodeInfo{
-			Version:  version,
-			Operator: evmAddress,
-			Uptime:   uptime,
-			Coverage: workerCoverage,
-			Records: Record{
-				LastHeartbeat:  lastHeartbeat,
-				RecentRequests: recentRequests,
-				RecentRewards:  rewards,
-				SlashedTokens:  slashedTokens,
-			},
+	return &NodeInfo{
+		Version:  version,
+		Operator: evmAddress,
+		Uptime:   uptime,
+		Coverage: workerCoverage,
+		Records: Record{
+			LastHeartbeat:  lastHeartbeat,
+			RecentRequests: recentRequests,
+			RecentRewards:  rewards,
+			SlashedTokens:  slashedTokens,
 		},
-	})
+	}, nil
 }
 
 func (c *Component) buildVersion() Version {
@@ -0,0 +1,323 @@
+package info
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/creasty/defaults"
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/v2/common/http/response"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/utils"
+	"go.uber.org/zap"
+)
+
+type WebhookRequest struct {
+	URL    string              `json:"url" validate:"required,url"`
+	Filter model.WebhookFilter `json:"filter"`
+	// Secret is generated if it is not set on creation, and it is kept if it is not set on update.
+	Secret  string `json:"secret" validate:"omitempty,min=16,max=128"`
+	Enabled *bool  `json:"enabled"`
+}
+
+type WebhookIDRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+type WebhookDeliveriesRequest struct {
+	ID     string  `param:"id" validate:"required"`
+	Limit  int     `query:"limit" default:"100" validate:"omitempty,min=1,max=500"`
+	Cursor *string `query:"cursor"`
+}
+
+type WebhookResponse struct {
+	Data *model.Webhook `json:"data"`
+}
+
+type WebhooksResponse struct {
+	Data []*model.Webhook `json:"data"`
+}
+
+// CreatedWebhook is the webhook returned on creation, which is the only response containing the secret.
+type CreatedWebhook struct {
+	*model.Webhook
+	Secret string `json:"secret"`
+}
+
+type CreateWebhookResponse struct {
+	Data *CreatedWebhook `json:"data"`
+}
+
+type WebhookDeliveriesResponse struct {
+	Data []*model.WebhookDelivery `json:"data"`
+	Meta *MetaCursor              `json:"meta,omitempty"`
+}
+
+// GetWebhooks returns the registered webhooks.
+func (c *Component) GetWebhooks(ctx echo.Context) error {
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, "webhooks")
+
+	webhooks, err := c.databaseClient.FindWebhooks(ctx.Request().Context(), model.WebhooksQuery{})
+	if err != nil {
+		zap.L().Error("failed to find webhooks", zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, WebhooksResponse{Data: webhooks})
+}
+
+// GetWebhook returns a webhook.
+func (c *Component) GetWebhook(ctx echo.Context) error {
+	var request WebhookIDRequest
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, "webhook")
+
+	webhook, err := c.loadWebhook(ctx, request.ID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, WebhookResponse{Data: webhook})
+}
+
+// PostWebhook registers a webhook, the secret is only returned in the response.
+func (c *Component) PostWebhook(ctx echo.Context) error {
+	var request WebhookRequest
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := validateWebhookRequest(ctx, &request); err != nil {
+		return err
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, "webhook")
+
+	id, err := randomHex(16)
+	if err != nil {
+		zap.L().Error("failed to generate webhook id", zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	webhook := model.Webhook{
+		ID:      id,
+		URL:     request.URL,
+		Secret:  request.Secret,
+		Filter:  request.Filter,
+		Enabled: request.Enabled == nil || *request.Enabled,
+	}
+
+	if webhook.Secret == "" {
+		if webhook.Secret, err = randomHex(32); err != nil {
+			zap.L().Error("failed to generate webhook secret", zap.Error(err))
+
+			return response.InternalError(ctx)
+		}
+	}
+
+	if err := c.databaseClient.SaveWebhook(ctx.Request().Context(), &webhook); err != nil {
+		zap.L().Error("failed to save webhook", zap.String("webhook", webhook.ID), zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	return ctx.JSON(http.StatusCreated, CreateWebhookResponse{
+		Data: &CreatedWebhook{
+			Webhook: &webhook,
+			Secret:  webhook.Secret,
+		},
+	})
+}
+
+// PutWebhook updates a webhook, enabling a webhook resets its consecutive failures.
+func (c *Component) PutWebhook(ctx echo.Context) error {
+	var (
+		idRequest WebhookIDRequest
+		request   WebhookRequest
+	)
+
+	if err := (&echo.DefaultBinder{}).BindPathParams(ctx, &idRequest); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := validateWebhookRequest(ctx, &request); err != nil {
+		return err
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, "webhook")
+
+	webhook, err := c.loadWebhook(ctx, idRequest.ID)
+	if err != nil {
+		return err
+	}
+
+	webhook.URL, webhook.Filter = request.URL, request.Filter
+
+	if request.Secret != "" {
+		webhook.Secret = request.Secret
+	}
+
+	if request.Enabled != nil {
+		if *request.Enabled && !webhook.Enabled {
+			webhook.Failures = 0
+		}
+
+		webhook.Enabled = *request.Enabled
+	}
+
+	if err := c.databaseClient.SaveWebhook(ctx.Request().Context(), webhook); err != nil {
+		zap.L().Error("failed to save webhook", zap.String("webhook", webhook.ID), zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, WebhookResponse{Data: webhook})
+}
+
+// DeleteWebhook deletes a webhook and its delivery logs.
+func (c *Component) DeleteWebhook(ctx echo.Context) error {
+	var request WebhookIDRequest
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, "webhook")
+
+	if _, err := c.loadWebhook(ctx, request.ID); err != nil {
+		return err
+	}
+
+	if err := c.databaseClient.DeleteWebhook(ctx.Request().Context(), request.ID); err != nil {
+		zap.L().Error("failed to delete webhook", zap.String("webhook", request.ID), zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetWebhookDeliveries returns the delivery logs of a webhook, ordered from the latest.
+func (c *Component) GetWebhookDeliveries(ctx echo.Context) error {
+	var request WebhookDeliveriesRequest
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := defaults.Set(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, "webhook_deliveries")
+
+	query := model.WebhookDeliveriesQuery{
+		WebhookID: request.ID,
+		Limit:     request.Limit,
+	}
+
+	if request.Cursor != nil {
+		cursor, err := strconv.ParseUint(*request.Cursor, 10, 64)
+		if err != nil {
+			return response.BadRequestError(ctx, fmt.Errorf("invalid cursor: %w", err))
+		}
+
+		query.Cursor = &cursor
+	}
+
+	deliveries, err := c.databaseClient.FindWebhookDeliveries(ctx.Request().Context(), query)
+	if err != nil {
+		zap.L().Error("failed to find webhook deliveries",
+			zap.Any("query", query),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	var meta *MetaCursor
+
+	if len(deliveries) > 0 && len(deliveries) == request.Limit {
+		meta = &MetaCursor{
+			Cursor: strconv.FormatUint(deliveries[len(deliveries)-1].ID, 10),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, WebhookDeliveriesResponse{
+		Data: deliveries,
+		Meta: meta,
+	})
+}
+
+// loadWebhook loads a webhook, the error is the response if the webhook can not be loaded.
+func (c *Component) loadWebhook(ctx echo.Context, id string) (*model.Webhook, error) {
+	webhook, err := c.databaseClient.LoadWebhook(ctx.Request().Context(), id)
+	if err != nil {
+		zap.L().Error("failed to load webhook", zap.String("webhook", id), zap.Error(err))
+
+		return nil, response.InternalError(ctx)
+	}
+
+	if webhook == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Webhook not found")
+	}
+
+	return webhook, nil
+}
+
+// validateWebhookRequest validates the request, the error is the response if the request is invalid.
+func validateWebhookRequest(ctx echo.Context, request *WebhookRequest) error {
+	if err := ctx.Validate(request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	if endpoint, err := url.Parse(request.URL); err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return response.ValidationFailedError(ctx, fmt.Errorf("invalid url: %s", request.URL))
+	}
+
+	// The types are only unique within a tag, as the types of the activities query.
+	if len(request.Filter.Types) > 0 {
+		if len(request.Filter.Tags) == 0 {
+			return response.ValidationFailedError(ctx, errors.New("the tags are required to filter the types"))
+		}
+
+		if _, err := utils.ParseTypes(request.Filter.Types, request.Filter.Tags); err != nil {
+			return response.ValidationFailedError(ctx, err)
+		}
+	}
+
+	return nil
+}
+
+func randomHex(size int) (string, error) {
+	data := make([]byte, size)
+
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(data), nil
+}
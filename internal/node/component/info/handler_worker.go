@@ -49,17 +49,24 @@ func (c *Component) GetWorkersStatus(ctx echo.Context) error {
 
 	zap.L().Debug("getting status for all workers")
 
+	response := WorkerResponse{
+		Data: c.WorkersStatus(ctx.Request().Context()),
+	}
+
+	zap.L().Debug("successfully retrieved worker statuses")
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// WorkersStatus returns the status of all workers grouped by their components.
+func (c *Component) WorkersStatus(ctx context.Context) ComponentInfo {
 	workerCount := config.CalculateWorkerCount(c.config)
 	workerInfoChan := make(chan *WorkerInfo, workerCount)
 
 	// Fetch the status of all workers concurrently
-	c.fetchAllWorkerInfo(ctx.Request().Context(), workerInfoChan)
-
-	response := c.buildWorkerResponse(workerInfoChan)
+	c.fetchAllWorkerInfo(ctx, workerInfoChan)
 
-	zap.L().Debug("successfully retrieved worker statuses")
-
-	return ctx.JSON(http.StatusOK, response)
+	return c.buildWorkerResponse(workerInfoChan).Data
 }
 
 // fetchAllWorkerInfo fetches the status of all workers concurrently.
@@ -51,6 +51,7 @@ type Parameters struct {
 	BlockBatchSize          *ConfigDetail   `json:"block_batch_size,omitempty"`
 	ReceiptsBatchSize       *ConfigDetail   `json:"receipts_batch_size,omitempty"`
 	BlockReceiptBatchSize   *ConfigDetail   `json:"block_receipts_batch_size,omitempty"`
+	ConfirmationBlocks      *ConfigDetail   `json:"confirmation_blocks,omitempty"`
 	APIKey                  *ConfigDetail   `json:"api_key,omitempty"`
 	Authentication          *Authentication `json:"authentication,omitempty"`
 	TimestampStart          *ConfigDetail   `json:"timestamp_start,omitempty"`
@@ -61,6 +62,8 @@ type Parameters struct {
 	OllamaHost              *ConfigDetail   `json:"ollama_host,omitempty"`
 	KaitoAPIToken           *ConfigDetail   `json:"kaito_api_token,omitempty"`
 	TwitterConfig           *AITwitter      `json:"twitter,omitempty"`
+	Feeds                   *ConfigDetail   `json:"feeds,omitempty"`
+	Interval                *ConfigDetail   `json:"interval,omitempty"`
 }
 
 type workerConfig struct {
@@ -157,6 +160,14 @@ var defaultNetworkParameters = map[network.Protocol]*Parameters{
 			Title:       "Block Receipt Batch Size",
 			Key:         "parameters.block_receipts_batch_size",
 		},
+		ConfirmationBlocks: &ConfigDetail{
+			IsRequired:  false,
+			Type:        UintType,
+			Value:       uint(0),
+			Description: "The number of blocks to wait for before a block is indexed, higher values reduce the chance of chain reorganizations at the cost of latency. Default: 0",
+			Title:       "Confirmation Blocks",
+			Key:         "parameters.confirmation_blocks",
+		},
 	},
 	network.NearProtocol: {
 		// unnecessary to expose
@@ -567,6 +578,21 @@ var WorkerToConfigMap = map[network.Protocol]map[worker.Worker]workerConfig{
 					Key:         "parameters.authentication.access_key",
 				},
 			},
+			Feeds: &ConfigDetail{
+				IsRequired:  false,
+				Type:        URLArrayType,
+				Description: "List of RSS, Atom and JSON feed URLs to index",
+				Title:       "Feeds",
+				Key:         "parameters.feeds",
+			},
+			Interval: &ConfigDetail{
+				IsRequired:  false,
+				Type:        UintType,
+				Value:       uint(600),
+				Description: "Seconds between the polls of the feeds",
+				Title:       "Interval",
+				Key:         "parameters.interval",
+			},
 		}, "Your RSSHub instance URL"),
 	},
 }
@@ -1,35 +1,221 @@
 package middleware
 
 import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/constant"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
 )
 
-// BearerAuth middleware for bearer token authentication
-func BearerAuth(accessToken string) echo.MiddlewareFunc {
+// Scope is the part of the API a key is allowed to access, which is named after the component.
+type Scope string
+
+const (
+	ScopeDecentralized Scope = "decentralized"
+	ScopeFederated     Scope = "federated"
+	ScopeRSS           Scope = "rss"
+	ScopeAI            Scope = "ai"
+	ScopeInfo          Scope = "info"
+	// ScopeOperator is the scope of the operator endpoints, it is only granted to the keys that list it explicitly.
+	ScopeOperator Scope = "operator"
+)
+
+// AccessTokenName is the name of the key of the access token.
+const AccessTokenName = "access_token"
+
+const (
+	resultAllowed       = "allowed"
+	resultUnauthorized  = "unauthorized"
+	resultForbidden     = "forbidden"
+	resultRateLimited   = "rate_limited"
+	resultQuotaExceeded = "quota_exceeded"
+)
+
+// Authenticator authenticates the requests with the API keys, and enforces the scopes,
+// rate limits and daily quotas of the keys, the limits are shared by the Core instances through Redis.
+type Authenticator struct {
+	keys        []*apiKey
+	redisClient rueidis.Client
+	counter     metric.Int64Counter
+}
+
+type apiKey struct {
+	*config.APIKey
+	// digest is the SHA-256 of the key, the digests have the same length, so they are compared in constant time.
+	digest [sha256.Size]byte
+}
+
+// keyContextKey is the key of the authenticated key in the context of a request.
+type keyContextKey struct{}
+
+// Middleware authenticates the requests to the scope, the requests are not authenticated if no keys are configured.
+func (a *Authenticator) Middleware(scope Scope) echo.MiddlewareFunc {
+	return a.middleware(scope)
+}
+
+// KeyMiddleware authenticates the requests of the endpoints serving several scopes, such as the GraphQL API,
+// the key is kept in the context of the request, so the scopes are checked by Allowed once they are known.
+func (a *Authenticator) KeyMiddleware() echo.MiddlewareFunc {
+	return a.middleware("")
+}
+
+// Allowed returns whether the key authenticated for the request is allowed to access the scope,
+// all scopes are allowed if no keys are configured.
+func (a *Authenticator) Allowed(ctx context.Context, scope Scope) bool {
+	if !a.Enabled() {
+		return true
+	}
+
+	key, ok := ctx.Value(keyContextKey{}).(*apiKey)
+
+	return ok && lo.Contains(key.Scopes, string(scope))
+}
+
+// middleware authenticates the requests, the scope of the requests is not checked if it is empty.
+func (a *Authenticator) middleware(scope Scope) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			if !a.Enabled() {
+				return next(c)
+			}
+
 			authHeader := c.Request().Header.Get("Authorization")
 			if authHeader == "" {
+				a.count(c, "", scope, resultUnauthorized)
+
 				return echo.NewHTTPError(http.StatusUnauthorized, "Missing Authorization header")
 			}
 
 			// Check if the header starts with "Bearer "
 			if !strings.HasPrefix(authHeader, "Bearer ") {
+				a.count(c, "", scope, resultUnauthorized)
+
 				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid Authorization header format")
 			}
 
-			// Extract the token
-			token := strings.TrimPrefix(authHeader, "Bearer ")
+			key := a.lookup(strings.TrimPrefix(authHeader, "Bearer "))
+			if key == nil {
+				a.count(c, "", scope, resultUnauthorized)
 
-			// Verify the token
-			if token != accessToken {
 				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid access token")
 			}
 
+			if scope != "" && !lo.Contains(key.Scopes, string(scope)) {
+				a.count(c, key.Name, scope, resultForbidden)
+
+				return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("The access token is not allowed to access %s", scope))
+			}
+
+			result, err := a.limit(c.Request().Context(), key)
+			if err != nil {
+				// The limits are not enforced if Redis is unavailable, rather than rejecting all requests.
+				zap.L().Error("failed to enforce the limits of api key", zap.String("key", key.Name), zap.Error(err))
+			}
+
+			if result.reason != "" {
+				a.count(c, key.Name, scope, result.reason)
+
+				c.Response().Header().Set(echo.HeaderRetryAfter, strconv.FormatInt(int64(math.Ceil(result.retryAfter.Seconds())), 10))
+
+				return echo.NewHTTPError(http.StatusTooManyRequests, lo.Ternary(result.reason == resultQuotaExceeded, "Daily quota exceeded", "Rate limit exceeded"))
+			}
+
+			a.count(c, key.Name, scope, resultAllowed)
+
+			c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), keyContextKey{}, key)))
+
 			return next(c)
 		}
 	}
 }
+
+// Enabled returns whether any key is configured, the requests are not authenticated otherwise.
+func (a *Authenticator) Enabled() bool {
+	return len(a.keys) > 0
+}
+
+// lookup returns the key of the token, all keys are compared so the time does not depend on which key matches.
+func (a *Authenticator) lookup(token string) *apiKey {
+	digest := sha256.Sum256([]byte(token))
+
+	var matched *apiKey
+
+	for _, key := range a.keys {
+		if subtle.ConstantTimeCompare(digest[:], key.digest[:]) == 1 {
+			matched = key
+		}
+	}
+
+	return matched
+}
+
+// count records the usage of the keys, the requests without a valid key are recorded without a key name.
+func (a *Authenticator) count(c echo.Context, name string, scope Scope, result string) {
+	a.counter.Add(c.Request().Context(), 1, metric.WithAttributes(
+		attribute.String("key", name),
+		attribute.String("scope", string(scope)),
+		attribute.String("result", result),
+	))
+}
+
+func NewAuthenticator(server *config.Server, redisClient rueidis.Client) (*Authenticator, error) {
+	authenticator := Authenticator{
+		redisClient: redisClient,
+	}
+
+	keys := server.APIKeys
+
+	// The access token is kept as a key with all scopes and without limits, it is the only key operating the node by default.
+	if server.AccessToken != "" {
+		keys = append([]*config.APIKey{{
+			Name:   AccessTokenName,
+			Key:    server.AccessToken,
+			Scopes: []string{string(ScopeDecentralized), string(ScopeFederated), string(ScopeRSS), string(ScopeAI), string(ScopeInfo), string(ScopeOperator)},
+		}}, keys...)
+	}
+
+	for _, key := range keys {
+		value := apiKey{
+			APIKey: key,
+			digest: sha256.Sum256([]byte(key.Key)),
+		}
+
+		if lo.ContainsBy(authenticator.keys, func(other *apiKey) bool { return other.Name == key.Name }) {
+			return nil, fmt.Errorf("duplicate api key name: %s", key.Name)
+		}
+
+		if authenticator.lookup(key.Key) != nil {
+			return nil, fmt.Errorf("duplicate api key: %s", key.Name)
+		}
+
+		if (key.RateLimit > 0 || key.DailyQuota > 0) && redisClient == nil {
+			zap.L().Warn("the limits of api key are not enforced without redis", zap.String("key", key.Name))
+		}
+
+		authenticator.keys = append(authenticator.keys, &value)
+	}
+
+	meter := otel.GetMeterProvider().Meter(constant.Name)
+
+	var err error
+
+	if authenticator.counter, err = meter.Int64Counter("rss3_node_api_key_requests"); err != nil {
+		return nil, fmt.Errorf("create meter of api key requests: %w", err)
+	}
+
+	return &authenticator, nil
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/redis/rueidis"
+)
+
+// limitScript checks the daily quota and takes a token from the bucket of a key in one step,
+// the request is only counted against the quota if it is allowed.
+//
+// KEYS[1] is the bucket, KEYS[2] is the quota counter of the day.
+// ARGV is the rate limit in requests per second, the burst, the current time in milliseconds,
+// the daily quota and the milliseconds until the end of the day.
+// It returns whether the request is allowed, the milliseconds to retry after and the reason of the rejection.
+var limitScript = rueidis.NewLuaScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local quota = tonumber(ARGV[4])
+local remaining = tonumber(ARGV[5])
+
+if quota > 0 and tonumber(redis.call('GET', KEYS[2]) or '0') >= quota then
+	return {0, remaining, 2}
+end
+
+if rate > 0 then
+	local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'timestamp')
+	local tokens = tonumber(bucket[1]) or burst
+	local timestamp = tonumber(bucket[2]) or now
+
+	tokens = math.min(burst, tokens + math.max(0, now - timestamp) * rate / 1000)
+
+	if tokens < 1 then
+		return {0, math.ceil((1 - tokens) * 1000 / rate), 1}
+	end
+
+	redis.call('HSET', KEYS[1], 'tokens', tostring(tokens - 1), 'timestamp', now)
+	redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+end
+
+if quota > 0 then
+	redis.call('INCR', KEYS[2])
+	redis.call('PEXPIRE', KEYS[2], remaining + 3600000)
+end
+
+return {1, 0, 0}
+`)
+
+type limitResult struct {
+	// reason is the result of a rejected request, it is empty if the request is allowed.
+	reason     string
+	retryAfter time.Duration
+}
+
+// limit takes a request from the rate limit and the daily quota of the key.
+func (a *Authenticator) limit(ctx context.Context, key *apiKey) (limitResult, error) {
+	if a.redisClient == nil || (key.RateLimit <= 0 && key.DailyQuota <= 0) {
+		return limitResult{}, nil
+	}
+
+	now := time.Now().UTC()
+	endOfDay := now.Truncate(24 * time.Hour).Add(24 * time.Hour)
+
+	burst := key.Burst
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(key.RateLimit)))
+	}
+
+	// The keys share the hash tag of the name, so they are in the same slot of a Redis cluster.
+	keys := []string{
+		fmt.Sprintf("api:{%s}:bucket", key.Name),
+		fmt.Sprintf("api:{%s}:quota:%s", key.Name, now.Format(time.DateOnly)),
+	}
+
+	args := []string{
+		strconv.FormatFloat(key.RateLimit, 'f', -1, 64),
+		strconv.Itoa(burst),
+		strconv.FormatInt(now.UnixMilli(), 10),
+		strconv.FormatInt(key.DailyQuota, 10),
+		strconv.FormatInt(endOfDay.Sub(now).Milliseconds(), 10),
+	}
+
+	values, err := limitScript.Exec(ctx, a.redisClient, keys, args).AsIntSlice()
+	if err != nil {
+		return limitResult{}, fmt.Errorf("execute limit script: %w", err)
+	}
+
+	if len(values) != 3 {
+		return limitResult{}, fmt.Errorf("unexpected result of limit script: %v", values)
+	}
+
+	if values[0] == 1 {
+		return limitResult{}, nil
+	}
+
+	return limitResult{
+		reason:     map[int64]string{1: resultRateLimited, 2: resultQuotaExceeded}[values[2]],
+		retryAfter: time.Duration(values[1]) * time.Millisecond,
+	}, nil
+}
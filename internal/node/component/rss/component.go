@@ -10,6 +10,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/rss3-network/node/v2/config"
 	"github.com/rss3-network/node/v2/internal/constant"
+	"github.com/rss3-network/node/v2/internal/database"
 	"github.com/rss3-network/node/v2/internal/node/component"
 	"github.com/rss3-network/node/v2/internal/node/component/middleware"
 	"github.com/rss3-network/node/v2/schema/worker"
@@ -23,10 +24,11 @@ import (
 )
 
 type Component struct {
-	config     *config.File
-	httpClient *http.Client
-	rsshub     *configx
-	counter    metric.Int64Counter
+	config         *config.File
+	httpClient     *http.Client
+	databaseClient database.Client
+	rsshub         *configx
+	counter        metric.Int64Counter
 }
 
 type configx struct {
@@ -52,18 +54,20 @@ func (h *Component) Name() string {
 
 var _ component.Component = (*Component)(nil)
 
-func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File) *Component {
+// NewComponent creates the RSS component, the database client is nil if the node does not index any feeds.
+func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File, databaseClient database.Client, authenticator *middleware.Authenticator) *Component {
 	RecentRequests = cb.New(MaxRecentRequests)
 
 	c := &Component{
-		config:     config,
-		httpClient: http.DefaultClient,
+		config:         config,
+		httpClient:     http.DefaultClient,
+		databaseClient: databaseClient,
 	}
 
 	group := apiServer.Group(fmt.Sprintf("/%s", Name))
 
 	// Add middleware for bearer token authentication
-	group.Use(middleware.BearerAuth(config.Discovery.Server.AccessToken))
+	group.Use(authenticator.Middleware(middleware.ScopeRSS))
 
 	group.GET("/*", c.Handler)
 
@@ -7,8 +7,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
+	"github.com/rss3-network/node/v2/internal/database/model"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	networkx "github.com/rss3-network/protocol-go/schema/network"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
@@ -113,3 +116,42 @@ func (h *Component) transformResponse(_ context.Context, data []byte) ([]*activi
 
 	return resp.Data, nil
 }
+
+// getFeedActivities finds the activities indexed from the feeds, and returns the cursor of the last activity.
+func (h *Component) getFeedActivities(ctx context.Context, request model.ActivitiesQuery) ([]*activityx.Activity, string, error) {
+	activities, err := h.databaseClient.FindActivities(ctx, request)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to find activities: %w", err)
+	}
+
+	last, exist := lo.Last(activities)
+	if exist {
+		return activities, fmt.Sprintf("%s:%s", last.ID, last.Network), nil
+	}
+
+	return nil, "", nil
+}
+
+// getCursor finds the activity of the cursor, which is formatted as the ID and network of the activity.
+func (h *Component) getCursor(ctx context.Context, cursor *string) (*activityx.Activity, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	id, networkStr, found := strings.Cut(*cursor, ":")
+	if !found {
+		return nil, fmt.Errorf("invalid cursor: missing network")
+	}
+
+	network, err := networkx.NetworkString(networkStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	data, _, err := h.databaseClient.FindActivity(ctx, model.ActivityQuery{ID: lo.ToPtr(id), Network: lo.ToPtr(network)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cursor: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,95 @@
+package rss
+
+import (
+	"net/http"
+
+	"github.com/creasty/defaults"
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/v2/common/http/response"
+	"github.com/rss3-network/node/v2/docs"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/schema/worker/rss"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// defaultActionLimit is the number of actions returned of each activity, the feed activities have one action.
+const defaultActionLimit = 10
+
+type ActivitiesResponse struct {
+	Data []*activityx.Activity `json:"data"`
+	Meta *MetaCursor           `json:"meta,omitempty"`
+}
+
+type MetaCursor struct {
+	Cursor string `json:"cursor"`
+}
+
+// GetFeedActivities returns the activities indexed from the feeds, the RSSHub activities are not stored.
+func (h *Component) GetFeedActivities(ctx echo.Context, request docs.GetRSSFeedsParams) error {
+	if err := defaults.Set(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	// The node has no database if it does not index any feeds, and the feeds are indexed by the RSSHub module.
+	if h.databaseClient == nil || h.rsshub == nil {
+		return echo.ErrNotFound
+	}
+
+	go h.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, lo.FromPtr(request.Feed))
+
+	go h.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, lo.FromPtr(request.Feed))
+
+	addRecentRequest(ctx.Request().RequestURI)
+
+	zap.L().Debug("processing feed activities request",
+		zap.String("feed", lo.FromPtr(request.Feed)),
+		zap.Int("limit", lo.FromPtr(request.Limit)),
+		zap.String("cursor", lo.FromPtr(request.Cursor)))
+
+	cursor, err := h.getCursor(ctx.Request().Context(), request.Cursor)
+	if err != nil {
+		zap.L().Error("failed to get feed activities cursor",
+			zap.String("cursor", lo.FromPtr(request.Cursor)),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	databaseRequest := model.ActivitiesQuery{
+		Owner:          request.Feed,
+		Cursor:         cursor,
+		StartTimestamp: request.SinceTimestamp,
+		EndTimestamp:   request.UntilTimestamp,
+		Limit:          lo.FromPtr(request.Limit),
+		ActionLimit:    defaultActionLimit,
+		Network:        []network.Network{h.rsshub.network},
+		Platform:       rss.PlatformRSS.String(),
+	}
+
+	activities, last, err := h.getFeedActivities(ctx.Request().Context(), databaseRequest)
+	if err != nil {
+		zap.L().Error("failed to get feed activities",
+			zap.String("feed", lo.FromPtr(request.Feed)),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	zap.L().Info("successfully retrieved feed activities",
+		zap.String("feed", lo.FromPtr(request.Feed)),
+		zap.Int("count", len(activities)))
+
+	return ctx.JSON(http.StatusOK, ActivitiesResponse{
+		Data: activities,
+		Meta: lo.Ternary(len(activities) < databaseRequest.Limit, nil, &MetaCursor{
+			Cursor: last,
+		}),
+	})
+}
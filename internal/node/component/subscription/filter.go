@@ -0,0 +1,116 @@
+package subscription
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/utils"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/samber/lo"
+)
+
+// FilterRequest is the filter of a subscription, it is set by the query parameters
+// and can be replaced by the messages of a WebSocket connection.
+type FilterRequest struct {
+	Owner    []string `query:"owner" json:"owner"`
+	Network  []string `query:"network" json:"network"`
+	Tag      []string `query:"tag" json:"tag"`
+	Type     []string `query:"type" json:"type"`
+	Platform []string `query:"platform" json:"platform"`
+}
+
+// Filter matches the activities a subscriber receives, an empty field matches all activities.
+type Filter struct {
+	Owners    []string
+	Networks  []network.Network
+	Tags      []tag.Tag
+	Types     []schema.Type
+	Platforms []string
+}
+
+// Filter parses the request into a filter.
+func (r *FilterRequest) Filter() (*Filter, error) {
+	filter := Filter{
+		Owners:    lo.Uniq(r.Owner),
+		Platforms: lo.Uniq(r.Platform),
+	}
+
+	for _, value := range lo.Uniq(r.Network) {
+		networkx, err := network.NetworkString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network: %s", value)
+		}
+
+		filter.Networks = append(filter.Networks, networkx)
+	}
+
+	for _, value := range lo.Uniq(r.Tag) {
+		tagx, err := tag.TagString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag: %s", value)
+		}
+
+		filter.Tags = append(filter.Tags, tagx)
+	}
+
+	types, err := utils.ParseTypes(lo.Uniq(r.Type), filter.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Types = types
+
+	return &filter, nil
+}
+
+// Match returns whether the activity matches the filter, the owners, tags and types
+// are matched against the activity and its actions as the database does.
+func (f *Filter) Match(activity *activityx.Activity) bool {
+	if len(f.Networks) > 0 && !lo.Contains(f.Networks, activity.Network) {
+		return false
+	}
+
+	if len(f.Platforms) > 0 && !lo.ContainsBy(f.Platforms, func(platform string) bool {
+		return strings.EqualFold(platform, activity.Platform)
+	}) {
+		return false
+	}
+
+	if len(f.Owners) > 0 && !lo.ContainsBy(f.Owners, func(owner string) bool {
+		return strings.EqualFold(owner, activity.From) || strings.EqualFold(owner, activity.To) ||
+			lo.ContainsBy(activity.Actions, func(action *activityx.Action) bool {
+				return strings.EqualFold(owner, action.From) || strings.EqualFold(owner, action.To)
+			})
+	}) {
+		return false
+	}
+
+	if len(f.Tags) > 0 && !lo.Contains(f.Tags, activity.Tag) && !lo.ContainsBy(activity.Actions, func(action *activityx.Action) bool {
+		return lo.Contains(f.Tags, action.Tag)
+	}) {
+		return false
+	}
+
+	if len(f.Types) > 0 && !lo.Contains(f.Types, activity.Type) && !lo.ContainsBy(activity.Actions, func(action *activityx.Action) bool {
+		return lo.Contains(f.Types, action.Type)
+	}) {
+		return false
+	}
+
+	return true
+}
+
+// Query builds the database query of the activities matched by the filter.
+func (f *Filter) Query() model.ActivitiesQuery {
+	return model.ActivitiesQuery{
+		Owners:    f.Owners,
+		Network:   f.Networks,
+		Tags:      f.Tags,
+		Types:     f.Types,
+		Platforms: f.Platforms,
+	}
+}
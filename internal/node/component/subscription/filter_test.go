@@ -0,0 +1,95 @@
+package subscription_test
+
+import (
+	"testing"
+
+	"github.com/rss3-network/node/v2/internal/node/component/subscription"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	activity := &activityx.Activity{
+		ID:       "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+		Network:  network.Ethereum,
+		From:     "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+		To:       "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+		Tag:      tag.Transaction,
+		Type:     typex.TransactionTransfer,
+		Platform: "Uniswap",
+		Actions: []*activityx.Action{
+			{
+				Tag:  tag.Exchange,
+				Type: typex.ExchangeSwap,
+				From: "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+				To:   "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+			},
+		},
+	}
+
+	testcases := []struct {
+		name    string
+		request subscription.FilterRequest
+		want    bool
+	}{
+		{
+			name: "Empty Filter",
+			want: true,
+		},
+		{
+			name:    "Owner Of Activity",
+			request: subscription.FilterRequest{Owner: []string{"0xd8da6bf26964af9d7eed9e03e53415d37aa96045"}},
+			want:    true,
+		},
+		{
+			name:    "Owner Of Action",
+			request: subscription.FilterRequest{Owner: []string{"0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD"}},
+			want:    true,
+		},
+		{
+			name:    "Other Owner",
+			request: subscription.FilterRequest{Owner: []string{"0x0000000000000000000000000000000000000000"}},
+			want:    false,
+		},
+		{
+			name:    "Tag And Type Of Action",
+			request: subscription.FilterRequest{Tag: []string{"exchange"}, Type: []string{"swap"}},
+			want:    true,
+		},
+		{
+			name:    "Other Network",
+			request: subscription.FilterRequest{Network: []string{"polygon"}},
+			want:    false,
+		},
+		{
+			name:    "Network And Platform",
+			request: subscription.FilterRequest{Network: []string{"ethereum"}, Platform: []string{"uniswap"}},
+			want:    true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := testcase.request.Filter()
+			require.NoError(t, err)
+
+			require.Equal(t, testcase.want, filter.Match(activity))
+		})
+	}
+
+	t.Run("Invalid Network", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := (&subscription.FilterRequest{Network: []string{"invalid"}}).Filter()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,122 @@
+package subscription
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+)
+
+// sender sends the events of a subscription over a transport.
+type sender interface {
+	Send(event *Event) error
+	SendError(err error) error
+	Ping() error
+	// Close closes the connection, err is the reason the subscription was closed by the server.
+	Close(err error)
+}
+
+var (
+	_ sender = (*websocketSender)(nil)
+	_ sender = (*eventStreamSender)(nil)
+)
+
+type websocketSender struct {
+	conn *websocket.Conn
+	// mutex serializes the messages, since the errors of filter updates are sent by the reader.
+	mutex sync.Mutex
+}
+
+func (s *websocketSender) Send(event *Event) error {
+	return s.writeJSON(event)
+}
+
+func (s *websocketSender) SendError(err error) error {
+	return s.writeJSON(&ErrorEvent{Error: err.Error()})
+}
+
+func (s *websocketSender) Ping() error {
+	return s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(defaultWriteTimeout))
+}
+
+func (s *websocketSender) Close(err error) {
+	message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+
+	// The subscriber is asked to reconnect with the cursor of the last received activity.
+	if err != nil {
+		message = websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error())
+	}
+
+	_ = s.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(defaultWriteTimeout))
+	_ = s.conn.Close()
+}
+
+func (s *websocketSender) writeJSON(value any) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.conn.SetWriteDeadline(time.Now().Add(defaultWriteTimeout)); err != nil {
+		return err
+	}
+
+	return s.conn.WriteJSON(value)
+}
+
+// eventStreamSender sends the events as Server-Sent Events, the cursor of an activity is the id of its event.
+type eventStreamSender struct {
+	response *echo.Response
+}
+
+func (s *eventStreamSender) Send(event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	return s.write(fmt.Sprintf("id: %s\nevent: activity\ndata: %s\n\n", event.Cursor, data))
+}
+
+func (s *eventStreamSender) SendError(err error) error {
+	data, err := json.Marshal(&ErrorEvent{Error: err.Error()})
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	return s.write(fmt.Sprintf("event: error\ndata: %s\n\n", data))
+}
+
+func (s *eventStreamSender) Ping() error {
+	return s.write(": ping\n\n")
+}
+
+func (s *eventStreamSender) Close(err error) {
+	if err != nil {
+		_ = s.SendError(err)
+	}
+}
+
+func (s *eventStreamSender) write(value string) error {
+	if _, err := s.response.Write([]byte(value)); err != nil {
+		return err
+	}
+
+	s.response.Flush()
+
+	return nil
+}
+
+func newEventStreamSender(response *echo.Response) *eventStreamSender {
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	// Disable the buffering of nginx, so the events are delivered immediately.
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	return &eventStreamSender{response: response}
+}
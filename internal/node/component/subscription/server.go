@@ -0,0 +1,350 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/creasty/defaults"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/v2/common/http/response"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/stream/pubsub"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultReplayLimit is the maximum number of activities replayed from a cursor,
+	// subscribers that are further behind should catch up with the REST API.
+	defaultReplayLimit = 1000
+	defaultPageLimit   = 100
+	// defaultPingInterval keeps the idle connections alive through proxies.
+	defaultPingInterval = 30 * time.Second
+	defaultWriteTimeout = 10 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	// The API allows requests from any origin.
+	CheckOrigin: func(*http.Request) bool {
+		return true
+	},
+}
+
+// Request is a subscription request.
+type Request struct {
+	FilterRequest
+
+	// Cursor is the cursor of the last received activity, the activities of its network committed after it are replayed.
+	// A cursor only orders the activities of its network, so the subscription must select the network of the cursor alone.
+	Cursor      *string `query:"cursor"`
+	ActionLimit int     `query:"action_limit" default:"10" validate:"min=1,max=20"`
+}
+
+// Event is an activity sent to a subscriber.
+type Event struct {
+	Cursor string              `json:"cursor"`
+	Data   *activityx.Activity `json:"data"`
+}
+
+// ErrorEvent is sent to a subscriber before the subscription is closed, or if a filter update is rejected.
+type ErrorEvent struct {
+	Error string `json:"error"`
+}
+
+// Server serves the subscriptions of a component over WebSocket and Server-Sent Events.
+type Server struct {
+	hub            *pubsub.Hub
+	databaseClient database.Client
+	// prepare normalizes the filter before it is used, such as resolving the owners.
+	prepare func(ctx context.Context, filter *Filter) error
+	// transform transforms the activities as the other endpoints of the component do.
+	transform func(ctx context.Context, activities []*activityx.Activity) []*activityx.Activity
+}
+
+// Serve subscribes to the activities matched by the filter of the request, the connection is upgraded
+// to a WebSocket if requested, otherwise the activities are sent as Server-Sent Events.
+func (s *Server) Serve(ctx echo.Context) error {
+	var request Request
+
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := defaults.Set(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	// Reconnecting EventSource clients send the id of the last received event.
+	if lastEventID := ctx.Request().Header.Get("Last-Event-ID"); request.Cursor == nil && lastEventID != "" {
+		request.Cursor = lo.ToPtr(lastEventID)
+	}
+
+	filter, err := s.parseFilter(ctx.Request().Context(), &request.FilterRequest)
+	if err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	// Subscribe before replaying, so no activity is missed between the replay and the live activities.
+	subscription := s.hub.Subscribe()
+	defer subscription.Close()
+
+	var replayed []*activityx.Activity
+
+	if request.Cursor != nil {
+		if replayed, err = s.replay(ctx.Request().Context(), filter, *request.Cursor, request.ActionLimit); err != nil {
+			zap.L().Error("failed to replay activities",
+				zap.String("cursor", *request.Cursor),
+				zap.Error(err))
+
+			return response.BadRequestError(ctx, err)
+		}
+	}
+
+	var connection sender
+
+	if websocket.IsWebSocketUpgrade(ctx.Request()) {
+		conn, err := upgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
+		if err != nil {
+			// The upgrader has already replied with an error.
+			return nil
+		}
+
+		connection = &websocketSender{conn: conn}
+	} else {
+		connection = newEventStreamSender(ctx.Response())
+	}
+
+	var filterValue atomic.Pointer[Filter]
+
+	filterValue.Store(filter)
+
+	streamCtx, cancel := context.WithCancel(ctx.Request().Context())
+	defer cancel()
+
+	// Only WebSocket subscribers can replace the filter after subscribing.
+	if conn, ok := connection.(*websocketSender); ok {
+		go func() {
+			defer cancel()
+
+			s.receiveFilters(streamCtx, conn, &filterValue)
+		}()
+	}
+
+	err = s.stream(streamCtx, connection, subscription, &filterValue, replayed, request.ActionLimit)
+
+	connection.Close(err)
+
+	return nil
+}
+
+// stream sends the replayed activities and then the live activities until the subscriber disconnects.
+func (s *Server) stream(ctx context.Context, connection sender, subscription *pubsub.Subscription, filter *atomic.Pointer[Filter], replayed []*activityx.Activity, actionLimit int) error {
+	// The activities committed during the replay are received by both, they are only sent once.
+	sent := make(map[string]struct{}, len(replayed))
+
+	for _, activity := range replayed {
+		sent[buildCursor(activity)] = struct{}{}
+	}
+
+	if err := s.send(ctx, connection, replayed, actionLimit); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(defaultPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := connection.Ping(); err != nil {
+				return nil
+			}
+		case activities, ok := <-subscription.Activities():
+			if !ok {
+				return subscription.Err()
+			}
+
+			currentFilter := filter.Load()
+
+			activities = lo.Filter(activities, func(activity *activityx.Activity, _ int) bool {
+				_, found := sent[buildCursor(activity)]
+
+				return !found && currentFilter.Match(activity)
+			})
+
+			if err := s.send(ctx, connection, activities, actionLimit); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+func (s *Server) send(ctx context.Context, connection sender, activities []*activityx.Activity, actionLimit int) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	// The published activities contain all actions, they are limited as the database queries do.
+	activities = lo.Map(activities, func(activity *activityx.Activity, _ int) *activityx.Activity {
+		if len(activity.Actions) <= actionLimit {
+			return activity
+		}
+
+		limited := *activity
+		limited.Actions = lo.Slice(activity.Actions, 0, actionLimit)
+
+		return &limited
+	})
+
+	for _, activity := range s.transform(ctx, activities) {
+		if activity == nil {
+			continue
+		}
+
+		if err := connection.Send(&Event{Cursor: buildCursor(activity), Data: activity}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// receiveFilters replaces the filter with the ones sent by a WebSocket subscriber until it disconnects.
+func (s *Server) receiveFilters(ctx context.Context, connection *websocketSender, filter *atomic.Pointer[Filter]) {
+	for {
+		var request FilterRequest
+
+		if err := connection.conn.ReadJSON(&request); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) && ctx.Err() == nil {
+				zap.L().Debug("subscriber disconnected", zap.Error(err))
+			}
+
+			return
+		}
+
+		value, err := s.parseFilter(ctx, &request)
+		if err != nil {
+			if err := connection.SendError(err); err != nil {
+				return
+			}
+
+			continue
+		}
+
+		filter.Store(value)
+	}
+}
+
+func (s *Server) parseFilter(ctx context.Context, request *FilterRequest) (*Filter, error) {
+	filter, err := request.Filter()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.prepare != nil {
+		if err := s.prepare(ctx, filter); err != nil {
+			return nil, err
+		}
+	}
+
+	return filter, nil
+}
+
+// replay loads the activities matched by the filter that were committed after the cursor, in ascending order.
+// The data source of a network commits its activities in the order of the indexed blocks, so the activities of
+// the network are replayed by their timestamps, while those of other networks may have been committed after the
+// cursor with earlier timestamps, which is why the filter must select the network of the cursor alone.
+// The activities are ordered as the REST API does, so the ones sharing the timestamp of the cursor and
+// ordered before it are replayed, while the ones ordered after it have already been received.
+func (s *Server) replay(ctx context.Context, filter *Filter, cursor string, actionLimit int) ([]*activityx.Activity, error) {
+	id, networkValue, found := strings.Cut(cursor, ":")
+	if !found {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	cursorNetwork, err := network.NetworkString(networkValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	if len(filter.Networks) != 1 || filter.Networks[0] != cursorNetwork {
+		return nil, fmt.Errorf("the cursor of %s only resumes a subscription to the network alone", cursorNetwork)
+	}
+
+	cursorActivity, _, err := s.databaseClient.FindActivity(ctx, model.ActivityQuery{ID: lo.ToPtr(id), Network: lo.ToPtr(cursorNetwork), ActionLimit: actionLimit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cursor: %w", err)
+	}
+
+	if cursorActivity == nil {
+		return nil, fmt.Errorf("cursor %s not found", cursor)
+	}
+
+	query := filter.Query()
+	query.StartTimestamp = lo.ToPtr(cursorActivity.Timestamp)
+	query.Limit = defaultPageLimit
+	query.ActionLimit = actionLimit
+
+	var activities []*activityx.Activity
+
+	for {
+		page, err := s.databaseClient.FindActivities(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("find activities: %w", err)
+		}
+
+		index := lo.IndexOf(lo.Map(page, func(activity *activityx.Activity, _ int) string {
+			return buildCursor(activity)
+		}), cursor)
+
+		if index >= 0 {
+			activities = append(activities, page[:index]...)
+
+			break
+		}
+
+		activities = append(activities, page...)
+
+		if len(activities) > defaultReplayLimit {
+			return nil, fmt.Errorf("more than %d activities were committed after the cursor", defaultReplayLimit)
+		}
+
+		if len(page) < query.Limit {
+			break
+		}
+
+		query.Cursor = page[len(page)-1]
+	}
+
+	// An activity is found once for each of its owners if the owners are not filtered, it is only replayed once.
+	return lo.Reverse(lo.UniqBy(activities, buildCursor)), nil
+}
+
+// buildCursor builds the cursor of an activity in the format of the REST API.
+func buildCursor(activity *activityx.Activity) string {
+	return fmt.Sprintf("%s:%s", activity.ID, activity.Network)
+}
+
+// NewServer creates a subscription server, prepare is optional.
+func NewServer(hub *pubsub.Hub, databaseClient database.Client, prepare func(ctx context.Context, filter *Filter) error, transform func(ctx context.Context, activities []*activityx.Activity) []*activityx.Activity) *Server {
+	return &Server{
+		hub:            hub,
+		databaseClient: databaseClient,
+		prepare:        prepare,
+		transform:      transform,
+	}
+}
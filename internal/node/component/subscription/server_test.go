@@ -0,0 +1,117 @@
+package subscription_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/dialer"
+	"github.com/rss3-network/node/v2/internal/node/component"
+	"github.com/rss3-network/node/v2/internal/node/component/subscription"
+	"github.com/rss3-network/node/v2/internal/stream/pubsub"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	databaseClient, err := dialer.Dial(ctx, &config.Database{
+		Driver: database.DriverSQLite,
+		URI:    filepath.Join(t.TempDir(), "node.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, databaseClient.Migrate(ctx))
+
+	buildActivity := func(id string, networkValue network.Network, timestamp uint64) *activityx.Activity {
+		return &activityx.Activity{
+			ID:      id,
+			Network: networkValue,
+			From:    "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+			To:      "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+			Tag:     tag.Transaction,
+			Type:    typex.TransactionTransfer,
+			Actions: []*activityx.Action{
+				{
+					Tag:  tag.Transaction,
+					Type: typex.TransactionTransfer,
+					From: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+					To:   "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+				},
+			},
+			Status:    true,
+			Timestamp: timestamp,
+		}
+	}
+
+	require.NoError(t, databaseClient.SaveActivities(ctx, []*activityx.Activity{
+		buildActivity("0x01", network.Ethereum, 1729000100),
+		buildActivity("0x02", network.Ethereum, 1729000200),
+		buildActivity("0x03", network.Ethereum, 1729000300),
+		buildActivity("0x04", network.Arbitrum, 1729000250),
+	}, true))
+
+	apiServer := echo.New()
+	apiServer.Validator = &component.Validator{Validator: validator.New()}
+
+	server := subscription.NewServer(pubsub.NewHub(nil, pubsub.ChannelDecentralized), databaseClient, nil, func(_ context.Context, activities []*activityx.Activity) []*activityx.Activity {
+		return activities
+	})
+
+	apiServer.GET("/subscribe", server.Serve)
+
+	subscribe := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		// The subscription is served until the subscriber disconnects.
+		requestCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+
+		request := httptest.NewRequest(http.MethodGet, "/subscribe?"+query, nil).WithContext(requestCtx)
+		recorder := httptest.NewRecorder()
+		apiServer.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	t.Run("Replay The Network Of The Cursor", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := subscribe(t, "network=ethereum&cursor=0x01:ethereum")
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		ids := regexp.MustCompile(`(?m)^id: (.+)$`).FindAllStringSubmatch(recorder.Body.String(), -1)
+		require.Equal(t, [][]string{
+			{"id: 0x02:ethereum", "0x02:ethereum"},
+			{"id: 0x03:ethereum", "0x03:ethereum"},
+		}, ids)
+	})
+
+	for _, query := range []string{
+		"cursor=0x01:ethereum",
+		"network=ethereum&network=arbitrum&cursor=0x01:ethereum",
+		"network=arbitrum&cursor=0x01:ethereum",
+	} {
+		query := query
+
+		t.Run(fmt.Sprintf("Reject The Cursor Of Another Network %s", query), func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, http.StatusBadRequest, subscribe(t, query).Code)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/v2/internal/stream"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultBackfillMergeIdle is the time since the last checkpoint of the live worker after which it is considered stopped.
+const defaultBackfillMergeIdle = 5 * time.Minute
+
+// BackfillOption is the block range to be backfilled and the number of shards it is split into,
+// or the accounts whose history is backfilled for the protocols without blocks.
+type BackfillOption struct {
+	BlockStart  uint64
+	BlockTarget uint64
+	Shards      uint64
+	Accounts    []string
+}
+
+// backfillShard is a part of the block range, indexed by an independent server with its own checkpoint.
+type backfillShard struct {
+	ID          string
+	BlockStart  uint64
+	BlockTarget uint64
+}
+
+// Backfill indexes the block range with range-sharded servers running concurrently,
+// the checkpoint of the live worker is moved to the block target once all shards have caught up.
+func Backfill(ctx context.Context, config *config.Module, option BackfillOption, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) error {
+	if config.Network.Protocol() == network.ActivityPubProtocol {
+		return backfillAccounts(ctx, config, option, databaseClient, streamClient, redisClient)
+	}
+
+	if config.Network.Protocol() != network.EthereumProtocol {
+		return fmt.Errorf("backfill is not supported by the %s protocol", config.Network.Protocol())
+	}
+
+	if option.BlockTarget <= option.BlockStart || option.Shards == 0 {
+		return fmt.Errorf("invalid backfill range %d-%d with %d shards", option.BlockStart, option.BlockTarget, option.Shards)
+	}
+
+	shards := buildBackfillShards(config.ID, option)
+
+	// The checkpoints of shards are kept after the backfill, so an interrupted backfill resumes from them.
+	checkpoints, err := databaseClient.LoadCheckpoints(ctx, "", config.Network, config.Worker.Name())
+	if err != nil {
+		return fmt.Errorf("load checkpoints: %w", err)
+	}
+
+	for _, checkpoint := range checkpoints {
+		if strings.HasPrefix(checkpoint.ID, backfillID(config.ID)) {
+			zap.L().Info("resuming backfill shard",
+				zap.String("id", checkpoint.ID),
+				zap.Int64("index_count", checkpoint.IndexCount),
+				zap.ByteString("state", checkpoint.State))
+		}
+	}
+
+	errorGroup, errorCtx := errgroup.WithContext(ctx)
+
+	for _, shard := range shards {
+		shard := shard
+
+		errorGroup.Go(func() error {
+			server, err := NewServer(errorCtx, buildBackfillModule(config, shard), databaseClient, streamClient, redisClient)
+			if err != nil {
+				return fmt.Errorf("new backfill server %s: %w", shard.ID, err)
+			}
+
+			zap.L().Info("starting backfill shard",
+				zap.String("id", shard.ID),
+				zap.Uint64("block_start", shard.BlockStart),
+				zap.Uint64("block_target", shard.BlockTarget))
+
+			if err := server.Run(errorCtx); err != nil {
+				return fmt.Errorf("run backfill server %s: %w", shard.ID, err)
+			}
+
+			zap.L().Info("backfill shard has caught up", zap.String("id", shard.ID))
+
+			return nil
+		})
+	}
+
+	if err := errorGroup.Wait(); err != nil {
+		return err
+	}
+
+	return mergeBackfill(ctx, config, option, databaseClient)
+}
+
+// backfillAccounts indexes the history of the accounts with a server that stops once the accounts are backfilled,
+// the live worker keeps receiving the new activities meanwhile.
+func backfillAccounts(ctx context.Context, config *config.Module, option BackfillOption, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) error {
+	if len(option.Accounts) == 0 {
+		return fmt.Errorf("no accounts to backfill")
+	}
+
+	server, err := NewServer(ctx, buildBackfillAccountsModule(config, option.Accounts), databaseClient, streamClient, redisClient)
+	if err != nil {
+		return fmt.Errorf("new backfill server: %w", err)
+	}
+
+	zap.L().Info("starting backfill of accounts",
+		zap.String("id", config.ID),
+		zap.Strings("accounts", option.Accounts))
+
+	if err := server.Run(ctx); err != nil {
+		return fmt.Errorf("run backfill server: %w", err)
+	}
+
+	zap.L().Info("backfill of accounts has finished", zap.String("id", config.ID))
+
+	return nil
+}
+
+// mergeBackfill moves the checkpoint of the live worker to the block target if it is behind,
+// so the live worker continues from where the backfill has stopped.
+// The live worker must be stopped, otherwise it would overwrite the merged checkpoint with the state it holds in memory.
+func mergeBackfill(ctx context.Context, config *config.Module, option BackfillOption, databaseClient database.Client) error {
+	checkpoint, err := databaseClient.LoadCheckpoint(ctx, config.ID, config.Network, config.Worker.Name())
+	if err != nil {
+		return fmt.Errorf("load checkpoint: %w", err)
+	}
+
+	var state ethereum.State
+	if err := json.Unmarshal(checkpoint.State, &state); err != nil {
+		return fmt.Errorf("unmarshal checkpoint state: %w", err)
+	}
+
+	if state.BlockNumber >= option.BlockTarget {
+		zap.L().Info("live worker is ahead of the backfill",
+			zap.String("id", config.ID),
+			zap.Uint64("block_number", state.BlockNumber))
+
+		return nil
+	}
+
+	if err := validateBackfillMerge(checkpoint, state, option, time.Now()); err != nil {
+		return err
+	}
+
+	// The hash of the block target is unknown, so the reorganization check of the next block is skipped.
+	if checkpoint.State, err = json.Marshal(ethereum.State{BlockNumber: option.BlockTarget}); err != nil {
+		return fmt.Errorf("marshal checkpoint state: %w", err)
+	}
+
+	if err := databaseClient.SaveCheckpoint(ctx, checkpoint); err != nil {
+		return fmt.Errorf("save checkpoint: %w", err)
+	}
+
+	zap.L().Info("merged backfill into the live worker",
+		zap.String("id", config.ID),
+		zap.Uint64("block_number", option.BlockTarget))
+
+	return nil
+}
+
+// validateBackfillMerge returns an error if the checkpoint of the live worker, which is behind the block target, can not be moved to it.
+// The blocks between the live worker and the block start would never be indexed, and a live worker that has saved
+// its checkpoint recently is considered running. The shard checkpoints are kept, so the backfill can be run again to merge it.
+func validateBackfillMerge(checkpoint *engine.Checkpoint, state ethereum.State, option BackfillOption, now time.Time) error {
+	if state.BlockNumber < option.BlockStart {
+		return fmt.Errorf("live worker %s at block %d is behind the backfill start %d, blocks %d-%d would be skipped",
+			checkpoint.ID, state.BlockNumber, option.BlockStart, state.BlockNumber+1, option.BlockStart)
+	}
+
+	// A checkpoint that has never indexed a block is initialized by the load and has not been saved by a live worker.
+	if state.BlockNumber > 0 && now.Sub(checkpoint.UpdatedAt) < defaultBackfillMergeIdle {
+		return fmt.Errorf("live worker %s has saved its checkpoint at %s, stop it for %s before merging the backfill",
+			checkpoint.ID, checkpoint.UpdatedAt.Format(time.RFC3339), defaultBackfillMergeIdle)
+	}
+
+	return nil
+}
+
+// buildBackfillShards splits the block range into shards of the same size,
+// the block start of each shard is exclusive as it is the last indexed block of the data source.
+func buildBackfillShards(id string, option BackfillOption) []backfillShard {
+	size := (option.BlockTarget - option.BlockStart + option.Shards - 1) / option.Shards
+
+	shards := make([]backfillShard, 0, option.Shards)
+
+	for blockStart := option.BlockStart; blockStart < option.BlockTarget; blockStart += size {
+		blockTarget := min(blockStart+size, option.BlockTarget)
+
+		shards = append(shards, backfillShard{
+			ID:          fmt.Sprintf("%s%d-%d", backfillID(id), blockStart, blockTarget),
+			BlockStart:  blockStart,
+			BlockTarget: blockTarget,
+		})
+	}
+
+	return shards
+}
+
+// buildBackfillModule builds the module of the shard with its own checkpoint ID and block range.
+func buildBackfillModule(module *config.Module, shard backfillShard) *config.Module {
+	parameters := make(config.Parameters)
+
+	if module.Parameters != nil {
+		for key, value := range *module.Parameters {
+			parameters[key] = value
+		}
+	}
+
+	parameters["block_start"] = shard.BlockStart
+	parameters["block_target"] = shard.BlockTarget
+
+	shardModule := *module
+	shardModule.ID = shard.ID
+	shardModule.Parameters = lo.ToPtr(parameters)
+
+	return &shardModule
+}
+
+// buildBackfillAccountsModule builds the module that backfills the accounts with its own checkpoint ID.
+func buildBackfillAccountsModule(module *config.Module, accounts []string) *config.Module {
+	parameters := make(config.Parameters)
+
+	if module.Parameters != nil {
+		for key, value := range *module.Parameters {
+			parameters[key] = value
+		}
+	}
+
+	parameters["backfill_accounts"] = accounts
+	parameters["backfill_only"] = true
+
+	accountsModule := *module
+	accountsModule.ID = backfillID(module.ID) + "accounts"
+	accountsModule.Parameters = lo.ToPtr(parameters)
+
+	return &accountsModule
+}
+
+func backfillID(id string) string {
+	return id + ".backfill."
+}
@@ -0,0 +1,108 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/internal/engine/protocol/ethereum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildBackfillShards(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name   string
+		option BackfillOption
+		want   []backfillShard
+	}{
+		{
+			name:   "Even range",
+			option: BackfillOption{BlockStart: 100, BlockTarget: 200, Shards: 2},
+			want: []backfillShard{
+				{ID: "ethereum-core.backfill.100-150", BlockStart: 100, BlockTarget: 150},
+				{ID: "ethereum-core.backfill.150-200", BlockStart: 150, BlockTarget: 200},
+			},
+		},
+		{
+			name:   "Uneven range",
+			option: BackfillOption{BlockStart: 0, BlockTarget: 10, Shards: 3},
+			want: []backfillShard{
+				{ID: "ethereum-core.backfill.0-4", BlockStart: 0, BlockTarget: 4},
+				{ID: "ethereum-core.backfill.4-8", BlockStart: 4, BlockTarget: 8},
+				{ID: "ethereum-core.backfill.8-10", BlockStart: 8, BlockTarget: 10},
+			},
+		},
+		{
+			name:   "More shards than blocks",
+			option: BackfillOption{BlockStart: 0, BlockTarget: 2, Shards: 4},
+			want: []backfillShard{
+				{ID: "ethereum-core.backfill.0-1", BlockStart: 0, BlockTarget: 1},
+				{ID: "ethereum-core.backfill.1-2", BlockStart: 1, BlockTarget: 2},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testcase.want, buildBackfillShards("ethereum-core", testcase.option))
+		})
+	}
+}
+
+func TestValidateBackfillMerge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	option := BackfillOption{BlockStart: 100, BlockTarget: 200, Shards: 2}
+
+	testcases := []struct {
+		name       string
+		checkpoint *engine.Checkpoint
+		state      ethereum.State
+		wantError  require.ErrorAssertionFunc
+	}{
+		{
+			name:       "Stopped at block start",
+			checkpoint: &engine.Checkpoint{ID: "ethereum-core", UpdatedAt: now.Add(-time.Hour)},
+			state:      ethereum.State{BlockNumber: 100},
+			wantError:  require.NoError,
+		},
+		{
+			name:       "Never started from genesis",
+			checkpoint: &engine.Checkpoint{ID: "ethereum-core", UpdatedAt: now},
+			state:      ethereum.State{},
+			wantError:  require.Error,
+		},
+		{
+			name:       "Behind block start",
+			checkpoint: &engine.Checkpoint{ID: "ethereum-core", UpdatedAt: now.Add(-time.Hour)},
+			state:      ethereum.State{BlockNumber: 99},
+			wantError:  require.Error,
+		},
+		{
+			name:       "Running",
+			checkpoint: &engine.Checkpoint{ID: "ethereum-core", UpdatedAt: now.Add(-time.Minute)},
+			state:      ethereum.State{BlockNumber: 150},
+			wantError:  require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testcase.wantError(t, validateBackfillMerge(testcase.checkpoint, testcase.state, option, now))
+		})
+	}
+
+	// A live worker that has never indexed a block can be merged if the backfill starts from the genesis block.
+	require.NoError(t, validateBackfillMerge(&engine.Checkpoint{ID: "ethereum-core", UpdatedAt: now}, ethereum.State{}, BackfillOption{BlockTarget: 200, Shards: 2}, now))
+}
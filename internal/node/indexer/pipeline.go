@@ -0,0 +1,468 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/internal/constant"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/internal/stream/pubsub"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultQueueSize is the number of batches each pipeline stage can buffer.
+	defaultQueueSize = 4
+	// defaultSaveAttempts is the number of attempts to save a batch before the worker gives up.
+	defaultSaveAttempts = 10
+	// defaultSaveRetryDelay is the initial delay between the attempts to save a batch, it doubles with each attempt.
+	defaultSaveRetryDelay = time.Second
+
+	stageTransform = "transform"
+	stageSave      = "save"
+)
+
+// batch is the unit of work passing through the pipeline stages.
+type batch struct {
+	ctx        context.Context
+	tasks      *engine.Tasks
+	checkpoint engine.Checkpoint
+	activities []*activityx.Activity
+	// deadLetters are the tasks that failed to be transformed.
+	deadLetters []*model.DeadLetter
+	// tombstones are the ids of the activities removed by the tasks.
+	tombstones []string
+	// receivedAt is the time the batch was received from the data source.
+	receivedAt time.Time
+}
+
+// receiveTasks is the first stage of the pipeline, it receives tasks from the data source and forwards them to the transform stage.
+// The state of the data source is attached to the tasks, so it never covers more than the tasks of the batch.
+func (s *Server) receiveTasks(ctx context.Context, tasksChan <-chan *engine.Tasks, errorChan <-chan error) error {
+	defer close(s.transformQueue)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case tasks := <-tasksChan:
+			zap.L().Debug("received tasks from source",
+				zap.Int("task_count", tasks.Len()))
+
+			value := batch{
+				// Extract the OpenTelemetry context from the tasks.
+				ctx:   otel.GetTextMapPropagator().Extract(ctx, tasks),
+				tasks: tasks,
+				checkpoint: engine.Checkpoint{
+					ID:      s.id,
+					Network: s.source.Network(),
+					Worker:  s.worker.Name(),
+				},
+				receivedAt: time.Now(),
+			}
+
+			select {
+			case s.transformQueue <- &value:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		case err := <-errorChan:
+			if err != nil {
+				return fmt.Errorf("an error occurred in the protocol: %w", err)
+			}
+
+			// The data source has finished, the remaining batches will be drained by the next stages.
+			return nil
+		}
+	}
+}
+
+// transformBatches is the second stage of the pipeline, it transforms the tasks of each batch into activities.
+// Batches are transformed one by one, so the next batch is transformed while the previous one is being saved.
+func (s *Server) transformBatches(ctx context.Context) error {
+	defer close(s.saveQueue)
+
+	for value := range s.transformQueue {
+		// Reorganizations have no tasks to transform but must be handled in order by the save stage.
+		if value.tasks.Reorganization == nil && value.tasks.Len() > 0 {
+			value.activities, value.deadLetters, value.tombstones = s.transformTasks(value.ctx, value.tasks)
+		}
+
+		select {
+		case s.saveQueue <- value:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// saveBatches is the last stage of the pipeline, it saves the activities and checkpoint of each batch in order.
+func (s *Server) saveBatches(ctx context.Context) error {
+	for value := range s.saveQueue {
+		retryableFunc := func() error {
+			if err := s.saveBatch(value.ctx, value); err != nil {
+				return fmt.Errorf("save batch: %w", err)
+			}
+
+			return nil
+		}
+
+		err := retry.Do(retryableFunc,
+			retry.Context(ctx),
+			retry.Attempts(s.saveAttempts),
+			retry.Delay(s.saveRetryDelay),
+			retry.DelayType(retry.BackOffDelay), // Use backoff delay type, increasing delay on each retry.
+			retry.MaxDelay(5*time.Minute),
+			retry.LastErrorOnly(true),
+			retry.OnRetry(func(n uint, err error) {
+				zap.L().Error("failed to save batch, retrying",
+					zap.Uint("retry_count", n),
+					zap.Error(err))
+			}),
+		)
+		if err != nil {
+			return fmt.Errorf("retry save batch: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// transformTasks transforms the tasks into activities concurrently, activities that contain no actions are filtered out
+// and tasks that failed to transform are returned as dead letters to be replayed later.
+// The ids of the activities removed by the tasks are returned as tombstones if the worker implements engine.Tombstoner.
+func (s *Server) transformTasks(ctx context.Context, tasks *engine.Tasks) ([]*activityx.Activity, []*model.DeadLetter, []string) {
+	ctx, span := otel.Tracer("").Start(ctx, "Indexer transformTasks", trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("service", constant.Name),
+		attribute.String("worker", s.worker.Name()),
+		attribute.Int("tasks", tasks.Len()),
+	)
+
+	type result struct {
+		activity   *activityx.Activity
+		deadLetter *model.DeadLetter
+		tombstones []string
+	}
+
+	tombstoner, _ := s.worker.(engine.Tombstoner)
+
+	resultPool := pool.NewWithResults[result]().WithMaxGoroutines(lo.Ternary(tasks.Len() < 20*runtime.NumCPU(), tasks.Len(), 20*runtime.NumCPU()))
+
+	for _, task := range tasks.Tasks {
+		task := task
+
+		resultPool.Go(func() result {
+			activity, err := s.worker.Transform(ctx, task)
+			if err != nil {
+				zap.L().Error("failed to transform task",
+					zap.String("task_id", task.ID()),
+					zap.Error(err))
+
+				return result{deadLetter: s.buildDeadLetter(task, err)}
+			}
+
+			var tombstones []string
+
+			if tombstoner != nil {
+				if tombstones, err = tombstoner.Tombstones(ctx, task); err != nil {
+					zap.L().Error("failed to find tombstones of task",
+						zap.String("task_id", task.ID()),
+						zap.Error(err))
+
+					return result{deadLetter: s.buildDeadLetter(task, err)}
+				}
+			}
+
+			if activity != nil && len(activity.Actions) > 0 {
+				zap.L().Info("successfully transformed task",
+					zap.String("task_id", task.ID()))
+			}
+
+			return result{activity: activity, tombstones: tombstones}
+		})
+	}
+
+	results := resultPool.Wait()
+
+	// Filter out activities that failed to transform or contain no actions
+	activities := lo.FilterMap(results, func(result result, _ int) (*activityx.Activity, bool) {
+		return result.activity, result.activity != nil && len(result.activity.Actions) > 0
+	})
+
+	deadLetters := lo.FilterMap(results, func(result result, _ int) (*model.DeadLetter, bool) {
+		return result.deadLetter, result.deadLetter != nil
+	})
+
+	tombstones := lo.FlatMap(results, func(result result, _ int) []string {
+		return result.tombstones
+	})
+
+	zap.L().Info("task transformation completed",
+		zap.Int("total_tasks", tasks.Len()),
+		zap.Int("successful_activities", len(activities)),
+		zap.Int("dead_letters", len(deadLetters)),
+		zap.Int("tombstones", len(tombstones)))
+
+	return activities, deadLetters, tombstones
+}
+
+// buildDeadLetter builds a dead letter from the task that failed to be transformed.
+func (s *Server) buildDeadLetter(task engine.Task, transformErr error) *model.DeadLetter {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		zap.L().Error("failed to marshal task payload",
+			zap.String("task_id", task.ID()),
+			zap.Error(err))
+
+		payload = json.RawMessage("null")
+	}
+
+	return &model.DeadLetter{
+		ID:       task.ID(),
+		Network:  task.GetNetwork(),
+		Worker:   s.worker.Name(),
+		Error:    transformErr.Error(),
+		Payload:  payload,
+		Attempts: 1,
+	}
+}
+
+// saveBatch saves the activities and checkpoint of the batch to the database.
+func (s *Server) saveBatch(ctx context.Context, value *batch) (err error) {
+	// Initialize the attributes of the meter.
+	meterTasksCounterAttributes := metric.WithAttributes(
+		attribute.String("service", constant.Name),
+		attribute.String("worker", s.worker.Name()),
+		attribute.Int("tasks", value.tasks.Len()),
+	)
+
+	checkpoint := value.checkpoint
+
+	switch {
+	case value.tasks.Checkpoint != nil:
+		// Merge the sub-checkpoint of the stream into the state committed by the previous batch,
+		// batches are saved in order, so the cursor of the stream never covers unsaved tasks.
+		checkpoint.State = s.committedState
+
+		if err := checkpoint.Commit(value.tasks.Checkpoint); err != nil {
+			return fmt.Errorf("commit checkpoint of stream %s: %w", value.tasks.Checkpoint.Name, err)
+		}
+	case value.tasks.State != nil:
+		checkpoint.State = value.tasks.State
+	default:
+		// The data source has attached no state, so the checkpoint is not advanced.
+		checkpoint.State = s.committedState
+	}
+
+	// The sub-checkpoints of the next batches are merged into the state once this batch has been saved.
+	defer func() {
+		if err == nil {
+			s.committedState = checkpoint.State
+		}
+	}()
+
+	ctx, span := otel.Tracer("").Start(ctx, "Indexer saveBatch", trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("service", constant.Name),
+		attribute.String("worker", s.worker.Name()),
+		attribute.Int("tasks", value.tasks.Len()),
+		attribute.String("state", string(checkpoint.State)),
+	)
+
+	// Remove the activities of orphaned blocks before indexing the canonical chain.
+	if value.tasks.Reorganization != nil {
+		return s.handleReorganization(ctx, value.tasks.Reorganization, &checkpoint)
+	}
+
+	// If no tasks are returned, only save the checkpoint to the database.
+	if value.tasks.Len() == 0 {
+		zap.L().Info("no tasks to process, saving checkpoint",
+			zap.Any("checkpoint", checkpoint))
+
+		if err := s.databaseClient.SaveCheckpoint(ctx, &checkpoint); err != nil {
+			return fmt.Errorf("save checkpoint: %w", err)
+		}
+
+		return nil
+	}
+
+	// Deprecated: use meterTasksHistogram instead.
+	s.meterTasksCounter.Add(ctx, int64(value.tasks.Len()), meterTasksCounterAttributes)
+	checkpoint.IndexCount = int64(len(value.activities))
+
+	// Low priority for Ethereum protocol and Core worker.
+	// Prevent low priority worker from overwriting activities from high priority worker in database.
+	lowPriority := isLowPriority(checkpoint.Network, s.worker.Name())
+
+	// Match the activities against the webhooks before the transaction, the webhooks are cached by the matcher.
+	deliveries, err := s.webhookMatcher.Deliveries(ctx, value.activities)
+	if err != nil {
+		return fmt.Errorf("match webhooks: %w", err)
+	}
+
+	// Save dead letters, activities, checkpoint, stream outbox and webhook deliveries in one transaction,
+	// so the checkpoint never advances past activities that have not been saved or published.
+	err = s.databaseClient.WithTransaction(ctx, func(ctx context.Context, client database.Client) error {
+		// Keep the tasks that failed to transform, so they can be replayed once the worker has been fixed.
+		if len(value.deadLetters) > 0 {
+			if err := client.SaveDeadLetters(ctx, value.deadLetters); err != nil {
+				return fmt.Errorf("save %d dead letters: %w", len(value.deadLetters), err)
+			}
+		}
+
+		if err := client.SaveActivities(ctx, value.activities, lowPriority); err != nil {
+			return fmt.Errorf("save %d activities: %w", len(value.activities), err)
+		}
+
+		// Delete the tombstoned activities after saving, since they may have been indexed by the same batch.
+		if err := deleteTombstones(ctx, client, checkpoint.Network, value.tombstones); err != nil {
+			return fmt.Errorf("delete %d tombstones: %w", len(value.tombstones), err)
+		}
+
+		if err := saveTasks(ctx, client, s.worker, value.tasks.Tasks, value.deadLetters); err != nil {
+			return fmt.Errorf("save state of tasks: %w", err)
+		}
+
+		if err := client.SaveCheckpoint(ctx, &checkpoint); err != nil {
+			return fmt.Errorf("save checkpoint: %w", err)
+		}
+
+		// The activities are published to the stream by the outbox relay after the transaction has been committed.
+		if s.streamClient != nil && len(value.activities) > 0 {
+			if err := client.SaveStreamOutbox(ctx, &model.StreamOutbox{Activities: value.activities}); err != nil {
+				return fmt.Errorf("save stream outbox: %w", err)
+			}
+		}
+
+		// The deliveries are attempted by the webhook dispatcher after the transaction has been committed.
+		if len(deliveries) > 0 {
+			if err := client.SaveWebhookDeliveries(ctx, deliveries); err != nil {
+				return fmt.Errorf("save %d webhook deliveries: %w", len(deliveries), err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	zap.L().Info("successfully saved activities and checkpoint",
+		zap.Int("activity_count", len(value.activities)),
+		zap.Any("checkpoint", checkpoint))
+
+	publishActivities(ctx, s.redisClient, checkpoint.Network, value.activities)
+
+	// Record the time it takes to handle tasks, from being received to being saved.
+	duration := time.Since(value.receivedAt).Seconds()
+	s.meterTasksHistogram.Record(ctx, duration, meterTasksCounterAttributes)
+
+	return nil
+}
+
+// deleteTombstones deletes the stored activities removed by the tasks, the partitions are searched
+// since the earliest timestamp of the stored activities, and the activities that have not been indexed are ignored.
+func deleteTombstones(ctx context.Context, client database.Client, workerNetwork network.Network, ids []string) error {
+	var (
+		stored []string
+		since  uint64
+	)
+
+	for _, id := range lo.Uniq(ids) {
+		activity, _, err := client.FindActivity(ctx, model.ActivityQuery{
+			ID:          lo.ToPtr(id),
+			Network:     lo.ToPtr(workerNetwork),
+			ActionLimit: 1,
+			ActionPage:  1,
+		})
+		if err != nil {
+			return fmt.Errorf("find activity %s: %w", id, err)
+		}
+
+		if activity == nil {
+			continue
+		}
+
+		if len(stored) == 0 || activity.Timestamp < since {
+			since = activity.Timestamp
+		}
+
+		stored = append(stored, activity.ID)
+	}
+
+	if len(stored) == 0 {
+		return nil
+	}
+
+	if err := client.DeleteActivities(ctx, workerNetwork, stored, time.Unix(int64(since), 0)); err != nil {
+		return fmt.Errorf("delete activities: %w", err)
+	}
+
+	zap.L().Debug("deleted tombstoned activities",
+		zap.String("network", workerNetwork.String()),
+		zap.Strings("ids", stored))
+
+	return nil
+}
+
+// saveTasks saves the state of the tasks in order if the worker implements database.TaskSaver,
+// the tasks that failed to transform are left to the replay of their dead letters.
+func saveTasks(ctx context.Context, client database.Client, worker engine.Worker, tasks []engine.Task, deadLetters []*model.DeadLetter) error {
+	taskSaver, ok := worker.(database.TaskSaver)
+	if !ok {
+		return nil
+	}
+
+	failed := lo.SliceToMap(deadLetters, func(deadLetter *model.DeadLetter) (string, struct{}) {
+		return deadLetter.ID, struct{}{}
+	})
+
+	tasks = lo.Filter(tasks, func(task engine.Task, _ int) bool {
+		_, exists := failed[task.ID()]
+
+		return !exists
+	})
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	return taskSaver.SaveTasks(ctx, client, tasks)
+}
+
+// publishActivities publishes the committed activities to the subscribers of the Core instances,
+// a failure is only logged since the subscribers resume from the database.
+func publishActivities(ctx context.Context, redisClient rueidis.Client, workerNetwork network.Network, activities []*activityx.Activity) {
+	channel, ok := pubsub.Channel(workerNetwork.Protocol())
+	if !ok || redisClient == nil || len(activities) == 0 {
+		return
+	}
+
+	if err := pubsub.Publish(ctx, redisClient, channel, activities); err != nil {
+		zap.L().Warn("failed to publish activities to subscribers",
+			zap.Int("activity_count", len(activities)),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,380 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/dialer"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/internal/stream/webhook"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteTombstones(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	databaseClient, err := dialer.Dial(ctx, &config.Database{
+		Driver: database.DriverSQLite,
+		URI:    filepath.Join(t.TempDir(), "node.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, databaseClient.Migrate(ctx))
+
+	// The post was published long before the tombstone, and is found by its stored timestamp.
+	post := &activityx.Activity{
+		ID:      "https://fosstodon.org/users/bert_hubert/statuses/113287843427757001",
+		Network: network.Mastodon,
+		From:    "@bert_hubert@fosstodon.org",
+		To:      "@bert_hubert@fosstodon.org",
+		Tag:     tag.Social,
+		Type:    typex.SocialPost,
+		Actions: []*activityx.Action{
+			{
+				Tag:      tag.Social,
+				Type:     typex.SocialPost,
+				From:     "@bert_hubert@fosstodon.org",
+				To:       "@bert_hubert@fosstodon.org",
+				Metadata: &metadata.SocialPost{Body: "Hello, Mastodon!"},
+			},
+		},
+		Status:    true,
+		Timestamp: uint64(time.Now().AddDate(-1, 0, 0).Unix()),
+	}
+
+	require.NoError(t, databaseClient.SaveActivities(ctx, []*activityx.Activity{post}, false))
+
+	err = databaseClient.WithTransaction(ctx, func(ctx context.Context, client database.Client) error {
+		return deleteTombstones(ctx, client, network.Mastodon, []string{post.ID, post.ID, "https://fosstodon.org/users/bert_hubert/statuses/1"})
+	})
+	require.NoError(t, err)
+
+	activity, _, err := databaseClient.FindActivity(ctx, model.ActivityQuery{ID: lo.ToPtr(post.ID), Network: lo.ToPtr(network.Mastodon), ActionLimit: 1, ActionPage: 1})
+	require.NoError(t, err)
+	require.Nil(t, activity)
+}
+
+// pipelineTask is a task of the fake data source, it is transformed into a post with its ID.
+type pipelineTask struct {
+	id        string
+	timestamp uint64
+}
+
+func (t *pipelineTask) ID() string {
+	return t.id
+}
+
+func (t *pipelineTask) GetNetwork() network.Network {
+	return network.RSSHub
+}
+
+func (t *pipelineTask) GetTimestamp() uint64 {
+	return t.timestamp
+}
+
+func (t *pipelineTask) Validate() error {
+	return nil
+}
+
+func (t *pipelineTask) BuildActivity(options ...activityx.Option) (*activityx.Activity, error) {
+	activity := activityx.Activity{
+		ID:        t.id,
+		Network:   network.RSSHub,
+		Status:    true,
+		Timestamp: t.timestamp,
+	}
+
+	for _, option := range options {
+		if err := option(&activity); err != nil {
+			return nil, err
+		}
+	}
+
+	return &activity, nil
+}
+
+// pipelineSource pushes the batches with the index of the batch as its state, and finishes once all batches have been received.
+type pipelineSource struct {
+	batches [][]engine.Task
+	// pushed is the number of batches received by the indexer.
+	pushed atomic.Int64
+}
+
+func (s *pipelineSource) Network() network.Network {
+	return network.RSSHub
+}
+
+func (s *pipelineSource) State() json.RawMessage {
+	return nil
+}
+
+func (s *pipelineSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks, errorChan chan<- error) {
+	go func() {
+		for index, tasks := range s.batches {
+			select {
+			case tasksChan <- &engine.Tasks{Tasks: tasks, State: json.RawMessage(strconv.Itoa(index + 1))}:
+				s.pushed.Add(1)
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case errorChan <- nil:
+		case <-ctx.Done():
+		}
+	}()
+}
+
+// pipelineWorker transforms the tasks after a random delay, and records the order in which the tasks are saved.
+type pipelineWorker struct {
+	// release blocks the transformation of the tasks until it is closed, if it is not nil.
+	release chan struct{}
+	// saveError fails the transaction of every batch, if it is not nil.
+	saveError error
+
+	mutex sync.Mutex
+	saved []string
+	saves int
+}
+
+func (w *pipelineWorker) Name() string {
+	return "pipeline"
+}
+
+func (w *pipelineWorker) Platform() string {
+	return "Pipeline"
+}
+
+func (w *pipelineWorker) Network() []network.Network {
+	return []network.Network{network.RSSHub}
+}
+
+func (w *pipelineWorker) Tags() []tag.Tag {
+	return []tag.Tag{tag.Social}
+}
+
+func (w *pipelineWorker) Types() []schema.Type {
+	return []schema.Type{typex.SocialPost}
+}
+
+func (w *pipelineWorker) Filter() engine.DataSourceFilter {
+	return nil
+}
+
+func (w *pipelineWorker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	if w.release != nil {
+		select {
+		case <-w.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	// #nosec
+	time.Sleep(time.Duration(rand.Intn(5)) * time.Millisecond)
+
+	activity, err := task.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, err
+	}
+
+	activity.Tag, activity.Type = tag.Social, typex.SocialPost
+	activity.From, activity.To = "alice", "alice"
+	activity.Actions = []*activityx.Action{
+		{
+			Tag:      tag.Social,
+			Type:     typex.SocialPost,
+			Platform: w.Platform(),
+			From:     "alice",
+			To:       "alice",
+			Metadata: &metadata.SocialPost{Body: task.ID()},
+		},
+	}
+
+	return activity, nil
+}
+
+func (w *pipelineWorker) SaveTasks(_ context.Context, _ database.Client, tasks []engine.Task) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.saves++
+
+	if w.saveError != nil {
+		return w.saveError
+	}
+
+	for _, task := range tasks {
+		w.saved = append(w.saved, task.ID())
+	}
+
+	return nil
+}
+
+func newPipelineServer(t *testing.T, source engine.DataSource, worker engine.Worker) (*Server, database.Client) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	databaseClient, err := dialer.Dial(ctx, &config.Database{
+		Driver: database.DriverSQLite,
+		URI:    filepath.Join(t.TempDir(), "node.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, databaseClient.Migrate(ctx))
+
+	server := Server{
+		id:             "pipeline",
+		config:         &config.Module{ID: "pipeline", Network: network.RSSHub},
+		source:         source,
+		worker:         worker,
+		databaseClient: databaseClient,
+		webhookMatcher: webhook.NewMatcher(databaseClient),
+		transformQueue: make(chan *batch, defaultQueueSize),
+		saveQueue:      make(chan *batch, defaultQueueSize),
+		saveAttempts:   defaultSaveAttempts,
+		saveRetryDelay: time.Millisecond,
+		committedState: json.RawMessage("{}"),
+	}
+
+	require.NoError(t, server.initializeMeter())
+
+	return &server, databaseClient
+}
+
+func TestPipeline(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var (
+		source = new(pipelineSource)
+		want   []string
+	)
+
+	for batchIndex := 0; batchIndex < 3*defaultQueueSize; batchIndex++ {
+		var tasks []engine.Task
+
+		for taskIndex := 0; taskIndex < 8; taskIndex++ {
+			id := fmt.Sprintf("%d-%d", batchIndex, taskIndex)
+
+			tasks = append(tasks, &pipelineTask{id: id, timestamp: uint64(time.Now().Unix())})
+			want = append(want, id)
+		}
+
+		source.batches = append(source.batches, tasks)
+	}
+
+	worker := new(pipelineWorker)
+
+	server, databaseClient := newPipelineServer(t, source, worker)
+
+	require.NoError(t, server.Run(ctx))
+
+	// The tasks are transformed concurrently but saved in the order they were pushed.
+	require.Equal(t, want, worker.saved)
+
+	// The checkpoint holds the state attached to the last batch.
+	checkpoint, err := databaseClient.LoadCheckpoint(ctx, "pipeline", network.RSSHub, worker.Name())
+	require.NoError(t, err)
+	require.JSONEq(t, strconv.Itoa(len(source.batches)), string(checkpoint.State))
+	require.Equal(t, int64(len(want)), checkpoint.IndexCount)
+
+	for _, id := range want {
+		activity, _, err := databaseClient.FindActivity(ctx, model.ActivityQuery{ID: lo.ToPtr(id), Network: lo.ToPtr(network.RSSHub), ActionLimit: 1, ActionPage: 1})
+		require.NoError(t, err)
+		require.NotNil(t, activity, id)
+	}
+}
+
+func TestPipelineBoundedQueues(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	source := new(pipelineSource)
+
+	for batchIndex := 0; batchIndex < 4*defaultQueueSize; batchIndex++ {
+		source.batches = append(source.batches, []engine.Task{&pipelineTask{id: strconv.Itoa(batchIndex), timestamp: uint64(time.Now().Unix())}})
+	}
+
+	worker := &pipelineWorker{release: make(chan struct{})}
+
+	server, _ := newPipelineServer(t, source, worker)
+
+	result := make(chan error, 1)
+
+	go func() {
+		result <- server.Run(ctx)
+	}()
+
+	// While the transformation is blocked, the transform stage holds one batch, its queue is full,
+	// and the receive stage holds one more batch, so the data source is blocked.
+	want := int64(1 + defaultQueueSize + 1)
+
+	require.Eventually(t, func() bool {
+		return source.pushed.Load() == want
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Never(t, func() bool {
+		return source.pushed.Load() > want
+	}, 200*time.Millisecond, 10*time.Millisecond)
+
+	close(worker.release)
+
+	require.NoError(t, <-result)
+	require.Equal(t, int64(len(source.batches)), source.pushed.Load())
+	require.Len(t, worker.saved, len(source.batches))
+}
+
+func TestPipelineSaveAttempts(t *testing.T) {
+	t.Parallel()
+
+	source := &pipelineSource{
+		batches: [][]engine.Task{
+			{&pipelineTask{id: "0", timestamp: uint64(time.Now().Unix())}},
+			{&pipelineTask{id: "1", timestamp: uint64(time.Now().Unix())}},
+		},
+	}
+
+	worker := &pipelineWorker{saveError: errors.New("database is unavailable")}
+
+	server, databaseClient := newPipelineServer(t, source, worker)
+
+	// The worker gives up once the first batch has failed to be saved after all attempts.
+	err := server.Run(context.Background())
+	require.ErrorContains(t, err, "retry save batch")
+	require.ErrorContains(t, err, "database is unavailable")
+	require.Equal(t, defaultSaveAttempts, worker.saves)
+
+	// The transaction has been rolled back, so neither the activity nor the checkpoint has been saved.
+	activity, _, err := databaseClient.FindActivity(context.Background(), model.ActivityQuery{ID: lo.ToPtr("0"), Network: lo.ToPtr(network.RSSHub), ActionLimit: 1, ActionPage: 1})
+	require.NoError(t, err)
+	require.Nil(t, activity)
+
+	checkpoint, err := databaseClient.LoadCheckpoint(context.Background(), "pipeline", network.RSSHub, worker.Name())
+	require.NoError(t, err)
+	require.JSONEq(t, "{}", string(checkpoint.State))
+}
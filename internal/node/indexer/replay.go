@@ -0,0 +1,181 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/engine"
+	"github.com/rss3-network/node/v2/internal/engine/protocol"
+	"github.com/rss3-network/node/v2/internal/stream"
+	"github.com/rss3-network/node/v2/internal/stream/webhook"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const defaultReplayLimit = 100
+
+// Replay transforms the dead letters of the module through its worker again,
+// the dead letters are removed once their activities have been saved.
+func Replay(ctx context.Context, config *config.Module, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) error {
+	worker, err := newWorker(config, databaseClient, redisClient)
+	if err != nil {
+		return err
+	}
+
+	query := model.DeadLettersQuery{
+		Network: lo.ToPtr(config.Network),
+		Worker:  lo.ToPtr(worker.Name()),
+		Limit:   defaultReplayLimit,
+	}
+
+	// Load all dead letters before replaying, since the replayed ones are deleted and can not be used as cursors.
+	var deadLetters []*model.DeadLetter
+
+	for {
+		page, err := databaseClient.FindDeadLetters(ctx, query)
+		if err != nil {
+			return fmt.Errorf("find dead letters: %w", err)
+		}
+
+		deadLetters = append(deadLetters, page...)
+
+		if len(page) < defaultReplayLimit {
+			break
+		}
+
+		last := page[len(page)-1]
+
+		query.Cursor = &model.DeadLetterCursor{
+			ID:      last.ID,
+			Network: last.Network,
+			Worker:  last.Worker,
+		}
+	}
+
+	zap.L().Info("replaying dead letters",
+		zap.String("id", config.ID),
+		zap.String("worker", worker.Name()),
+		zap.Int("dead_letters", len(deadLetters)))
+
+	var replayed int
+
+	webhookMatcher := webhook.NewMatcher(databaseClient)
+
+	for _, deadLetter := range deadLetters {
+		task, err := protocol.NewTask(deadLetter.Network.Protocol())
+		if err != nil {
+			return fmt.Errorf("new task: %w", err)
+		}
+
+		if err := json.Unmarshal(deadLetter.Payload, task); err != nil {
+			zap.L().Error("failed to unmarshal dead letter payload",
+				zap.String("task_id", deadLetter.ID),
+				zap.Error(err))
+
+			continue
+		}
+
+		activity, tombstones, err := replayTask(ctx, worker, task)
+		if err != nil {
+			zap.L().Error("failed to replay task",
+				zap.String("task_id", deadLetter.ID),
+				zap.Error(err))
+
+			deadLetter.Error = err.Error()
+
+			if err := databaseClient.SaveDeadLetters(ctx, []*model.DeadLetter{deadLetter}); err != nil {
+				return fmt.Errorf("save dead letter: %w", err)
+			}
+
+			continue
+		}
+
+		// Activities that contain no actions are filtered out as the indexer does.
+		var activities []*activityx.Activity
+
+		if activity != nil && len(activity.Actions) > 0 {
+			activities = append(activities, activity)
+		}
+
+		deliveries, err := webhookMatcher.Deliveries(ctx, activities)
+		if err != nil {
+			return fmt.Errorf("match webhooks: %w", err)
+		}
+
+		err = databaseClient.WithTransaction(ctx, func(ctx context.Context, client database.Client) error {
+			if err := client.SaveActivities(ctx, activities, isLowPriority(config.Network, worker.Name())); err != nil {
+				return fmt.Errorf("save %d activities: %w", len(activities), err)
+			}
+
+			if err := deleteTombstones(ctx, client, deadLetter.Network, tombstones); err != nil {
+				return fmt.Errorf("delete %d tombstones: %w", len(tombstones), err)
+			}
+
+			if err := saveTasks(ctx, client, worker, []engine.Task{task}, nil); err != nil {
+				return fmt.Errorf("save state of task: %w", err)
+			}
+
+			if err := client.DeleteDeadLetter(ctx, deadLetter); err != nil {
+				return fmt.Errorf("delete dead letter: %w", err)
+			}
+
+			// The activities are published by the stream outbox relay of the workers.
+			if streamClient != nil && len(activities) > 0 {
+				if err := client.SaveStreamOutbox(ctx, &model.StreamOutbox{Activities: activities}); err != nil {
+					return fmt.Errorf("save stream outbox: %w", err)
+				}
+			}
+
+			// The deliveries are attempted by the webhook dispatcher of the workers.
+			if len(deliveries) > 0 {
+				if err := client.SaveWebhookDeliveries(ctx, deliveries); err != nil {
+					return fmt.Errorf("save %d webhook deliveries: %w", len(deliveries), err)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		publishActivities(ctx, redisClient, deadLetter.Network, activities)
+
+		zap.L().Info("successfully replayed task",
+			zap.String("task_id", deadLetter.ID))
+
+		replayed++
+	}
+
+	zap.L().Info("dead letters replay completed",
+		zap.Int("dead_letters", len(deadLetters)),
+		zap.Int("replayed", replayed))
+
+	return nil
+}
+
+// replayTask transforms the task, and finds the activities removed by the task if the worker implements engine.Tombstoner.
+func replayTask(ctx context.Context, worker engine.Worker, task engine.Task) (*activityx.Activity, []string, error) {
+	activity, err := worker.Transform(ctx, task)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tombstoner, ok := worker.(engine.Tombstoner)
+	if !ok {
+		return activity, nil, nil
+	}
+
+	tombstones, err := tombstoner.Tombstones(ctx, task)
+	if err != nil {
+		return nil, nil, fmt.Errorf("find tombstones: %w", err)
+	}
+
+	return activity, tombstones, nil
+}
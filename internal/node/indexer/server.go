@@ -3,11 +3,10 @@ package indexer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"runtime"
 	"time"
 
-	"github.com/avast/retry-go/v4"
 	"github.com/redis/rueidis"
 	"github.com/rss3-network/node/v2/config"
 	"github.com/rss3-network/node/v2/internal/constant"
@@ -16,18 +15,18 @@ import (
 	"github.com/rss3-network/node/v2/internal/engine/protocol"
 	decentralizedWorker "github.com/rss3-network/node/v2/internal/engine/worker/decentralized"
 	federatedWorker "github.com/rss3-network/node/v2/internal/engine/worker/federated"
+	rssWorker "github.com/rss3-network/node/v2/internal/engine/worker/rss"
 	"github.com/rss3-network/node/v2/internal/node/monitor"
 	"github.com/rss3-network/node/v2/internal/stream"
+	"github.com/rss3-network/node/v2/internal/stream/outbox"
+	"github.com/rss3-network/node/v2/internal/stream/webhook"
 	decentralizedx "github.com/rss3-network/node/v2/schema/worker/decentralized"
-	activityx "github.com/rss3-network/protocol-go/schema/activity"
 	"github.com/rss3-network/protocol-go/schema/network"
-	"github.com/samber/lo"
-	"github.com/sourcegraph/conc/pool"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
-	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 type Server struct {
@@ -39,17 +38,28 @@ type Server struct {
 	streamClient   stream.Client
 	monitorClient  monitor.Client
 	redisClient    rueidis.Client
+	webhookMatcher *webhook.Matcher
 	// meterTasksCounter is a counter of the number of tasks processed.
 	// Deprecated: use meterTasksHistogram instead.
 	meterTasksCounter   metric.Int64Counter
 	meterTasksHistogram metric.Float64Histogram
 	meterCurrentBlock   metric.Int64ObservableGauge
 	meterLatestBlock    metric.Int64ObservableGauge
+	meterQueueDepth     metric.Int64ObservableGauge
+	// transformQueue and saveQueue are the bounded queues between the pipeline stages.
+	transformQueue chan *batch
+	saveQueue      chan *batch
+	// saveAttempts and saveRetryDelay are the number of attempts to save a batch and the initial delay between them.
+	saveAttempts   uint
+	saveRetryDelay time.Duration
+	// committedState is the checkpoint state of the last saved batch, the sub-checkpoints
+	// of the streams are merged into it. It is only accessed by the save stage.
+	committedState json.RawMessage
 }
 
 func (s *Server) Run(ctx context.Context) error {
 	var (
-		// TODO Develop a more effective solution to implement back pressure.
+		// The tasks channel is unbuffered, so the state of the data source never runs ahead of the received tasks.
 		tasksChan = make(chan *engine.Tasks)
 		errorChan = make(chan error)
 	)
@@ -58,157 +68,78 @@ func (s *Server) Run(ctx context.Context) error {
 		zap.String("version", constant.BuildVersion()),
 		zap.String("worker", s.worker.Name()))
 
+	errorGroup, ctx := errgroup.WithContext(ctx)
+
 	s.source.Start(ctx, tasksChan, errorChan)
 
-	for {
-		select {
-		case tasks := <-tasksChan:
-			zap.L().Debug("received tasks from source",
-				zap.Int("task_count", tasks.Len()))
+	// A slow stage fills its bounded queue, which blocks the previous stages and eventually the data source.
+	errorGroup.Go(func() error {
+		return s.receiveTasks(ctx, tasksChan, errorChan)
+	})
 
-			retryableFunc := func() error {
-				if err := s.handleTasks(ctx, tasks); err != nil {
-					return fmt.Errorf("handle tasks: %w", err)
-				}
+	errorGroup.Go(func() error {
+		return s.transformBatches(ctx)
+	})
 
-				return nil
-			}
+	// The relay and the dispatcher are stopped once all batches have been saved.
+	relayCtx, cancelRelay := context.WithCancel(ctx)
+	defer cancelRelay()
 
-			err := retry.Do(retryableFunc,
-				retry.Attempts(0),
-				retry.Delay(time.Second),            // Set initial delay to 1 second.
-				retry.DelayType(retry.BackOffDelay), // Use backoff delay type, increasing delay on each retry.
-				retry.MaxDelay(5*time.Minute),
-				retry.OnRetry(func(n uint, err error) {
-					zap.L().Error("failed to handle tasks, retrying",
-						zap.Uint("retry_count", n),
-						zap.Error(err))
-				}),
-			)
-			if err != nil {
-				return fmt.Errorf("retry handle tasks: %w", err)
-			}
-		case err := <-errorChan:
-			if err != nil {
-				return fmt.Errorf("an error occurred in the protocol: %w", err)
+	errorGroup.Go(func() error {
+		defer cancelRelay()
+
+		return s.saveBatches(ctx)
+	})
+
+	// Publish the activities committed to the stream outbox.
+	if s.streamClient != nil {
+		errorGroup.Go(func() error {
+			if err := outbox.NewRelay(s.databaseClient, s.streamClient).Run(relayCtx); err != nil && !errors.Is(err, context.Canceled) {
+				return fmt.Errorf("run stream outbox relay: %w", err)
 			}
 
 			return nil
-		}
+		})
 	}
-}
-
-func (s *Server) handleTasks(ctx context.Context, tasks *engine.Tasks) error {
-	// Initialize the attributes of the meter.
-	meterTasksCounterAttributes := metric.WithAttributes(
-		attribute.String("service", constant.Name),
-		attribute.String("worker", s.worker.Name()),
-		attribute.Int("tasks", tasks.Len()),
-	)
 
-	// Start a new timer to record the time it takes to handle tasks.
-	taskTimer := time.Now()
-
-	checkpoint := engine.Checkpoint{
-		ID:      s.id,
-		Network: s.source.Network(),
-		Worker:  s.worker.Name(),
-		State:   s.source.State(),
-	}
+	// Deliver the activities matched by the webhooks.
+	errorGroup.Go(func() error {
+		if err := webhook.NewDispatcher(s.databaseClient).Run(relayCtx); err != nil && !errors.Is(err, context.Canceled) {
+			return fmt.Errorf("run webhook dispatcher: %w", err)
+		}
 
-	// Extract the OpenTelemetry context from the tasks.
-	ctx = otel.GetTextMapPropagator().Extract(ctx, tasks)
+		return nil
+	})
 
-	ctx, span := otel.Tracer("").Start(ctx, "Indexer handleTasks", trace.WithSpanKind(trace.SpanKindConsumer))
-	defer span.End()
+	return errorGroup.Wait()
+}
 
-	span.SetAttributes(
-		attribute.String("service", constant.Name),
-		attribute.String("worker", s.worker.Name()),
-		attribute.Int("tasks", tasks.Len()),
-		attribute.String("state", string(checkpoint.State)),
-	)
+// handleReorganization deletes the activities built from orphaned blocks and rewinds the checkpoint to the common ancestor.
+func (s *Server) handleReorganization(ctx context.Context, reorganization *engine.Reorganization, checkpoint *engine.Checkpoint) error {
+	zap.L().Warn("rolling back activities of orphaned blocks",
+		zap.String("worker", s.worker.Name()),
+		zap.Uint64("block_number", reorganization.BlockNumber),
+		zap.Int("activity_count", len(reorganization.ActivityIDs)))
 
-	// If no tasks are returned, only save the checkpoint to the database.
-	if tasks.Len() == 0 {
-		zap.L().Info("no tasks to process, saving checkpoint",
-			zap.Any("checkpoint", checkpoint))
+	transactionFunction := func(ctx context.Context, client database.Client) error {
+		if err := client.DeleteActivities(ctx, checkpoint.Network, reorganization.ActivityIDs, time.Unix(int64(reorganization.Timestamp), 0)); err != nil {
+			return fmt.Errorf("delete %d activities: %w", len(reorganization.ActivityIDs), err)
+		}
 
-		if err := s.databaseClient.SaveCheckpoint(ctx, &checkpoint); err != nil {
+		if err := client.SaveCheckpoint(ctx, checkpoint); err != nil {
 			return fmt.Errorf("save checkpoint: %w", err)
 		}
 
 		return nil
 	}
 
-	resultPool := pool.NewWithResults[*activityx.Activity]().WithMaxGoroutines(lo.Ternary(tasks.Len() < 20*runtime.NumCPU(), tasks.Len(), 20*runtime.NumCPU()))
-
-	for _, task := range tasks.Tasks {
-		task := task
-
-		resultPool.Go(func() *activityx.Activity {
-			activity, err := s.worker.Transform(ctx, task)
-			if err != nil {
-				zap.L().Error("failed to transform task",
-					zap.String("task_id", task.ID()),
-					zap.Error(err))
-
-				return nil
-			}
-
-			if activity != nil && len(activity.Actions) > 0 {
-				zap.L().Info("successfully transformed task",
-					zap.String("task_id", task.ID()))
-			}
-
-			return activity
-		})
-	}
-
-	// Filter out activities that failed to transform or contain no actions
-	activities := lo.Filter(resultPool.Wait(), func(activity *activityx.Activity, _ int) bool {
-		return activity != nil && len(activity.Actions) > 0
-	})
-
-	zap.L().Info("task transformation completed",
-		zap.Int("total_tasks", tasks.Len()),
-		zap.Int("successful_activities", len(activities)))
-
-	// Deprecated: use meterTasksHistogram instead.
-	s.meterTasksCounter.Add(ctx, int64(tasks.Len()), meterTasksCounterAttributes)
-	checkpoint.IndexCount = int64(len(activities))
-
-	// Low priority for Ethereum protocol and Core worker.
-	// Prevent low priority worker from overwriting activities from high priority worker in database.
-	lowPriority := checkpoint.Network.Protocol() == network.EthereumProtocol && s.worker.Name() == decentralizedx.Core.String()
-
-	// Save activities and checkpoint to the database.
-	if err := s.databaseClient.SaveActivities(ctx, activities, lowPriority); err != nil {
-		return fmt.Errorf("save %d activities: %w", len(activities), err)
+	if err := s.databaseClient.WithTransaction(ctx, transactionFunction); err != nil {
+		return fmt.Errorf("roll back reorganization: %w", err)
 	}
 
-	zap.L().Info("successfully saved activities and checkpoint",
-		zap.Int("activity_count", len(activities)),
+	zap.L().Info("successfully rolled back activities of orphaned blocks",
 		zap.Any("checkpoint", checkpoint))
 
-	if err := s.databaseClient.SaveCheckpoint(ctx, &checkpoint); err != nil {
-		return fmt.Errorf("save checkpoint: %w", err)
-	}
-
-	// Record the time it takes to handle tasks.
-	duration := time.Since(taskTimer).Seconds()
-	s.meterTasksHistogram.Record(ctx, duration, meterTasksCounterAttributes)
-
-	// Push activities to the stream.
-	if s.streamClient != nil && len(activities) > 0 {
-		if err := s.streamClient.PushActivities(ctx, activities); err != nil {
-			return fmt.Errorf("publish %d activities: %w", len(activities), err)
-		}
-
-		zap.L().Debug("successfully pushed activities to stream",
-			zap.Int("activity_count", len(activities)))
-	}
-
 	return nil
 }
 
@@ -232,6 +163,10 @@ func (s *Server) initializeMeter() (err error) {
 		return fmt.Errorf("failed to observe meter LatestBlock: %w", err)
 	}
 
+	if s.meterQueueDepth, err = meter.Int64ObservableGauge("rss3_node_pipeline_queue_depth", metric.WithInt64Callback(s.queueDepthMetricHandler)); err != nil {
+		return fmt.Errorf("failed to observe meter QueueDepth: %w", err)
+	}
+
 	zap.L().Info("successfully initialized meters")
 
 	return nil
@@ -280,6 +215,24 @@ func (s *Server) currentBlockMetricHandler(ctx context.Context, observer metric.
 	return nil
 }
 
+// queueDepthMetricHandler observes the number of batches waiting in the queue of each pipeline stage.
+func (s *Server) queueDepthMetricHandler(_ context.Context, observer metric.Int64Observer) error {
+	queues := map[string]chan *batch{
+		stageTransform: s.transformQueue,
+		stageSave:      s.saveQueue,
+	}
+
+	for stage, queue := range queues {
+		observer.Observe(int64(len(queue)), metric.WithAttributes(
+			attribute.String("service", constant.Name),
+			attribute.String("worker", s.worker.Name()),
+			attribute.String("stage", stage),
+		))
+	}
+
+	return nil
+}
+
 // latestBlockMetricHandler gets the latest block height/number from the network rpc.
 func (s *Server) latestBlockMetricHandler(ctx context.Context, observer metric.Int64Observer) error {
 	go func() {
@@ -313,6 +266,48 @@ func (s *Server) latestBlockMetricHandler(ctx context.Context, observer metric.I
 	return nil
 }
 
+// newWorker creates the worker of the module.
+func newWorker(config *config.Module, databaseClient database.Client, redisClient rueidis.Client) (engine.Worker, error) {
+	switch config.Network.Protocol() {
+	case network.ArweaveProtocol, network.EthereumProtocol, network.FarcasterProtocol, network.NearProtocol:
+		worker, err := decentralizedWorker.New(config, databaseClient, redisClient)
+		if err != nil {
+			return nil, fmt.Errorf("new decentralized worker: %w", err)
+		}
+
+		zap.L().Debug("created decentralized worker",
+			zap.String("protocol", string(config.Network.Protocol())))
+
+		return worker, nil
+	case network.ActivityPubProtocol, network.ATProtocol:
+		worker, err := federatedWorker.New(config, databaseClient, redisClient)
+		if err != nil {
+			return nil, fmt.Errorf("new federated worker: %w", err)
+		}
+
+		zap.L().Debug("created federated worker")
+
+		return worker, nil
+	case network.RSSProtocol:
+		worker, err := rssWorker.New(config)
+		if err != nil {
+			return nil, fmt.Errorf("new rss worker: %w", err)
+		}
+
+		zap.L().Debug("created rss worker")
+
+		return worker, nil
+	default:
+		return nil, fmt.Errorf("unknown worker protocol: %s", config.Network.Protocol())
+	}
+}
+
+// isLowPriority returns true for the Core worker of the Ethereum protocol,
+// which must not overwrite activities from high priority workers in database.
+func isLowPriority(workerNetwork network.Network, worker string) bool {
+	return workerNetwork.Protocol() == network.EthereumProtocol && worker == decentralizedx.Core.String()
+}
+
 func NewServer(ctx context.Context, config *config.Module, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) (server *Server, err error) {
 	zap.L().Debug("creating new server instance",
 		zap.String("id", config.ID),
@@ -324,6 +319,11 @@ func NewServer(ctx context.Context, config *config.Module, databaseClient databa
 		databaseClient: databaseClient,
 		streamClient:   streamClient,
 		redisClient:    redisClient,
+		webhookMatcher: webhook.NewMatcher(databaseClient),
+		transformQueue: make(chan *batch, defaultQueueSize),
+		saveQueue:      make(chan *batch, defaultQueueSize),
+		saveAttempts:   defaultSaveAttempts,
+		saveRetryDelay: defaultSaveRetryDelay,
 	}
 
 	zap.L().Debug("initializing worker",
@@ -334,22 +334,8 @@ func NewServer(ctx context.Context, config *config.Module, databaseClient databa
 		zap.Any("params", config.Parameters))
 
 	// Initialize worker.
-	switch config.Network.Protocol() {
-	case network.ArweaveProtocol, network.EthereumProtocol, network.FarcasterProtocol, network.RSSProtocol, network.NearProtocol:
-		if instance.worker, err = decentralizedWorker.New(instance.config, databaseClient, instance.redisClient); err != nil {
-			return nil, fmt.Errorf("new decentralized worker: %w", err)
-		}
-
-		zap.L().Debug("created decentralized worker",
-			zap.String("protocol", string(config.Network.Protocol())))
-	case network.ActivityPubProtocol, network.ATProtocol:
-		if instance.worker, err = federatedWorker.New(instance.config, databaseClient, instance.redisClient); err != nil {
-			return nil, fmt.Errorf("new federated worker: %w", err)
-		}
-
-		zap.L().Debug("created federated worker")
-	default:
-		return nil, fmt.Errorf("unknown worker protocol: %s", config.Network.Protocol())
+	if instance.worker, err = newWorker(config, databaseClient, redisClient); err != nil {
+		return nil, err
 	}
 
 	zap.L().Info("worker initialized successfully",
@@ -390,6 +376,11 @@ func NewServer(ctx context.Context, config *config.Module, databaseClient databa
 		if err != nil {
 			return nil, fmt.Errorf("new near monitorClient: %w", err)
 		}
+	case network.RSSProtocol:
+		instance.monitorClient, err = monitor.NewRSSClient()
+		if err != nil {
+			return nil, fmt.Errorf("new rss monitorClient: %w", err)
+		}
 	}
 
 	zap.L().Debug("successfully created monitor client",
@@ -417,6 +408,8 @@ func NewServer(ctx context.Context, config *config.Module, databaseClient databa
 		zap.String("checkpoint.worker", checkpoint.Worker),
 		zap.Any("checkpoint.state", state))
 
+	instance.committedState = checkpoint.State
+
 	// Initialize protocol.
 	if instance.source, err = protocol.New(instance.config, instance.worker.Filter(), checkpoint, databaseClient, redisClient); err != nil {
 		return nil, fmt.Errorf("new protocol: %w", err)
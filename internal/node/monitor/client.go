@@ -256,6 +256,28 @@ func (c *atprotoClient) LatestState(_ context.Context) (uint64, uint64, error) {
 // NewAtprotoClient returns a new atproto client.
 func NewAtprotoClient() (Client, error) { return &atprotoClient{}, nil }
 
+// rssClient is a client implementation for rss.
+type rssClient struct{}
+
+// make sure client implements Client
+var _ Client = (*rssClient)(nil)
+
+// CurrentState returns the time the feeds were last polled, the feeds have no remote state to catch up with.
+func (c *rssClient) CurrentState(state CheckpointState) (uint64, uint64) {
+	return uint64(state.RSSState.PollTimestamp), 0
+}
+
+func (c *rssClient) TargetState(_ *config.Parameters) (uint64, uint64) {
+	return uint64(time.Now().Unix()), 0
+}
+
+func (c *rssClient) LatestState(_ context.Context) (uint64, uint64, error) {
+	return uint64(time.Now().Unix()), 0, nil
+}
+
+// NewRSSClient returns a new rss client.
+func NewRSSClient() (Client, error) { return &rssClient{}, nil }
+
 // getTargetBlockFromParam returns the target block number/height from the parameters.
 func getTargetBlockFromParam(param *config.Parameters) uint64 {
 	if param == nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/rss3-network/node/v2/config/parameter"
 	"github.com/rss3-network/node/v2/internal/engine/protocol/atproto"
 	"github.com/rss3-network/node/v2/internal/engine/protocol/farcaster"
+	"github.com/rss3-network/node/v2/internal/engine/protocol/rss"
 	workerx "github.com/rss3-network/node/v2/schema/worker"
 	"github.com/rss3-network/node/v2/schema/worker/decentralized"
 	"github.com/rss3-network/protocol-go/schema/network"
@@ -21,6 +22,8 @@ type AtprotoState atproto.State
 
 type FarcastState farcaster.State
 
+type RSSState rss.State
+
 type CheckpointState struct {
 	BlockHeight    uint64 `json:"block_height"`
 	BlockTimestamp uint64 `json:"block_timestamp"`
@@ -28,6 +31,7 @@ type CheckpointState struct {
 
 	AtprotoState
 	FarcastState
+	RSSState
 }
 
 type WorkerProgress struct {
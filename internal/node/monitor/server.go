@@ -113,6 +113,8 @@ func initNetworkClient(m *config.Module) (Client, error) {
 		client, err = NewNearClient(m.Endpoint)
 	case network.ATProtocol:
 		client, err = NewAtprotoClient()
+	case network.RSSProtocol:
+		client, err = NewRSSClient()
 	default:
 		return nil, fmt.Errorf("unsupported network protocol: %s", m.Network)
 	}
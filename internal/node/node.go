@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-playground/validator/v10"
@@ -21,7 +22,9 @@ import (
 	"github.com/rss3-network/node/v2/internal/node/component/ai"
 	"github.com/rss3-network/node/v2/internal/node/component/decentralized"
 	"github.com/rss3-network/node/v2/internal/node/component/federated"
+	"github.com/rss3-network/node/v2/internal/node/component/graphql"
 	"github.com/rss3-network/node/v2/internal/node/component/info"
+	authx "github.com/rss3-network/node/v2/internal/node/component/middleware"
 	"github.com/rss3-network/node/v2/internal/node/component/rss"
 	"github.com/rss3-network/node/v2/internal/node/middlewarex"
 	"github.com/rss3-network/node/v2/provider/ethereum/contract/vsl"
@@ -51,9 +54,14 @@ func (s *Core) Run(_ context.Context) error {
 }
 
 // NewCoreService initializes the core services required by the Core
-func NewCoreService(ctx context.Context, config *config.File, databaseClient database.Client, redisClient rueidis.Client, networkParamsCaller *vsl.NetworkParamsCaller, settlementCaller *vsl.SettlementCaller) *Core {
+func NewCoreService(ctx context.Context, config *config.File, databaseClient database.Client, redisClient rueidis.Client, networkParamsCaller *vsl.NetworkParamsCaller, settlementCaller *vsl.SettlementCaller) (*Core, error) {
 	apiServer := echo.New()
 
+	authenticator, err := authx.NewAuthenticator(config.Discovery.Server, redisClient)
+	if err != nil {
+		return nil, fmt.Errorf("new authenticator: %w", err)
+	}
+
 	node := Core{
 		apiServer:           apiServer,
 		components:          []*component.Component{},
@@ -77,7 +85,7 @@ func NewCoreService(ctx context.Context, config *config.File, databaseClient dat
 
 	aggComp := aggregator.Component{}
 
-	infoComponent := info.NewComponent(ctx, apiServer, config, databaseClient, redisClient, networkParamsCaller)
+	infoComponent := info.NewComponent(ctx, apiServer, config, databaseClient, redisClient, networkParamsCaller, authenticator)
 	{
 		var comp component.Component = infoComponent
 		node.components = append(node.components, &comp)
@@ -85,7 +93,7 @@ func NewCoreService(ctx context.Context, config *config.File, databaseClient dat
 	}
 
 	if config.Component.RSS != nil {
-		rssComponent := rss.NewComponent(ctx, apiServer, config)
+		rssComponent := rss.NewComponent(ctx, apiServer, config, databaseClient, authenticator)
 		{
 			var comp component.Component = rssComponent
 			node.components = append(node.components, &comp)
@@ -94,7 +102,7 @@ func NewCoreService(ctx context.Context, config *config.File, databaseClient dat
 	}
 
 	if config.Component.AI != nil {
-		aiComponent := ai.NewComponent(ctx, apiServer, config)
+		aiComponent := ai.NewComponent(ctx, apiServer, config, authenticator)
 		{
 			var comp component.Component = aiComponent
 			node.components = append(node.components, &comp)
@@ -103,7 +111,7 @@ func NewCoreService(ctx context.Context, config *config.File, databaseClient dat
 	}
 
 	if len(config.Component.Decentralized) > 0 {
-		decentralizedComponent := decentralized.NewComponent(ctx, apiServer, config, databaseClient, redisClient)
+		decentralizedComponent := decentralized.NewComponent(ctx, apiServer, config, databaseClient, redisClient, authenticator)
 		{
 			var comp component.Component = decentralizedComponent
 			node.components = append(node.components, &comp)
@@ -112,7 +120,7 @@ func NewCoreService(ctx context.Context, config *config.File, databaseClient dat
 	}
 
 	if len(config.Component.Federated) > 0 {
-		federatedComponent := federated.NewComponent(ctx, apiServer, config, databaseClient, redisClient)
+		federatedComponent := federated.NewComponent(ctx, apiServer, config, databaseClient, redisClient, authenticator)
 		{
 			var comp component.Component = federatedComponent
 			node.components = append(node.components, &comp)
@@ -120,7 +128,19 @@ func NewCoreService(ctx context.Context, config *config.File, databaseClient dat
 		}
 	}
 
-	docs.RegisterHandlers(apiServer, aggComp)
+	// The GraphQL API resolves the activities, workers and networks through the other components.
+	graphqlComponent, err := graphql.NewComponent(ctx, apiServer, config, databaseClient, redisClient, authenticator, aggComp)
+	if err != nil {
+		return nil, fmt.Errorf("new graphql component: %w", err)
+	}
+
+	{
+		var comp component.Component = graphqlComponent
+		node.components = append(node.components, &comp)
+	}
+
+	// The generated routes are not registered through the groups of the components, so they are authenticated by the router.
+	docs.RegisterHandlers(&authenticatedRouter{Echo: apiServer, authenticator: authenticator}, aggComp)
 
 	// Generate openapi.json
 	apiServer.GET("/openapi.json", func(c echo.Context) error {
@@ -138,7 +158,39 @@ func NewCoreService(ctx context.Context, config *config.File, databaseClient dat
 
 	zap.L().Info("Core service initialization completed")
 
-	return &node
+	return &node, nil
+}
+
+// authenticatedRouter registers the routes with the authentication of the scopes of their components.
+type authenticatedRouter struct {
+	*echo.Echo
+	authenticator *authx.Authenticator
+}
+
+func (r *authenticatedRouter) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.Echo.GET(path, h, append(r.middlewares(path), m...)...)
+}
+
+func (r *authenticatedRouter) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.Echo.POST(path, h, append(r.middlewares(path), m...)...)
+}
+
+// middlewares returns the authentication of the component the path belongs to, the info routes are public.
+func (r *authenticatedRouter) middlewares(path string) []echo.MiddlewareFunc {
+	scopes := map[string]authx.Scope{
+		"/" + decentralized.Name + "/": authx.ScopeDecentralized,
+		"/" + federated.Name + "/":     authx.ScopeFederated,
+		"/" + rss.Name + "/":           authx.ScopeRSS,
+		"/" + ai.Name + "/":            authx.ScopeAI,
+	}
+
+	for prefix, scope := range scopes {
+		if strings.HasPrefix(path, prefix) {
+			return []echo.MiddlewareFunc{r.authenticator.Middleware(scope)}
+		}
+	}
+
+	return nil
 }
 
 // CheckParams checks the network parameters and settlement tasks
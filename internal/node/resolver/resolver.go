@@ -0,0 +1,411 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/provider/ethereum"
+	"github.com/rss3-network/node/v2/provider/ethereum/contract/crossbell"
+	"github.com/rss3-network/node/v2/provider/ethereum/contract/crossbell/character"
+	"github.com/rss3-network/node/v2/provider/ethereum/contract/ens"
+	"github.com/rss3-network/node/v2/provider/ethereum/contract/lens"
+	"github.com/rss3-network/node/v2/schema/worker/decentralized"
+	"github.com/rss3-network/node/v2/schema/worker/federated"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	SuffixENS       = ".eth"
+	SuffixFarcaster = ".fc"
+	SuffixLens      = ".lens"
+	SuffixCrossbell = ".csb"
+
+	// cacheExpiration is the expiration of a resolved name in the cache.
+	cacheExpiration = time.Hour
+	// cacheMissExpiration is the expiration of an unresolvable name in the cache,
+	// it is shorter so that newly registered names are picked up soon.
+	cacheMissExpiration = 5 * time.Minute
+)
+
+var (
+	// ErrUnsupportedName is returned if a name does not belong to any supported name service.
+	ErrUnsupportedName = errors.New("unsupported name")
+	// ErrUnresolvableName is returned if a name is not registered or has no address.
+	ErrUnresolvableName = errors.New("unresolvable name")
+	// ErrUnavailableService is returned if the endpoint required by the name service is not configured.
+	ErrUnavailableService = errors.New("unavailable name service")
+)
+
+// Result is a name resolved by a name service.
+type Result struct {
+	Name     string `json:"name"`
+	Platform string `json:"platform"`
+	// Address is an EVM address for ENS, Farcaster, Lens and Crossbell names, and a DID for Bluesky handles.
+	Address string `json:"address"`
+}
+
+// Resolver resolves ENS names, Farcaster usernames, Lens and Crossbell handles, and Bluesky handles.
+type Resolver struct {
+	databaseClient  database.Client
+	redisClient     rueidis.Client
+	ethereumClient  ethereum.Client
+	polygonClient   ethereum.Client
+	crossbellClient ethereum.Client
+}
+
+// Resolve resolves a name into the address it points to, an address is returned as is.
+func (r *Resolver) Resolve(ctx context.Context, name string) (*Result, error) {
+	if common.IsHexAddress(name) {
+		return &Result{
+			Name:    name,
+			Address: common.HexToAddress(name).String(),
+		}, nil
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	resolve, err := r.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if result, found := r.loadCache(ctx, name); found {
+		if result == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnresolvableName, name)
+		}
+
+		return result, nil
+	}
+
+	result, err := resolve(ctx, name)
+	if err != nil && !errors.Is(err, ErrUnresolvableName) {
+		return nil, err
+	}
+
+	r.saveCache(ctx, name, result)
+
+	return result, err
+}
+
+// IsAddressName returns whether a name belongs to a name service that resolves it into an EVM address,
+// that is an ENS name, a Farcaster username, or a Lens or Crossbell handle.
+func IsAddressName(name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	return lo.ContainsBy([]string{SuffixENS, SuffixFarcaster, SuffixLens, SuffixCrossbell}, func(suffix string) bool {
+		return strings.HasSuffix(name, suffix) && len(name) > len(suffix)
+	})
+}
+
+// lookup returns the function that resolves a name by its suffix.
+func (r *Resolver) lookup(name string) (func(ctx context.Context, name string) (*Result, error), error) {
+	switch {
+	case strings.HasSuffix(name, SuffixENS) && len(name) > len(SuffixENS):
+		return r.resolveENS, nil
+	case strings.HasSuffix(name, SuffixFarcaster) && len(name) > len(SuffixFarcaster):
+		return r.resolveFarcaster, nil
+	case strings.HasSuffix(name, SuffixLens) && len(name) > len(SuffixLens):
+		return r.resolveLens, nil
+	case strings.HasSuffix(name, SuffixCrossbell) && len(name) > len(SuffixCrossbell):
+		return r.resolveCrossbell, nil
+	case strings.Contains(strings.Trim(name, "."), "."):
+		// Other domain names are treated as Bluesky handles.
+		return r.resolveBluesky, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedName, name)
+	}
+}
+
+// resolveENS resolves an ENS name with the resolver set for the name in the ENS registry.
+func (r *Resolver) resolveENS(ctx context.Context, name string) (*Result, error) {
+	if r.ethereumClient == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnavailableService, decentralized.PlatformENS)
+	}
+
+	node := ens.NameHash(name)
+
+	registry, err := ens.NewENSRegistryCaller(ens.AddressENSRegistry, r.ethereumClient)
+	if err != nil {
+		return nil, fmt.Errorf("new ens registry caller: %w", err)
+	}
+
+	resolverAddress, err := registry.Resolver(&bind.CallOpts{Context: ctx}, node)
+	if err != nil {
+		return nil, fmt.Errorf("find resolver of %s: %w", name, err)
+	}
+
+	if resolverAddress == (common.Address{}) {
+		return nil, fmt.Errorf("%w: %s", ErrUnresolvableName, name)
+	}
+
+	// The addr function is shared by the public resolvers and any resolver that supports EIP-137.
+	resolver, err := ens.NewPublicResolverV2Caller(resolverAddress, r.ethereumClient)
+	if err != nil {
+		return nil, fmt.Errorf("new resolver caller: %w", err)
+	}
+
+	address, err := resolver.Addr(&bind.CallOpts{Context: ctx}, node)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s with resolver %s: %w", name, resolverAddress, err)
+	}
+
+	if address == (common.Address{}) {
+		return nil, fmt.Errorf("%w: %s", ErrUnresolvableName, name)
+	}
+
+	return &Result{
+		Name:     name,
+		Platform: decentralized.PlatformENS.String(),
+		Address:  address.String(),
+	}, nil
+}
+
+// resolveFarcaster resolves a Farcaster username with the indexed profiles, the first
+// verified address is preferred over the custody address.
+func (r *Resolver) resolveFarcaster(ctx context.Context, name string) (*Result, error) {
+	profile, err := r.databaseClient.LoadDatasetFarcasterProfileByUsername(ctx, strings.TrimSuffix(name, SuffixFarcaster))
+	if err != nil {
+		return nil, fmt.Errorf("load farcaster profile: %w", err)
+	}
+
+	if profile == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnresolvableName, name)
+	}
+
+	address, found := lo.Find(append(profile.EthAddresses, profile.CustodyAddress), common.IsHexAddress)
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnresolvableName, name)
+	}
+
+	return &Result{
+		Name:     name,
+		Platform: decentralized.PlatformFarcaster.String(),
+		Address:  common.HexToAddress(address).String(),
+	}, nil
+}
+
+// ReverseFarcaster resolves an address into the usernames of the Farcaster profiles that have verified it.
+func (r *Resolver) ReverseFarcaster(ctx context.Context, address string) ([]*Result, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedName, address)
+	}
+
+	address = common.HexToAddress(address).String()
+
+	profiles, err := r.databaseClient.LoadDatasetFarcasterProfilesByAddress(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("load farcaster profiles: %w", err)
+	}
+
+	results := make([]*Result, 0, len(profiles))
+
+	for _, profile := range profiles {
+		// The profiles without a username have no name to be resolved from.
+		if profile.Username == "" {
+			continue
+		}
+
+		results = append(results, &Result{
+			Name:     profile.Username + SuffixFarcaster,
+			Platform: decentralized.PlatformFarcaster.String(),
+			Address:  address,
+		})
+	}
+
+	return results, nil
+}
+
+// resolveLens resolves a Lens v2 handle into the owner of the profile linked to the handle.
+func (r *Resolver) resolveLens(ctx context.Context, name string) (*Result, error) {
+	if r.polygonClient == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnavailableService, decentralized.PlatformLens)
+	}
+
+	handleCaller, err := lens.NewV2LensHandleCaller(lens.AddressV2LensHandle, r.polygonClient)
+	if err != nil {
+		return nil, fmt.Errorf("new lens handle caller: %w", err)
+	}
+
+	// The token id of a handle is derived from the local name, the call does not fail if it is not minted.
+	handleID, err := handleCaller.GetTokenId(&bind.CallOpts{Context: ctx}, strings.TrimSuffix(name, SuffixLens))
+	if err != nil {
+		return nil, fmt.Errorf("get token id of %s: %w", name, err)
+	}
+
+	registryCaller, err := lens.NewV2HandleRegistryCaller(lens.AddressV2ProfileHandleRegistry, r.polygonClient)
+	if err != nil {
+		return nil, fmt.Errorf("new lens handle registry caller: %w", err)
+	}
+
+	profileID, err := registryCaller.Resolve(&bind.CallOpts{Context: ctx}, handleID)
+	if err != nil || profileID == nil || profileID.Sign() == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnresolvableName, name)
+	}
+
+	hubCaller, err := lens.NewV2LensHubCaller(lens.AddressLensProtocol, r.polygonClient)
+	if err != nil {
+		return nil, fmt.Errorf("new lens hub caller: %w", err)
+	}
+
+	owner, err := hubCaller.OwnerOf(&bind.CallOpts{Context: ctx}, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("get owner of profile %s: %w", profileID, err)
+	}
+
+	return &Result{
+		Name:     name,
+		Platform: decentralized.PlatformLens.String(),
+		Address:  owner.String(),
+	}, nil
+}
+
+// resolveCrossbell resolves a Crossbell handle into the owner of the character.
+func (r *Resolver) resolveCrossbell(ctx context.Context, name string) (*Result, error) {
+	if r.crossbellClient == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnavailableService, decentralized.PlatformCrossbell)
+	}
+
+	characterCaller, err := character.NewCharacterCaller(crossbell.AddressWeb3Entry, r.crossbellClient)
+	if err != nil {
+		return nil, fmt.Errorf("new character caller: %w", err)
+	}
+
+	// Crossbell handles are registered without the suffix.
+	result, err := characterCaller.GetCharacterByHandle(&bind.CallOpts{Context: ctx}, strings.TrimSuffix(name, SuffixCrossbell))
+	if err != nil {
+		return nil, fmt.Errorf("get character of %s: %w", name, err)
+	}
+
+	if result.CharacterId == nil || result.CharacterId.Sign() == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnresolvableName, name)
+	}
+
+	owner, err := characterCaller.OwnerOf(&bind.CallOpts{Context: ctx}, result.CharacterId)
+	if err != nil {
+		return nil, fmt.Errorf("get owner of character %s: %w", result.CharacterId, err)
+	}
+
+	return &Result{
+		Name:     name,
+		Platform: decentralized.PlatformCrossbell.String(),
+		Address:  owner.String(),
+	}, nil
+}
+
+// resolveBluesky resolves a Bluesky handle into the DID with the indexed profiles.
+func (r *Resolver) resolveBluesky(ctx context.Context, name string) (*Result, error) {
+	profiles, err := r.databaseClient.LoadDatasetBlueskyProfiles(ctx, model.QueryBlueskyProfiles{
+		Handles: []string{name},
+		Limit:   lo.ToPtr(1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load bluesky profiles: %w", err)
+	}
+
+	if len(profiles) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnresolvableName, name)
+	}
+
+	return &Result{
+		Name:     name,
+		Platform: federated.PlatformBluesky.String(),
+		Address:  profiles[0].DID,
+	}, nil
+}
+
+// loadCache loads a name from the cache, a nil result is returned if the name is cached as unresolvable.
+func (r *Resolver) loadCache(ctx context.Context, name string) (*Result, bool) {
+	if r.redisClient == nil {
+		return nil, false
+	}
+
+	command := r.redisClient.B().Get().Key(buildNameCacheKey(name)).Build()
+
+	data, err := r.redisClient.Do(ctx, command).AsBytes()
+	if err != nil {
+		if !errors.Is(err, rueidis.Nil) {
+			zap.L().Warn("failed to load resolved name from cache", zap.String("name", name), zap.Error(err))
+		}
+
+		return nil, false
+	}
+
+	var result *Result
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, false
+	}
+
+	return result, true
+}
+
+// saveCache saves a name to the cache, failures are only logged since the cache is an optimization.
+func (r *Resolver) saveCache(ctx context.Context, name string, result *Result) {
+	if r.redisClient == nil {
+		return
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+
+	command := r.redisClient.B().Set().
+		Key(buildNameCacheKey(name)).
+		Value(rueidis.BinaryString(data)).
+		Ex(lo.Ternary(result == nil, cacheMissExpiration, cacheExpiration)).
+		Build()
+
+	if err := r.redisClient.Do(ctx, command).Error(); err != nil {
+		zap.L().Warn("failed to save resolved name to cache", zap.String("name", name), zap.Error(err))
+	}
+}
+
+// buildNameCacheKey builds the cache key for a name
+func buildNameCacheKey(name string) string {
+	return fmt.Sprintf("resolver:name:%s", name)
+}
+
+// NewResolver creates a resolver, the name services whose endpoints are missing from the configuration are disabled.
+func NewResolver(ctx context.Context, endpoints map[string]config.Endpoint, databaseClient database.Client, redisClient rueidis.Client) *Resolver {
+	instance := Resolver{
+		databaseClient: databaseClient,
+		redisClient:    redisClient,
+	}
+
+	dial := func(network network.Network) ethereum.Client {
+		endpoint, found := endpoints[network.String()]
+		if !found {
+			zap.L().Info("name service endpoint is not configured", zap.String("network", network.String()))
+
+			return nil
+		}
+
+		client, err := ethereum.Dial(ctx, endpoint.URL, endpoint.BuildEthereumOptions()...)
+		if err != nil {
+			zap.L().Error("failed to dial name service endpoint", zap.String("network", network.String()), zap.Error(err))
+
+			return nil
+		}
+
+		return client
+	}
+
+	instance.ethereumClient = dial(network.Ethereum)
+	instance.polygonClient = dial(network.Polygon)
+	instance.crossbellClient = dial(network.Crossbell)
+
+	return &instance
+}
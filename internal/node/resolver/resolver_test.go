@@ -0,0 +1,192 @@
+package resolver_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/dialer"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/node/resolver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	databaseClient, err := dialer.Dial(ctx, &config.Database{
+		Driver: database.DriverSQLite,
+		URI:    filepath.Join(t.TempDir(), "node.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, databaseClient.Migrate(ctx))
+
+	require.NoError(t, databaseClient.SaveDatasetFarcasterProfile(ctx, &model.Profile{
+		Fid:            3,
+		Username:       "dwr",
+		CustodyAddress: "0x6b0bda3f2ffed5efc83fa8c024acff1dd45793f1",
+		EthAddresses:   []string{"0xd7029bdea1c17493893aafe29aad69ef892b8ff2"},
+	}))
+
+	require.NoError(t, databaseClient.SaveDatasetFarcasterProfile(ctx, &model.Profile{
+		Fid:            14142,
+		Username:       "custody",
+		CustodyAddress: "0x8888888198fbdc8c017870cc5d3c96d0cf15c4f0",
+	}))
+
+	require.NoError(t, databaseClient.SaveDatasetBlueskyProfiles(ctx, []*model.BlueskyProfile{
+		{
+			DID:       "did:plc:ewvi7nxzyoun6zhxrhs64oiz",
+			Handle:    "atproto.com",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}))
+
+	// No endpoints are configured, so the name services backed by contracts are unavailable.
+	instance := resolver.NewResolver(ctx, nil, databaseClient, nil)
+
+	testcases := []struct {
+		name      string
+		input     string
+		want      *resolver.Result
+		wantError error
+	}{
+		{
+			name:  "Address",
+			input: "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+			want: &resolver.Result{
+				Name:    "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+				Address: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+			},
+		},
+		{
+			name:  "Farcaster Verified Address",
+			input: "DWR.fc",
+			want: &resolver.Result{
+				Name:     "dwr.fc",
+				Platform: "Farcaster",
+				Address:  "0xD7029BDEa1c17493893AAfE29AAD69EF892B8ff2",
+			},
+		},
+		{
+			name:  "Farcaster Custody Address",
+			input: "custody.fc",
+			want: &resolver.Result{
+				Name:     "custody.fc",
+				Platform: "Farcaster",
+				Address:  "0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0",
+			},
+		},
+		{
+			name:      "Farcaster Unknown Username",
+			input:     "unknown.fc",
+			wantError: resolver.ErrUnresolvableName,
+		},
+		{
+			name:  "Bluesky Handle",
+			input: "atproto.com",
+			want: &resolver.Result{
+				Name:     "atproto.com",
+				Platform: "Bluesky",
+				Address:  "did:plc:ewvi7nxzyoun6zhxrhs64oiz",
+			},
+		},
+		{
+			name:      "ENS Without Endpoint",
+			input:     "vitalik.eth",
+			wantError: resolver.ErrUnavailableService,
+		},
+		{
+			name:      "Lens Without Endpoint",
+			input:     "stani.lens",
+			wantError: resolver.ErrUnavailableService,
+		},
+		{
+			name:      "Unsupported Name",
+			input:     "vitalik",
+			wantError: resolver.ErrUnsupportedName,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := instance.Resolve(ctx, testcase.input)
+			if testcase.wantError != nil {
+				require.ErrorIs(t, err, testcase.wantError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testcase.want, result)
+		})
+	}
+}
+
+func TestReverseFarcaster(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	databaseClient, err := dialer.Dial(ctx, &config.Database{
+		Driver: database.DriverSQLite,
+		URI:    filepath.Join(t.TempDir(), "node.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, databaseClient.Migrate(ctx))
+
+	require.NoError(t, databaseClient.SaveDatasetFarcasterProfile(ctx, &model.Profile{
+		Fid:            3,
+		Username:       "dwr",
+		CustodyAddress: "0x6B0bDA3f2FFEd5EfC83fa8c024acfF1Dd45793f1",
+		EthAddresses:   []string{"0xD7029BDEa1c17493893AAfE29AAD69EF892B8ff2"},
+	}))
+
+	instance := resolver.NewResolver(ctx, nil, databaseClient, nil)
+
+	results, err := instance.ReverseFarcaster(ctx, "0xd7029bdea1c17493893aafe29aad69ef892b8ff2")
+	require.NoError(t, err)
+	require.Equal(t, []*resolver.Result{
+		{
+			Name:     "dwr.fc",
+			Platform: "Farcaster",
+			Address:  "0xD7029BDEa1c17493893AAfE29AAD69EF892B8ff2",
+		},
+	}, results)
+
+	// The custody address is not a verified address.
+	results, err = instance.ReverseFarcaster(ctx, "0x6B0bDA3f2FFEd5EfC83fa8c024acfF1Dd45793f1")
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	_, err = instance.ReverseFarcaster(ctx, "dwr.fc")
+	require.ErrorIs(t, err, resolver.ErrUnsupportedName)
+}
+
+func TestIsAddressName(t *testing.T) {
+	t.Parallel()
+
+	for name, want := range map[string]bool{
+		"vitalik.eth":  true,
+		"dwr.fc":       true,
+		"stani.lens":   true,
+		"Henry.CSB":    true,
+		".eth":         false,
+		"atproto.com":  false,
+		"x.near":       false,
+		"0x6b0bda3f2f": false,
+		"u-ncPD8ug3VY_RUC14oqqWu0IAeaSKtIz-Jzm9fV1rM": false,
+	} {
+		require.Equal(t, want, resolver.IsAddressName(name), name)
+	}
+}
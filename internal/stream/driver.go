@@ -5,4 +5,23 @@ type Driver string
 const (
 	DriverKafka  Driver = "kafka"
 	DriverPulsar Driver = "pulsar"
+	DriverNATS   Driver = "nats"
+	DriverRedis  Driver = "redis"
+)
+
+// Key is the field of activities used as the message key, messages with the same key are written to the same partition.
+type Key string
+
+const (
+	KeyID      Key = "id"
+	KeyOwner   Key = "owner"
+	KeyNetwork Key = "network"
+)
+
+// Encoding is the format of activities written to the stream.
+type Encoding string
+
+const (
+	EncodingJSON Encoding = "json"
+	EncodingAvro Encoding = "avro"
 )
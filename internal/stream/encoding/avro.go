@@ -0,0 +1,237 @@
+package encoding
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/hamba/avro"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/samber/lo"
+)
+
+// avroMagicByte is the first byte of the schema registry wire format, followed by the 4-byte schema ID.
+const avroMagicByte = byte(0)
+
+// activitySchema is the Avro schema of activities, the metadata of actions varies by type and is encoded as JSON.
+const activitySchema = `{
+  "type": "record",
+  "name": "Activity",
+  "namespace": "io.rss3.node",
+  "fields": [
+    {"name": "id", "type": "string"},
+    {"name": "owner", "type": "string"},
+    {"name": "network", "type": "string"},
+    {"name": "index", "type": "long"},
+    {"name": "from", "type": "string"},
+    {"name": "to", "type": "string"},
+    {"name": "tag", "type": "string"},
+    {"name": "type", "type": "string"},
+    {"name": "platform", "type": "string"},
+    {"name": "fee_address", "type": "string", "default": ""},
+    {"name": "fee_amount", "type": "string", "default": ""},
+    {"name": "fee_decimal", "type": "long", "default": 0},
+    {"name": "total_actions", "type": "long"},
+    {"name": "actions", "type": {"type": "array", "items": {
+      "type": "record",
+      "name": "Action",
+      "fields": [
+        {"name": "tag", "type": "string"},
+        {"name": "type", "type": "string"},
+        {"name": "platform", "type": "string"},
+        {"name": "from", "type": "string"},
+        {"name": "to", "type": "string"},
+        {"name": "metadata", "type": "string"},
+        {"name": "related_urls", "type": {"type": "array", "items": "string"}}
+      ]
+    }}},
+    {"name": "direction", "type": "string", "default": ""},
+    {"name": "success", "type": "boolean"},
+    {"name": "timestamp", "type": "long"}
+  ]
+}`
+
+type avroActivity struct {
+	ID           string       `avro:"id"`
+	Owner        string       `avro:"owner"`
+	Network      string       `avro:"network"`
+	Index        int64        `avro:"index"`
+	From         string       `avro:"from"`
+	To           string       `avro:"to"`
+	Tag          string       `avro:"tag"`
+	Type         string       `avro:"type"`
+	Platform     string       `avro:"platform"`
+	FeeAddress   string       `avro:"fee_address"`
+	FeeAmount    string       `avro:"fee_amount"`
+	FeeDecimal   int64        `avro:"fee_decimal"`
+	TotalActions int64        `avro:"total_actions"`
+	Actions      []avroAction `avro:"actions"`
+	Direction    string       `avro:"direction"`
+	Success      bool         `avro:"success"`
+	Timestamp    int64        `avro:"timestamp"`
+}
+
+type avroAction struct {
+	Tag         string   `avro:"tag"`
+	Type        string   `avro:"type"`
+	Platform    string   `avro:"platform"`
+	From        string   `avro:"from"`
+	To          string   `avro:"to"`
+	Metadata    string   `avro:"metadata"`
+	RelatedURLs []string `avro:"related_urls"`
+}
+
+var _ Encoder = (*avroEncoder)(nil)
+
+// avroEncoder encodes activities with the schema registry wire format,
+// the schema is registered under the <topic>-value subject the first time a topic is written.
+type avroEncoder struct {
+	schema         avro.Schema
+	schemaRegistry string
+	httpClient     *http.Client
+	schemaIDs      sync.Map
+}
+
+func (e *avroEncoder) Encode(ctx context.Context, topic string, activity *activityx.Activity) ([]byte, error) {
+	schemaID, err := e.loadSchemaID(ctx, topic)
+	if err != nil {
+		return nil, fmt.Errorf("load schema id: %w", err)
+	}
+
+	value, err := buildAvroActivity(activity)
+	if err != nil {
+		return nil, fmt.Errorf("build avro activity: %w", err)
+	}
+
+	data, err := avro.Marshal(e.schema, value)
+	if err != nil {
+		return nil, fmt.Errorf("marshal avro activity: %w", err)
+	}
+
+	buffer := bytes.NewBuffer(make([]byte, 0, 5+len(data)))
+	buffer.WriteByte(avroMagicByte)
+	_ = binary.Write(buffer, binary.BigEndian, schemaID)
+	buffer.Write(data)
+
+	return buffer.Bytes(), nil
+}
+
+// loadSchemaID registers the schema of the topic, the schema registry returns the existing ID if it has been registered.
+func (e *avroEncoder) loadSchemaID(ctx context.Context, topic string) (uint32, error) {
+	if schemaID, ok := e.schemaIDs.Load(topic); ok {
+		return schemaID.(uint32), nil
+	}
+
+	body, err := json.Marshal(map[string]string{"schema": e.schema.String()})
+	if err != nil {
+		return 0, err
+	}
+
+	endpoint, err := url.JoinPath(e.schemaRegistry, "subjects", topic+"-value", "versions")
+	if err != nil {
+		return 0, fmt.Errorf("invalid schema registry endpoint: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("new request: %w", err)
+	}
+
+	request.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+
+	response, err := e.httpClient.Do(request)
+	if err != nil {
+		return 0, fmt.Errorf("register schema: %w", err)
+	}
+
+	defer lo.Try(response.Body.Close)
+
+	if response.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("register schema: unexpected status code: %d", response.StatusCode)
+	}
+
+	var result struct {
+		ID uint32 `json:"id"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("decode schema registry response: %w", err)
+	}
+
+	e.schemaIDs.Store(topic, result.ID)
+
+	return result.ID, nil
+}
+
+func buildAvroActivity(activity *activityx.Activity) (*avroActivity, error) {
+	value := avroActivity{
+		ID:           activity.ID,
+		Owner:        activity.Owner,
+		Network:      activity.Network.String(),
+		Index:        int64(activity.Index),
+		From:         activity.From,
+		To:           activity.To,
+		Tag:          activity.Tag.String(),
+		Platform:     activity.Platform,
+		TotalActions: int64(activity.TotalActions),
+		Actions:      make([]avroAction, 0, len(activity.Actions)),
+		Success:      activity.Status,
+		Timestamp:    int64(activity.Timestamp),
+	}
+
+	if activity.Type != nil {
+		value.Type = activity.Type.Name()
+	}
+
+	if activity.Fee != nil {
+		value.FeeAddress = lo.FromPtr(activity.Fee.Address)
+		value.FeeAmount = activity.Fee.Amount.String()
+		value.FeeDecimal = int64(activity.Fee.Decimal)
+	}
+
+	if activity.Direction != 0 {
+		value.Direction = activity.Direction.String()
+	}
+
+	for _, action := range activity.Actions {
+		metadata, err := json.Marshal(action.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("marshal metadata: %w", err)
+		}
+
+		avroAction := avroAction{
+			Tag:         action.Tag.String(),
+			Platform:    action.Platform,
+			From:        action.From,
+			To:          action.To,
+			Metadata:    string(metadata),
+			RelatedURLs: lo.Ternary(action.RelatedURLs != nil, action.RelatedURLs, []string{}),
+		}
+
+		if action.Type != nil {
+			avroAction.Type = action.Type.Name()
+		}
+
+		value.Actions = append(value.Actions, avroAction)
+	}
+
+	return &value, nil
+}
+
+func newAvroEncoder(schemaRegistry string) (*avroEncoder, error) {
+	schema, err := avro.Parse(activitySchema)
+	if err != nil {
+		return nil, fmt.Errorf("parse activity schema: %w", err)
+	}
+
+	return &avroEncoder{
+		schema:         schema,
+		schemaRegistry: schemaRegistry,
+		httpClient:     http.DefaultClient,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package encoding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/stream"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+)
+
+// Encoder encodes activities written to a topic.
+type Encoder interface {
+	Encode(ctx context.Context, topic string, activity *activityx.Activity) ([]byte, error)
+}
+
+var _ Encoder = (*jsonEncoder)(nil)
+
+type jsonEncoder struct{}
+
+func (e *jsonEncoder) Encode(_ context.Context, _ string, activity *activityx.Activity) ([]byte, error) {
+	return json.Marshal(activity)
+}
+
+func New(config *config.Stream) (Encoder, error) {
+	switch config.Encoding {
+	case stream.EncodingJSON:
+		return new(jsonEncoder), nil
+	case stream.EncodingAvro:
+		return newAvroEncoder(config.SchemaRegistry)
+	default:
+		return nil, fmt.Errorf("unsupported stream encoding %s", config.Encoding)
+	}
+}
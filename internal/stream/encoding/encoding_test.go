@@ -0,0 +1,128 @@
+package encoding_test
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hamba/avro"
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/stream"
+	"github.com/rss3-network/node/v2/internal/stream/encoding"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/stretchr/testify/require"
+)
+
+var activity = &activityx.Activity{
+	ID:      "0x5ffa607a127d63fb36827075493d1de06f58fc44710b9ffb887b2effe02d2b8b",
+	Owner:   "0x000000A52a03835517E9d193B3c27626e1Bc96b1",
+	Network: network.Ethereum,
+	Index:   3,
+	From:    "0x000000A52a03835517E9d193B3c27626e1Bc96b1",
+	To:      "0xA69babEF1cA67A37Ffaf7a485DfFF3382056e78C",
+	Tag:     tag.Transaction,
+	Type:    typex.TransactionTransfer,
+	Actions: []*activityx.Action{
+		{
+			Tag:      tag.Transaction,
+			Type:     typex.TransactionTransfer,
+			Platform: "Ethereum",
+			From:     "0x000000A52a03835517E9d193B3c27626e1Bc96b1",
+			To:       "0xA69babEF1cA67A37Ffaf7a485DfFF3382056e78C",
+			Metadata: &metadata.TransactionTransfer{Name: "Ethereum", Symbol: "ETH"},
+		},
+	},
+	TotalActions: 1,
+	Direction:    activityx.DirectionOut,
+	Status:       true,
+	Timestamp:    1708474559,
+}
+
+func TestJSONEncoder(t *testing.T) {
+	t.Parallel()
+
+	encoder, err := encoding.New(&config.Stream{Encoding: stream.EncodingJSON})
+	require.NoError(t, err)
+
+	data, err := encoder.Encode(context.Background(), "rss3.node.activities", activity)
+	require.NoError(t, err)
+
+	var result activityx.Activities
+	require.NoError(t, json.Unmarshal(append(append([]byte("["), data...), ']'), &result))
+	require.Len(t, result, 1)
+	require.Equal(t, activity.ID, result[0].ID)
+	require.Equal(t, activity.Type, result[0].Type)
+}
+
+func TestAvroEncoder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		registrations atomic.Int32
+		schema        atomic.Value
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, http.MethodPost, request.Method)
+		require.Equal(t, "/subjects/rss3.node.activities-value/versions", request.URL.Path)
+
+		var body struct {
+			Schema string `json:"schema"`
+		}
+
+		require.NoError(t, json.NewDecoder(request.Body).Decode(&body))
+
+		schema.Store(body.Schema)
+		registrations.Add(1)
+
+		_, _ = writer.Write([]byte(`{"id": 7}`))
+	}))
+	t.Cleanup(server.Close)
+
+	encoder, err := encoding.New(&config.Stream{Encoding: stream.EncodingAvro, SchemaRegistry: server.URL})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		data, err := encoder.Encode(context.Background(), "rss3.node.activities", activity)
+		require.NoError(t, err)
+
+		// The schema registry wire format is the magic byte, the schema ID and the Avro data.
+		require.Equal(t, byte(0), data[0])
+		require.Equal(t, uint32(7), binary.BigEndian.Uint32(data[1:5]))
+
+		parsed, err := avro.Parse(schema.Load().(string))
+		require.NoError(t, err)
+
+		var result map[string]any
+		require.NoError(t, avro.Unmarshal(parsed, data[5:], &result))
+
+		require.Equal(t, activity.ID, result["id"])
+		require.Equal(t, activity.Owner, result["owner"])
+		require.Equal(t, "ethereum", result["network"])
+		require.Equal(t, int64(3), result["index"])
+		require.Equal(t, "transaction", result["tag"])
+		require.Equal(t, "transfer", result["type"])
+		require.Equal(t, "out", result["direction"])
+		require.Equal(t, true, result["success"])
+		require.Equal(t, int64(activity.Timestamp), result["timestamp"])
+
+		actions, ok := result["actions"].([]any)
+		require.True(t, ok)
+		require.Len(t, actions, 1)
+
+		action := actions[0].(map[string]any)
+		require.Equal(t, "Ethereum", action["platform"])
+		require.JSONEq(t, `{"name": "Ethereum", "symbol": "ETH"}`, action["metadata"].(string))
+	}
+
+	// The schema ID is cached once the schema of the topic has been registered.
+	require.Equal(t, int32(1), registrations.Load())
+}
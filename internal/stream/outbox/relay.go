@@ -0,0 +1,101 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/stream"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchLimit   = 100
+)
+
+// Relay publishes the activities committed to the stream outbox, the outboxes are locked while being published
+// and deleted in the same transaction, so each committed batch is published by a single relay.
+// The delivery is at least once, the batch is published again if the relay fails to commit after publishing it,
+// so the consumers should deduplicate the activities by their IDs and networks.
+type Relay struct {
+	databaseClient database.Client
+	streamClient   stream.Client
+}
+
+func (r *Relay) Run(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			published, err := r.relay(ctx)
+			if err != nil {
+				zap.L().Error("failed to relay stream outboxes", zap.Error(err))
+			}
+
+			// Drain the outbox without waiting if there are more outboxes left.
+			timer.Reset(lo.Ternary(err == nil && published == defaultBatchLimit, 0, defaultPollInterval))
+		}
+	}
+}
+
+// relay publishes a batch of outboxes and returns the number of published outboxes.
+func (r *Relay) relay(ctx context.Context) (int, error) {
+	var published int
+
+	err := r.databaseClient.WithTransaction(ctx, func(ctx context.Context, client database.Client) error {
+		outboxes, err := client.LockStreamOutboxes(ctx, defaultBatchLimit)
+		if err != nil {
+			return fmt.Errorf("lock stream outboxes: %w", err)
+		}
+
+		if len(outboxes) == 0 {
+			return nil
+		}
+
+		activities := make([]*activityx.Activity, 0)
+		ids := make([]uint64, 0, len(outboxes))
+
+		for _, outbox := range outboxes {
+			activities = append(activities, outbox.Activities...)
+			ids = append(ids, outbox.ID)
+		}
+
+		if len(activities) > 0 {
+			if err := r.streamClient.PushActivities(ctx, activities); err != nil {
+				return fmt.Errorf("publish %d activities: %w", len(activities), err)
+			}
+		}
+
+		if err := client.DeleteStreamOutboxes(ctx, ids); err != nil {
+			return fmt.Errorf("delete %d stream outboxes: %w", len(ids), err)
+		}
+
+		published = len(outboxes)
+
+		zap.L().Debug("successfully relayed stream outboxes",
+			zap.Int("outbox_count", len(outboxes)),
+			zap.Int("activity_count", len(activities)))
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
+
+func NewRelay(databaseClient database.Client, streamClient stream.Client) *Relay {
+	return &Relay{
+		databaseClient: databaseClient,
+		streamClient:   streamClient,
+	}
+}
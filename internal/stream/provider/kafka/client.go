@@ -2,11 +2,13 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
+	"github.com/rss3-network/node/v2/config"
 	"github.com/rss3-network/node/v2/internal/stream"
+	"github.com/rss3-network/node/v2/internal/stream/encoding"
+	"github.com/rss3-network/node/v2/internal/stream/router"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
 	"github.com/twmb/franz-go/pkg/kadm"
 	"github.com/twmb/franz-go/pkg/kgo"
@@ -15,14 +17,20 @@ import (
 
 type Client struct {
 	kafkaClient *kgo.Client
-	topic       string
+	router      *router.Router
+	encoder     encoding.Encoder
 }
 
-func New(ctx context.Context, uri, topic string) (stream.Client, error) {
-	brokers := strings.Split(uri, ",")
+func New(ctx context.Context, config *config.Stream) (stream.Client, error) {
+	brokers := strings.Split(config.URI, ",")
 
 	if len(brokers) == 0 {
-		return nil, fmt.Errorf("invalid uri: %s", uri)
+		return nil, fmt.Errorf("invalid uri: %s", config.URI)
+	}
+
+	encoder, err := encoding.New(config)
+	if err != nil {
+		return nil, fmt.Errorf("new encoder: %w", err)
 	}
 
 	kafkaClient, err := kgo.NewClient([]kgo.Opt{kgo.SeedBrokers(brokers...)}...)
@@ -31,31 +39,54 @@ func New(ctx context.Context, uri, topic string) (stream.Client, error) {
 		return nil, fmt.Errorf("new kafka client: %w", err)
 	}
 
-	kafkaAdminClient := kadm.NewClient(kafkaClient)
+	activityRouter := router.New(config)
+
+	if err := createTopics(ctx, kadm.NewClient(kafkaClient), activityRouter.Topics(), config.Partitions, config.ReplicationFactor); err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		kafkaClient: kafkaClient,
+		router:      activityRouter,
+		encoder:     encoder,
+	}, nil
+}
 
+// createTopics creates the missing topics and adds partitions to the existing topics that have fewer partitions,
+// the replication factor of existing topics is left unchanged.
+func createTopics(ctx context.Context, kafkaAdminClient *kadm.Client, topics []string, partitions int32, replicationFactor int16) error {
 	topicDetails, err := kafkaAdminClient.ListTopics(ctx)
 
 	if err != nil {
-		return nil, fmt.Errorf("list topics: %w", err)
+		return fmt.Errorf("list topics: %w", err)
 	}
 
-	if _, exists := topicDetails[topic]; !exists {
-		if _, err = kafkaAdminClient.CreateTopic(ctx, 1, 1, nil, topic); err != nil {
-			return nil, fmt.Errorf("create %s topic: %w", topic, err)
+	for _, topic := range topics {
+		topicDetail, exists := topicDetails[topic]
+
+		if !exists {
+			if _, err = kafkaAdminClient.CreateTopic(ctx, partitions, replicationFactor, nil, topic); err != nil {
+				return fmt.Errorf("create %s topic: %w", topic, err)
+			}
+
+			continue
+		}
+
+		if current := int32(len(topicDetail.Partitions)); current < partitions {
+			if _, err = kafkaAdminClient.UpdatePartitions(ctx, int(partitions), topic); err != nil {
+				return fmt.Errorf("update %s topic partitions from %d to %d: %w", topic, current, partitions, err)
+			}
 		}
 	}
 
-	return &Client{
-		kafkaClient: kafkaClient,
-		topic:       topic,
-	}, nil
+	return nil
 }
 
 func (c *Client) PushActivities(ctx context.Context, activities []*activityx.Activity) error {
 	records := make([]*kgo.Record, 0, len(activities))
 
 	for _, activity := range activities {
-		record, err := c.encodeActivity(activity)
+		record, err := c.encodeActivity(ctx, activity)
 		if err != nil {
 			return fmt.Errorf("encode activity %s: %w", activity.ID, err)
 		}
@@ -74,15 +105,17 @@ func (c *Client) PushActivities(ctx context.Context, activities []*activityx.Act
 	return nil
 }
 
-func (c *Client) encodeActivity(activity *activityx.Activity) (*kgo.Record, error) {
-	value, err := json.Marshal(activity)
+func (c *Client) encodeActivity(ctx context.Context, activity *activityx.Activity) (*kgo.Record, error) {
+	topic := c.router.Topic(activity)
+
+	value, err := c.encoder.Encode(ctx, topic, activity)
 	if err != nil {
 		return nil, err
 	}
 
 	record := kgo.Record{
-		Topic: c.topic,
-		Key:   []byte(activity.ID),
+		Topic: topic,
+		Key:   c.router.Key(activity),
 		Value: value,
 	}
 
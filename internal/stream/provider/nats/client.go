@@ -0,0 +1,79 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rss3-network/node/v2/internal/stream"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"go.uber.org/zap"
+)
+
+type Client struct {
+	jetStream jetstream.JetStream
+	subject   string
+}
+
+func New(ctx context.Context, uri, subject string) (stream.Client, error) {
+	connection, err := nats.Connect(uri)
+	if err != nil {
+		return nil, fmt.Errorf("connect to nats: %w", err)
+	}
+
+	jetStream, err := jetstream.New(connection)
+	if err != nil {
+		return nil, fmt.Errorf("new jetstream: %w", err)
+	}
+
+	// The stream name can not contain dots, for example, rss3.node.activities is stored in the RSS3_NODE_ACTIVITIES stream.
+	streamConfig := jetstream.StreamConfig{
+		Name:     strings.ToUpper(strings.NewReplacer(".", "_", "*", "_", ">", "_").Replace(subject)),
+		Subjects: []string{subject},
+	}
+
+	if _, err := jetStream.CreateOrUpdateStream(ctx, streamConfig); err != nil {
+		return nil, fmt.Errorf("create %s stream: %w", streamConfig.Name, err)
+	}
+
+	return &Client{
+		jetStream: jetStream,
+		subject:   subject,
+	}, nil
+}
+
+func (c *Client) PushActivities(ctx context.Context, activities []*activityx.Activity) error {
+	futures := make([]jetstream.PubAckFuture, 0, len(activities))
+
+	for _, activity := range activities {
+		value, err := json.Marshal(activity)
+		if err != nil {
+			return fmt.Errorf("encode activity %s: %w", activity.ID, err)
+		}
+
+		// The message ID is used by JetStream to drop duplicated messages within the deduplication window.
+		future, err := c.jetStream.PublishAsync(c.subject, value, jetstream.WithMsgID(activity.ID))
+		if err != nil {
+			return fmt.Errorf("publish activity %s: %w", activity.ID, err)
+		}
+
+		futures = append(futures, future)
+	}
+
+	for _, future := range futures {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-future.Ok():
+		case err := <-future.Err():
+			return fmt.Errorf("push activities: %w", err)
+		}
+	}
+
+	zap.L().Info("pushed activities", zap.Int("messages", len(activities)))
+
+	return nil
+}
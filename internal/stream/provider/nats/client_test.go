@@ -0,0 +1,102 @@
+package nats_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	natsx "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/ory/dockertest/v3"
+	"github.com/rss3-network/node/v2/internal/stream/provider/nats"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	pool, container, uri, err := createContainer()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, pool.Purge(container))
+	})
+
+	client, err := nats.New(ctx, uri, "rss3.node.activities")
+	require.NoError(t, err)
+
+	activities := []*activityx.Activity{
+		{ID: "0x1", Network: network.Ethereum},
+		{ID: "0x2", Network: network.Ethereum},
+	}
+
+	require.NoError(t, client.PushActivities(ctx, activities))
+
+	// Pushing the same activities again is deduplicated by the message ID.
+	require.NoError(t, client.PushActivities(ctx, activities))
+
+	connection, err := natsx.Connect(uri)
+	require.NoError(t, err)
+	t.Cleanup(connection.Close)
+
+	jetStream, err := jetstream.New(connection)
+	require.NoError(t, err)
+
+	consumer, err := jetStream.OrderedConsumer(ctx, "RSS3_NODE_ACTIVITIES", jetstream.OrderedConsumerConfig{})
+	require.NoError(t, err)
+
+	batch, err := consumer.Fetch(len(activities)*2, jetstream.FetchMaxWait(time.Second))
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(activities))
+
+	for message := range batch.Messages() {
+		var value map[string]any
+
+		require.NoError(t, json.Unmarshal(message.Data(), &value))
+
+		ids = append(ids, value["id"].(string))
+	}
+
+	require.NoError(t, batch.Error())
+	require.Equal(t, []string{"0x1", "0x2"}, ids)
+}
+
+func createContainer() (pool *dockertest.Pool, container *dockertest.Resource, uri string, err error) {
+	if pool, err = dockertest.NewPool(""); err != nil {
+		return nil, nil, "", fmt.Errorf("create docker pool: %w", err)
+	}
+
+	container, err = pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "nats",
+		Tag:        "2.10",
+		Cmd:        []string{"-js"},
+	})
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("create nats container: %w", err)
+	}
+
+	uri = fmt.Sprintf("nats://127.0.0.1:%s", container.GetPort("4222/tcp"))
+
+	// Wait until the server accepts connections.
+	if err := pool.Retry(func() error {
+		connection, err := natsx.Connect(uri)
+		if err != nil {
+			return err
+		}
+
+		defer connection.Close()
+
+		return connection.Flush()
+	}); err != nil {
+		return nil, nil, "", fmt.Errorf("wait for nats container: %w", err)
+	}
+
+	return pool, container, uri, nil
+}
@@ -2,14 +2,29 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/rss3-network/node/v2/config"
 	"github.com/rss3-network/node/v2/internal/stream"
 	"github.com/rss3-network/node/v2/internal/stream/provider/kafka"
+	"github.com/rss3-network/node/v2/internal/stream/provider/nats"
+	"github.com/rss3-network/node/v2/internal/stream/provider/pulsar"
+	"github.com/rss3-network/node/v2/internal/stream/provider/redis"
 )
 
-const kafkaTopic = "rss3.node.activities"
-
+// New creates the stream client of the driver, routing, partitioning and encoding are only supported by Kafka,
+// the other drivers write JSON to the default topic.
 func New(ctx context.Context, config *config.Stream) (stream.Client, error) {
-	return kafka.New(ctx, config.URI, kafkaTopic)
+	switch config.Driver {
+	case stream.DriverKafka:
+		return kafka.New(ctx, config)
+	case stream.DriverPulsar:
+		return pulsar.New(ctx, config.URI, config.Topic)
+	case stream.DriverNATS:
+		return nats.New(ctx, config.URI, config.Topic)
+	case stream.DriverRedis:
+		return redis.New(ctx, config.URI, config.Topic)
+	default:
+		return nil, fmt.Errorf("unsupported stream driver %s", config.Driver)
+	}
 }
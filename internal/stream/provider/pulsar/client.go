@@ -0,0 +1,98 @@
+package pulsar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/rss3-network/node/v2/internal/stream"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"go.uber.org/zap"
+)
+
+type Client struct {
+	pulsarClient pulsar.Client
+	producer     pulsar.Producer
+}
+
+func New(_ context.Context, uri, topic string) (stream.Client, error) {
+	pulsarClient, err := pulsar.NewClient(pulsar.ClientOptions{
+		URL: uri,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("new pulsar client: %w", err)
+	}
+
+	// The topic is created automatically by the broker if it does not exist.
+	producer, err := pulsarClient.CreateProducer(pulsar.ProducerOptions{
+		Topic: topic,
+	})
+	if err != nil {
+		pulsarClient.Close()
+
+		return nil, fmt.Errorf("create %s producer: %w", topic, err)
+	}
+
+	return &Client{
+		pulsarClient: pulsarClient,
+		producer:     producer,
+	}, nil
+}
+
+func (c *Client) PushActivities(ctx context.Context, activities []*activityx.Activity) error {
+	var (
+		waitGroup sync.WaitGroup
+		mutex     sync.Mutex
+		errs      []error
+	)
+
+	for _, activity := range activities {
+		message, err := c.encodeActivity(activity)
+		if err != nil {
+			return fmt.Errorf("encode activity %s: %w", activity.ID, err)
+		}
+
+		waitGroup.Add(1)
+
+		c.producer.SendAsync(ctx, message, func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+			defer waitGroup.Done()
+
+			if err != nil {
+				mutex.Lock()
+				errs = append(errs, err)
+				mutex.Unlock()
+			}
+		})
+	}
+
+	if err := c.producer.FlushWithCtx(ctx); err != nil {
+		return fmt.Errorf("flush producer: %w", err)
+	}
+
+	waitGroup.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("push activities: %w", err)
+	}
+
+	zap.L().Info("pushed activities", zap.Int("messages", len(activities)))
+
+	return nil
+}
+
+func (c *Client) encodeActivity(activity *activityx.Activity) (*pulsar.ProducerMessage, error) {
+	value, err := json.Marshal(activity)
+	if err != nil {
+		return nil, err
+	}
+
+	message := pulsar.ProducerMessage{
+		Key:     activity.ID,
+		Payload: value,
+	}
+
+	return &message, nil
+}
@@ -0,0 +1,112 @@
+package pulsar_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	pulsarx "github.com/apache/pulsar-client-go/pulsar"
+	"github.com/ory/dockertest/v3"
+	"github.com/rss3-network/node/v2/internal/stream/provider/pulsar"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	pool, container, uri, err := createContainer()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, pool.Purge(container))
+	})
+
+	topic := "persistent://public/default/rss3.node.activities"
+
+	pulsarClient, err := pulsarx.NewClient(pulsarx.ClientOptions{URL: uri})
+	require.NoError(t, err)
+	t.Cleanup(pulsarClient.Close)
+
+	// Subscribe before pushing, so the consumer receives the activities from the start.
+	consumer, err := pulsarClient.Subscribe(pulsarx.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "test",
+		SubscriptionInitialPosition: pulsarx.SubscriptionPositionEarliest,
+	})
+	require.NoError(t, err)
+	t.Cleanup(consumer.Close)
+
+	client, err := pulsar.New(ctx, uri, topic)
+	require.NoError(t, err)
+
+	activities := []*activityx.Activity{
+		{ID: "0x1", Network: network.Ethereum},
+		{ID: "0x2", Network: network.Ethereum},
+	}
+
+	require.NoError(t, client.PushActivities(ctx, activities))
+
+	receiveContext, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	for _, activity := range activities {
+		message, err := consumer.Receive(receiveContext)
+		require.NoError(t, err)
+
+		var value map[string]any
+
+		require.NoError(t, json.Unmarshal(message.Payload(), &value))
+		require.Equal(t, activity.ID, message.Key())
+		require.Equal(t, activity.ID, value["id"])
+
+		require.NoError(t, consumer.Ack(message))
+	}
+}
+
+func createContainer() (pool *dockertest.Pool, container *dockertest.Resource, uri string, err error) {
+	if pool, err = dockertest.NewPool(""); err != nil {
+		return nil, nil, "", fmt.Errorf("create docker pool: %w", err)
+	}
+
+	// The standalone broker takes a while to start, so wait for it longer than the default.
+	pool.MaxWait = 3 * time.Minute
+
+	container, err = pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "apachepulsar/pulsar",
+		Tag:        "3.3.1",
+		Cmd:        []string{"bin/pulsar", "standalone"},
+	})
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("create pulsar container: %w", err)
+	}
+
+	uri = fmt.Sprintf("pulsar://127.0.0.1:%s", container.GetPort("6650/tcp"))
+
+	// Wait until the broker reports it is ready.
+	if err := pool.Retry(func() error {
+		response, err := http.Get(fmt.Sprintf("http://127.0.0.1:%s/admin/v2/brokers/health", container.GetPort("8080/tcp")))
+		if err != nil {
+			return err
+		}
+
+		defer lo.Try(response.Body.Close)
+
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected status code %d", response.StatusCode)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, "", fmt.Errorf("wait for pulsar container: %w", err)
+	}
+
+	return pool, container, uri, nil
+}
@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/internal/stream"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"go.uber.org/zap"
+)
+
+// defaultMaxLength is the approximate number of entries kept in the stream, the oldest entries are trimmed.
+const defaultMaxLength = 1_000_000
+
+type Client struct {
+	redisClient rueidis.Client
+	key         string
+}
+
+func New(_ context.Context, uri, key string) (stream.Client, error) {
+	option, err := rueidis.ParseURL(uri)
+	if err != nil {
+		return nil, fmt.Errorf("parse redis uri: %w", err)
+	}
+
+	// The client only appends entries, so the client-side caching is disabled for the servers without RESP3 support.
+	option.DisableCache = true
+
+	redisClient, err := rueidis.NewClient(option)
+	if err != nil {
+		return nil, fmt.Errorf("new redis client: %w", err)
+	}
+
+	return &Client{
+		redisClient: redisClient,
+		key:         key,
+	}, nil
+}
+
+func (c *Client) PushActivities(ctx context.Context, activities []*activityx.Activity) error {
+	commands := make(rueidis.Commands, 0, len(activities))
+
+	for _, activity := range activities {
+		value, err := json.Marshal(activity)
+		if err != nil {
+			return fmt.Errorf("encode activity %s: %w", activity.ID, err)
+		}
+
+		command := c.redisClient.B().Xadd().Key(c.key).Maxlen().Almost().Threshold(strconv.Itoa(defaultMaxLength)).Id("*").
+			FieldValue().FieldValue("id", activity.ID).FieldValue("activity", rueidis.BinaryString(value)).Build()
+
+		commands = append(commands, command)
+	}
+
+	for _, result := range c.redisClient.DoMulti(ctx, commands...) {
+		if err := result.Error(); err != nil {
+			return fmt.Errorf("push activities: %w", err)
+		}
+	}
+
+	zap.L().Info("pushed activities", zap.Int("entries", len(activities)))
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/internal/stream/provider/redis"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	server := miniredis.RunT(t)
+
+	client, err := redis.New(ctx, "redis://"+server.Addr(), "rss3.node.activities")
+	require.NoError(t, err)
+
+	activities := []*activityx.Activity{
+		{ID: "0x1", Network: network.Ethereum},
+		{ID: "0x2", Network: network.Ethereum},
+	}
+
+	require.NoError(t, client.PushActivities(ctx, activities))
+
+	redisClient, err := rueidis.NewClient(rueidis.ClientOption{InitAddress: []string{server.Addr()}, DisableCache: true})
+	require.NoError(t, err)
+	t.Cleanup(redisClient.Close)
+
+	entries, err := redisClient.Do(ctx, redisClient.B().Xrange().Key("rss3.node.activities").Start("-").End("+").Build()).AsXRange()
+	require.NoError(t, err)
+	require.Len(t, entries, len(activities))
+
+	for index, entry := range entries {
+		require.Equal(t, activities[index].ID, entry.FieldValues["id"])
+		require.Contains(t, entry.FieldValues["activity"], `"id":"`+activities[index].ID+`"`)
+	}
+}
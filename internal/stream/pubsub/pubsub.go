@@ -0,0 +1,216 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/rueidis"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"go.uber.org/zap"
+)
+
+const (
+	ChannelDecentralized = "activities:decentralized"
+	ChannelFederated     = "activities:federated"
+
+	// defaultBufferSize is the number of batches buffered for a subscriber before it is dropped as too slow.
+	defaultBufferSize = 64
+	// defaultReconnectDelay is the delay before the hub subscribes to Redis again after the connection is lost.
+	defaultReconnectDelay = time.Second
+)
+
+// ErrSlowSubscriber is the reason a subscription is closed if it does not keep up with the committed activities.
+var ErrSlowSubscriber = errors.New("subscriber is too slow to receive activities")
+
+// Channel returns the channel the activities of a protocol are published to, the activities
+// of protocols without a subscription endpoint are not published.
+func Channel(protocol network.Protocol) (string, bool) {
+	switch protocol {
+	case network.ArweaveProtocol, network.EthereumProtocol, network.FarcasterProtocol, network.NearProtocol:
+		return ChannelDecentralized, true
+	case network.ActivityPubProtocol, network.ATProtocol:
+		return ChannelFederated, true
+	default:
+		return "", false
+	}
+}
+
+// Publish publishes the activities committed by a worker to the Core instances, the activities
+// are not persisted by Redis, subscribers that miss them resume from the database.
+func Publish(ctx context.Context, redisClient rueidis.Client, channel string, activities []*activityx.Activity) error {
+	data, err := json.Marshal(deriveTags(activities))
+	if err != nil {
+		return fmt.Errorf("encode %d activities: %w", len(activities), err)
+	}
+
+	command := redisClient.B().Publish().Channel(channel).Message(rueidis.BinaryString(data)).Build()
+
+	if err := redisClient.Do(ctx, command).Error(); err != nil {
+		return fmt.Errorf("publish %d activities to %s: %w", len(activities), channel, err)
+	}
+
+	return nil
+}
+
+// deriveTags copies the activities with the tags derived from the types, so that the activities
+// can be unmarshalled even if the workers left the tags empty.
+func deriveTags(activities []*activityx.Activity) []*activityx.Activity {
+	results := make([]*activityx.Activity, 0, len(activities))
+
+	for _, item := range activities {
+		activity := *item
+
+		if activity.Type != nil {
+			activity.Tag = activity.Type.Tag()
+		}
+
+		activity.Actions = make([]*activityx.Action, 0, len(item.Actions))
+
+		for _, action := range item.Actions {
+			action := *action
+
+			if action.Type != nil {
+				action.Tag = action.Type.Tag()
+			}
+
+			activity.Actions = append(activity.Actions, &action)
+		}
+
+		results = append(results, &activity)
+	}
+
+	return results
+}
+
+// Hub subscribes to a channel once per Core instance and fans out the activities to the local subscribers.
+type Hub struct {
+	redisClient rueidis.Client
+	channel     string
+
+	mutex       sync.RWMutex
+	subscribers map[*Subscription]struct{}
+}
+
+// Subscription receives the batches of activities published after it is created.
+type Subscription struct {
+	hub        *Hub
+	activities chan []*activityx.Activity
+	err        error
+}
+
+// Activities returns the batches of activities, the channel is closed if the subscription is dropped.
+func (s *Subscription) Activities() <-chan []*activityx.Activity {
+	return s.activities
+}
+
+// Err returns the reason the subscription was dropped, it is only valid once the activities channel is closed.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Close removes the subscription from the hub.
+func (s *Subscription) Close() {
+	s.hub.remove(s, nil)
+}
+
+// Run receives the activities published to the channel until the context is canceled, it subscribes
+// again if the connection is lost, the activities published in the meantime are missed.
+func (h *Hub) Run(ctx context.Context) error {
+	command := h.redisClient.B().Subscribe().Channel(h.channel).Build()
+
+	for {
+		// The subscription holds a dedicated connection, since the embedded Redis server
+		// does not accept other commands on a subscribed connection.
+		err := h.redisClient.Dedicated(func(client rueidis.DedicatedClient) error {
+			return client.Receive(ctx, command, func(message rueidis.PubSubMessage) {
+				// The activities are unmarshalled as activityx.Activities, which parses the types from the tags.
+				var activities activityx.Activities
+
+				if err := json.Unmarshal([]byte(message.Message), &activities); err != nil {
+					zap.L().Error("failed to decode published activities", zap.String("channel", h.channel), zap.Error(err))
+
+					return
+				}
+
+				h.broadcast(activities)
+			})
+		})
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		zap.L().Error("lost subscription of published activities, subscribing again",
+			zap.String("channel", h.channel),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(defaultReconnectDelay):
+		}
+	}
+}
+
+// Subscribe creates a subscription, which must be closed once it is no longer used.
+func (h *Hub) Subscribe() *Subscription {
+	subscription := Subscription{
+		hub:        h,
+		activities: make(chan []*activityx.Activity, defaultBufferSize),
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.subscribers[&subscription] = struct{}{}
+
+	return &subscription
+}
+
+func (h *Hub) broadcast(activities []*activityx.Activity) {
+	var slowSubscribers []*Subscription
+
+	h.mutex.RLock()
+
+	for subscription := range h.subscribers {
+		select {
+		case subscription.activities <- activities:
+		default:
+			slowSubscribers = append(slowSubscribers, subscription)
+		}
+	}
+
+	h.mutex.RUnlock()
+
+	// Slow subscribers are dropped rather than blocking the others, they resume from the database.
+	for _, subscription := range slowSubscribers {
+		h.remove(subscription, ErrSlowSubscriber)
+	}
+}
+
+func (h *Hub) remove(subscription *Subscription, err error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if _, found := h.subscribers[subscription]; !found {
+		return
+	}
+
+	delete(h.subscribers, subscription)
+
+	subscription.err = err
+	close(subscription.activities)
+}
+
+func NewHub(redisClient rueidis.Client, channel string) *Hub {
+	return &Hub{
+		redisClient: redisClient,
+		channel:     channel,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
@@ -0,0 +1,109 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/internal/stream/pubsub"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+
+	redisClient, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{server.Addr()},
+		DisableCache: true,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(redisClient.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	hub := pubsub.NewHub(redisClient, pubsub.ChannelDecentralized)
+
+	go func() {
+		_ = hub.Run(ctx)
+	}()
+
+	first, second := hub.Subscribe(), hub.Subscribe()
+	defer first.Close()
+
+	activities := []*activityx.Activity{
+		{
+			ID:           "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+			Network:      network.Ethereum,
+			From:         "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+			To:           "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+			Tag:          tag.Transaction,
+			Type:         typex.TransactionTransfer,
+			TotalActions: 1,
+			Actions: []*activityx.Action{
+				{
+					Tag:  tag.Transaction,
+					Type: typex.TransactionTransfer,
+					From: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+					To:   "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+					Metadata: &metadata.TransactionTransfer{
+						Name:  "Wrapped Ether",
+						Value: lo.ToPtr(decimal.NewFromInt(1)),
+					},
+				},
+			},
+			Status:    true,
+			Timestamp: 1729000000,
+		},
+	}
+
+	// Wait for the hub to subscribe to the channel.
+	require.Eventually(t, func() bool {
+		return len(server.PubSubChannels("")) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, pubsub.Publish(ctx, redisClient, pubsub.ChannelDecentralized, activities))
+
+	for _, subscription := range []*pubsub.Subscription{first, second} {
+		select {
+		case received := <-subscription.Activities():
+			require.Equal(t, activities, received)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timeout waiting for activities")
+		}
+	}
+
+	// A closed subscription no longer receives activities.
+	second.Close()
+
+	_, ok := <-second.Activities()
+	require.False(t, ok)
+	require.NoError(t, second.Err())
+}
+
+func TestChannel(t *testing.T) {
+	t.Parallel()
+
+	channel, ok := pubsub.Channel(network.EthereumProtocol)
+	require.True(t, ok)
+	require.Equal(t, pubsub.ChannelDecentralized, channel)
+
+	channel, ok = pubsub.Channel(network.ATProtocol)
+	require.True(t, ok)
+	require.Equal(t, pubsub.ChannelFederated, channel)
+
+	_, ok = pubsub.Channel(network.RSSProtocol)
+	require.False(t, ok)
+}
@@ -0,0 +1,62 @@
+package router
+
+import (
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/stream"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/samber/lo"
+)
+
+// Router routes activities to topics by their network and tag, and builds the message keys for partitioning.
+type Router struct {
+	topic  string
+	key    stream.Key
+	routes []*config.StreamRoute
+}
+
+// Topic returns the topic of the first route matching the activity, or the default topic if no route matches.
+func (r *Router) Topic(activity *activityx.Activity) string {
+	for _, route := range r.routes {
+		if len(route.Networks) > 0 && !lo.Contains(route.Networks, activity.Network) {
+			continue
+		}
+
+		if len(route.Tags) > 0 && !lo.Contains(route.Tags, activity.Tag) {
+			continue
+		}
+
+		return route.Topic
+	}
+
+	return r.topic
+}
+
+// Topics returns all topics that activities can be routed to.
+func (r *Router) Topics() []string {
+	topics := lo.Map(r.routes, func(route *config.StreamRoute, _ int) string {
+		return route.Topic
+	})
+
+	return lo.Uniq(append([]string{r.topic}, topics...))
+}
+
+// Key returns the message key of the activity.
+func (r *Router) Key(activity *activityx.Activity) []byte {
+	switch r.key {
+	case stream.KeyOwner:
+		// Activities without an owner are keyed by the sender.
+		return []byte(lo.Ternary(activity.Owner != "", activity.Owner, activity.From))
+	case stream.KeyNetwork:
+		return []byte(activity.Network.String())
+	default:
+		return []byte(activity.ID)
+	}
+}
+
+func New(config *config.Stream) *Router {
+	return &Router{
+		topic:  config.Topic,
+		key:    config.Key,
+		routes: config.Routes,
+	}
+}
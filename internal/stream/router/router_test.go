@@ -0,0 +1,74 @@
+package router_test
+
+import (
+	"testing"
+
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/stream"
+	"github.com/rss3-network/node/v2/internal/stream/router"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter(t *testing.T) {
+	t.Parallel()
+
+	streamConfig := config.Stream{
+		Topic: "rss3.node.activities",
+		Key:   stream.KeyOwner,
+		Routes: []*config.StreamRoute{
+			{
+				Topic:    "rss3.node.activities.ethereum.exchange",
+				Networks: []network.Network{network.Ethereum},
+				Tags:     []tag.Tag{tag.Exchange},
+			},
+			{
+				Topic: "rss3.node.activities.social",
+				Tags:  []tag.Tag{tag.Social},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		activity *activityx.Activity
+		topic    string
+		key      string
+	}{
+		{
+			name:     "Network and tag route",
+			activity: &activityx.Activity{ID: "0x1", Owner: "0xowner", Network: network.Ethereum, Tag: tag.Exchange},
+			topic:    "rss3.node.activities.ethereum.exchange",
+			key:      "0xowner",
+		},
+		{
+			name:     "Tag route",
+			activity: &activityx.Activity{ID: "0x2", Owner: "0xowner", Network: network.Farcaster, Tag: tag.Social},
+			topic:    "rss3.node.activities.social",
+			key:      "0xowner",
+		},
+		{
+			name:     "Default topic",
+			activity: &activityx.Activity{ID: "0x3", From: "0xfrom", Network: network.Arbitrum, Tag: tag.Exchange},
+			topic:    "rss3.node.activities",
+			key:      "0xfrom",
+		},
+	}
+
+	streamRouter := router.New(&streamConfig)
+
+	require.Equal(t, []string{"rss3.node.activities", "rss3.node.activities.ethereum.exchange", "rss3.node.activities.social"}, streamRouter.Topics())
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testcase.topic, streamRouter.Topic(testcase.activity))
+			require.Equal(t, testcase.key, string(streamRouter.Key(testcase.activity)))
+		})
+	}
+}
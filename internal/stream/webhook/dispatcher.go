@@ -0,0 +1,309 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchLimit   = 16
+	defaultTimeout      = 10 * time.Second
+	// defaultLeaseDuration is the time a claimed delivery is reserved for its dispatcher, it must exceed the timeout of the attempt.
+	defaultLeaseDuration = 3 * defaultTimeout
+
+	// MaxAttempts is the number of attempts of a delivery before it fails.
+	MaxAttempts = 8
+	// MaxFailures is the number of consecutive failed deliveries before the webhook is disabled.
+	MaxFailures = 5
+
+	defaultRetryDelay    = 10 * time.Second
+	defaultMaxRetryDelay = time.Hour
+	// maxResponseSize is the size of the response body read for the delivery log.
+	maxResponseSize = 512
+)
+
+// Payload is the body of a delivery.
+type Payload struct {
+	ID      uint64                `json:"id"`
+	Webhook string                `json:"webhook"`
+	Data    []*activityx.Activity `json:"data"`
+}
+
+// Dispatcher delivers the pending deliveries to the webhooks. The deliveries are claimed with a lease in a short
+// transaction, posted outside of it, and their results are saved in a second transaction, so each attempt is made
+// by a single dispatcher. A delivery whose dispatcher stopped before saving the result is attempted again once its
+// lease has expired, so a delivery may be received more than once and receivers should dedupe it by its ID.
+// A failed attempt is retried with an exponential backoff, and the webhook is disabled after too many consecutive failed deliveries.
+type Dispatcher struct {
+	databaseClient database.Client
+	httpClient     *http.Client
+}
+
+func (d *Dispatcher) Run(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			dispatched, err := d.dispatch(ctx)
+			if err != nil {
+				zap.L().Error("failed to dispatch webhook deliveries", zap.Error(err))
+			}
+
+			// Drain the due deliveries without waiting if there are more deliveries left.
+			timer.Reset(lo.Ternary(err == nil && dispatched == defaultBatchLimit, 0, defaultPollInterval))
+		}
+	}
+}
+
+// dispatch attempts a batch of due deliveries and returns the number of claimed deliveries.
+func (d *Dispatcher) dispatch(ctx context.Context) (int, error) {
+	deliveries, webhooks, err := d.claim(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	// The deliveries are attempted concurrently outside any transaction, so no connection is held by slow receivers.
+	var waitGroup sync.WaitGroup
+
+	for _, delivery := range deliveries {
+		if delivery.Status != model.WebhookDeliveryStatusPending {
+			continue
+		}
+
+		waitGroup.Add(1)
+
+		go func(delivery *model.WebhookDelivery) {
+			defer waitGroup.Done()
+
+			d.attempt(ctx, webhooks[delivery.WebhookID], delivery)
+		}(delivery)
+	}
+
+	waitGroup.Wait()
+
+	if err := d.record(ctx, deliveries); err != nil {
+		return 0, fmt.Errorf("record webhook deliveries: %w", err)
+	}
+
+	return len(deliveries), nil
+}
+
+// claim locks the due deliveries and leases them to this dispatcher by moving their next attempt past the timeout of the attempt.
+// The attempt is counted by the claim, so the deliveries of a dispatcher that keeps stopping still run out of attempts.
+// The deliveries that can not be attempted are finished as failed.
+func (d *Dispatcher) claim(ctx context.Context) ([]*model.WebhookDelivery, map[string]*model.Webhook, error) {
+	var (
+		deliveries []*model.WebhookDelivery
+		webhooks   map[string]*model.Webhook
+	)
+
+	err := d.databaseClient.WithTransaction(ctx, func(ctx context.Context, client database.Client) error {
+		var err error
+
+		if deliveries, err = client.LockWebhookDeliveries(ctx, defaultBatchLimit); err != nil {
+			return fmt.Errorf("lock webhook deliveries: %w", err)
+		}
+
+		webhooks = make(map[string]*model.Webhook)
+
+		for _, delivery := range deliveries {
+			webhook, found := webhooks[delivery.WebhookID]
+			if !found {
+				if webhook, err = client.LoadWebhook(ctx, delivery.WebhookID); err != nil {
+					return fmt.Errorf("load webhook %s: %w", delivery.WebhookID, err)
+				}
+
+				webhooks[delivery.WebhookID] = webhook
+			}
+
+			switch {
+			case webhook == nil || !webhook.Enabled:
+				delivery.Status, delivery.Error = model.WebhookDeliveryStatusFailed, "webhook is deleted or disabled"
+			case delivery.Attempts >= MaxAttempts:
+				delivery.Status, delivery.Error = model.WebhookDeliveryStatusFailed, "lease of the last attempt expired"
+			default:
+				delivery.Attempts++
+				delivery.NextAttemptAt = time.Now().Add(defaultLeaseDuration)
+			}
+
+			if err := client.UpdateWebhookDelivery(ctx, delivery); err != nil {
+				return fmt.Errorf("update webhook delivery %d: %w", delivery.ID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deliveries, webhooks, nil
+}
+
+// record saves the results of the attempts, and updates the consecutive failures of the webhooks with the finished deliveries.
+func (d *Dispatcher) record(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	return d.databaseClient.WithTransaction(ctx, func(ctx context.Context, client database.Client) error {
+		for _, delivery := range deliveries {
+			if err := client.UpdateWebhookDelivery(ctx, delivery); err != nil {
+				return fmt.Errorf("update webhook delivery %d: %w", delivery.ID, err)
+			}
+		}
+
+		for id, group := range lo.GroupBy(deliveries, func(delivery *model.WebhookDelivery) string { return delivery.WebhookID }) {
+			// The webhook is loaded again, since its failures may have been updated by other dispatchers meanwhile.
+			webhook, err := client.LoadWebhook(ctx, id)
+			if err != nil {
+				return fmt.Errorf("load webhook %s: %w", id, err)
+			}
+
+			if webhook == nil {
+				continue
+			}
+
+			if err := d.recordResults(ctx, client, webhook, group); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// attempt posts the delivery to the webhook, and updates the delivery with the result.
+func (d *Dispatcher) attempt(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) {
+	responseStatus, err := d.post(ctx, webhook, delivery)
+
+	delivery.ResponseStatus = responseStatus
+
+	if err == nil {
+		delivery.Status, delivery.Error = model.WebhookDeliveryStatusDelivered, ""
+
+		return
+	}
+
+	delivery.Error = err.Error()
+
+	if delivery.Attempts >= MaxAttempts {
+		delivery.Status = model.WebhookDeliveryStatusFailed
+	} else {
+		delivery.NextAttemptAt = time.Now().Add(RetryDelay(delivery.Attempts))
+	}
+
+	zap.L().Warn("failed to deliver activities to webhook",
+		zap.String("webhook", webhook.ID),
+		zap.Uint64("delivery", delivery.ID),
+		zap.Int("attempts", delivery.Attempts),
+		zap.Error(err))
+}
+
+func (d *Dispatcher) post(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(Payload{
+		ID:      delivery.ID,
+		Webhook: webhook.ID,
+		Data:    delivery.Activities,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("encode payload: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("new request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(HeaderSignature, Sign(webhook.Secret, timestamp, body))
+	request.Header.Set(HeaderDelivery, strconv.FormatUint(delivery.ID, 10))
+	request.Header.Set(HeaderWebhook, webhook.ID)
+
+	response, err := d.httpClient.Do(request)
+	if err != nil {
+		return 0, fmt.Errorf("post delivery: %w", err)
+	}
+	defer lo.Try(response.Body.Close)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		content, _ := io.ReadAll(io.LimitReader(response.Body, maxResponseSize))
+
+		return response.StatusCode, fmt.Errorf("unexpected status %s: %s", response.Status, content)
+	}
+
+	return response.StatusCode, nil
+}
+
+// recordResults updates the consecutive failures of the webhook with the finished deliveries,
+// the webhook is disabled once the failures reach the threshold, and the failures are reset by a successful delivery.
+func (d *Dispatcher) recordResults(ctx context.Context, client database.Client, webhook *model.Webhook, deliveries []*model.WebhookDelivery) error {
+	failures := webhook.Failures
+
+	for _, delivery := range deliveries {
+		switch delivery.Status {
+		case model.WebhookDeliveryStatusDelivered:
+			failures = 0
+		case model.WebhookDeliveryStatusFailed:
+			failures++
+		}
+	}
+
+	if failures == webhook.Failures || !webhook.Enabled {
+		return nil
+	}
+
+	webhook.Failures = failures
+
+	if webhook.Failures >= MaxFailures {
+		webhook.Enabled = false
+
+		zap.L().Warn("disabled webhook after consecutive failed deliveries",
+			zap.String("webhook", webhook.ID),
+			zap.Int("failures", webhook.Failures))
+	}
+
+	if err := client.SaveWebhook(ctx, webhook); err != nil {
+		return fmt.Errorf("save webhook %s: %w", webhook.ID, err)
+	}
+
+	return nil
+}
+
+// RetryDelay returns the delay before the next attempt of a delivery, which doubles with each failed attempt.
+func RetryDelay(attempts int) time.Duration {
+	delay := float64(defaultRetryDelay) * math.Pow(2, float64(attempts-1))
+
+	return time.Duration(math.Min(delay, float64(defaultMaxRetryDelay)))
+}
+
+func NewDispatcher(databaseClient database.Client) *Dispatcher {
+	return &Dispatcher{
+		databaseClient: databaseClient,
+		httpClient: &http.Client{
+			Timeout: defaultTimeout,
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"strings"
+
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/samber/lo"
+)
+
+// Match returns whether the activity matches the filter of a webhook, the fields are matched against
+// the activity and its actions as the database does, and the direction is relative to the owners.
+func Match(filter model.WebhookFilter, activity *activityx.Activity) bool {
+	if len(filter.Network) > 0 && !lo.Contains(filter.Network, activity.Network) {
+		return false
+	}
+
+	if len(filter.Platforms) > 0 && !lo.ContainsBy(filter.Platforms, func(platform string) bool {
+		return strings.EqualFold(platform, activity.Platform)
+	}) {
+		return false
+	}
+
+	if filter.Status != nil && *filter.Status != activity.Status {
+		return false
+	}
+
+	if len(filter.Tags) > 0 && !lo.Contains(filter.Tags, activity.Tag) && !lo.ContainsBy(activity.Actions, func(action *activityx.Action) bool {
+		return lo.Contains(filter.Tags, action.Tag)
+	}) {
+		return false
+	}
+
+	if len(filter.Types) > 0 && !matchType(filter, activity.Type) && !lo.ContainsBy(activity.Actions, func(action *activityx.Action) bool {
+		return matchType(filter, action.Type)
+	}) {
+		return false
+	}
+
+	if len(filter.Owners) == 0 && filter.Direction == nil {
+		return true
+	}
+
+	for address, direction := range directions(activity) {
+		if len(filter.Owners) > 0 && !lo.ContainsBy(filter.Owners, func(owner string) bool {
+			return strings.EqualFold(owner, address)
+		}) {
+			continue
+		}
+
+		if filter.Direction == nil || *filter.Direction == direction {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchType returns whether the type is one of the types of the filter, the names are only
+// unique within a tag, so the type must also belong to one of the tags if the tags are set.
+func matchType(filter model.WebhookFilter, typex schema.Type) bool {
+	if typex == nil || !lo.Contains(filter.Types, typex.Name()) {
+		return false
+	}
+
+	return len(filter.Tags) == 0 || lo.Contains(filter.Tags, typex.Tag())
+}
+
+// directions returns the direction of the activity for each of its addresses, as the index of the activity does.
+func directions(activity *activityx.Activity) map[string]activityx.Direction {
+	addresses := make(map[string]activityx.Direction)
+
+	for _, action := range activity.Actions {
+		if action.From == action.To {
+			addresses[action.To] = activityx.DirectionSelf
+
+			continue
+		}
+
+		addresses[action.To] = activityx.DirectionIn
+		addresses[action.From] = activityx.DirectionOut
+	}
+
+	// The from/to address of the transaction has a higher priority.
+	if activity.From == activity.To {
+		addresses[activity.To] = activityx.DirectionSelf
+	} else {
+		addresses[activity.To] = activityx.DirectionIn
+		addresses[activity.From] = activityx.DirectionOut
+	}
+
+	delete(addresses, "")
+
+	return addresses
+}
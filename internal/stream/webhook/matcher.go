@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/samber/lo"
+)
+
+// defaultCacheExpiration is the time the enabled webhooks are cached, the changes of the webhooks take effect after it.
+const defaultCacheExpiration = 30 * time.Second
+
+// Matcher matches the committed activities against the enabled webhooks.
+type Matcher struct {
+	databaseClient database.Client
+
+	mutex    sync.Mutex
+	webhooks []*model.Webhook
+	loadedAt time.Time
+}
+
+// Deliveries returns a pending delivery for each webhook that matches some of the activities,
+// the deliveries should be saved in the transaction that commits the activities.
+func (m *Matcher) Deliveries(ctx context.Context, activities []*activityx.Activity) ([]*model.WebhookDelivery, error) {
+	if len(activities) == 0 {
+		return nil, nil
+	}
+
+	webhooks, err := m.loadWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*model.WebhookDelivery, 0)
+
+	for _, webhook := range webhooks {
+		matched := lo.Filter(activities, func(activity *activityx.Activity, _ int) bool {
+			return Match(webhook.Filter, activity)
+		})
+
+		if len(matched) == 0 {
+			continue
+		}
+
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			Activities:    matched,
+			Status:        model.WebhookDeliveryStatusPending,
+			NextAttemptAt: time.Now(),
+		})
+	}
+
+	return deliveries, nil
+}
+
+func (m *Matcher) loadWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if time.Since(m.loadedAt) < defaultCacheExpiration {
+		return m.webhooks, nil
+	}
+
+	webhooks, err := m.databaseClient.FindWebhooks(ctx, model.WebhooksQuery{Enabled: lo.ToPtr(true)})
+	if err != nil {
+		return nil, fmt.Errorf("find enabled webhooks: %w", err)
+	}
+
+	m.webhooks, m.loadedAt = webhooks, time.Now()
+
+	return webhooks, nil
+}
+
+func NewMatcher(databaseClient database.Client) *Matcher {
+	return &Matcher{
+		databaseClient: databaseClient,
+	}
+}
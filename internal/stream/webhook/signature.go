@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	// HeaderSignature is the HMAC-SHA256 signature of the timestamp and the body, in the format of sha256=<hex>.
+	HeaderSignature = "X-RSS3-Signature"
+	// HeaderTimestamp is the Unix time the delivery is attempted, receivers should reject stale timestamps to prevent replays.
+	HeaderTimestamp = "X-RSS3-Timestamp"
+	HeaderDelivery  = "X-RSS3-Delivery"
+	HeaderWebhook   = "X-RSS3-Webhook"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the signature of a delivery, which is the HMAC-SHA256 of the timestamp
+// and the body joined by a dot, keyed by the secret of the webhook.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns whether the signature of a delivery is valid, it is used by receivers written in Go.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
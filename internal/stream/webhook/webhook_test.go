@@ -0,0 +1,240 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/v2/config"
+	"github.com/rss3-network/node/v2/internal/database"
+	"github.com/rss3-network/node/v2/internal/database/dialer"
+	"github.com/rss3-network/node/v2/internal/database/model"
+	"github.com/rss3-network/node/v2/internal/stream/webhook"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+var activity = &activityx.Activity{
+	ID:       "0x30182d4468ddc7001b897908203abb57939fc57663c491435a2f88cafd51d101",
+	Network:  network.Ethereum,
+	From:     "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+	To:       "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+	Tag:      tag.Transaction,
+	Type:     typex.TransactionTransfer,
+	Platform: "Uniswap",
+	Status:   true,
+	Actions: []*activityx.Action{
+		{
+			Tag:  tag.Exchange,
+			Type: typex.ExchangeSwap,
+			From: "0x9D22816f6611cFcB0cDE5076C5f4e4A269E79Bef",
+			To:   "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+		},
+	},
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name   string
+		filter model.WebhookFilter
+		want   bool
+	}{
+		{
+			name: "Empty Filter",
+			want: true,
+		},
+		{
+			name:   "Owner Of Action",
+			filter: model.WebhookFilter{Owners: []string{"0x3fc91a3afd70395cd496c647d5a6cc9d4b2b7fad"}},
+			want:   true,
+		},
+		{
+			name:   "Outgoing Activity Of Owner",
+			filter: model.WebhookFilter{Owners: []string{"0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"}, Direction: lo.ToPtr(activityx.DirectionOut)},
+			want:   true,
+		},
+		{
+			name:   "Incoming Activity Of Owner",
+			filter: model.WebhookFilter{Owners: []string{"0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"}, Direction: lo.ToPtr(activityx.DirectionIn)},
+			want:   false,
+		},
+		{
+			name:   "Tag And Type Of Action",
+			filter: model.WebhookFilter{Tags: []tag.Tag{tag.Exchange}, Types: []string{"swap"}},
+			want:   true,
+		},
+		{
+			name:   "Type Of Other Tag",
+			filter: model.WebhookFilter{Tags: []tag.Tag{tag.Collectible}, Types: []string{"transfer"}},
+			want:   false,
+		},
+		{
+			name:   "Failed Activities",
+			filter: model.WebhookFilter{Status: lo.ToPtr(false)},
+			want:   false,
+		},
+		{
+			name:   "Network And Platform",
+			filter: model.WebhookFilter{Network: []network.Network{network.Ethereum}, Platforms: []string{"uniswap"}},
+			want:   true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testcase.want, webhook.Match(testcase.filter, activity))
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"id":1}`)
+
+	signature := webhook.Sign("secret", 1700000000, body)
+	require.Equal(t, "sha256=", signature[:7])
+	require.True(t, webhook.Verify("secret", 1700000000, body, signature))
+	require.False(t, webhook.Verify("secret", 1700000001, body, signature))
+	require.False(t, webhook.Verify("other", 1700000000, body, signature))
+}
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 10*time.Second, webhook.RetryDelay(1))
+	require.Equal(t, 40*time.Second, webhook.RetryDelay(3))
+	require.Equal(t, time.Hour, webhook.RetryDelay(webhook.MaxAttempts*2))
+}
+
+// payload is the body of a delivery, the activities are not decoded since their types depend on the tags.
+type payload struct {
+	ID   uint64            `json:"id"`
+	Data []json.RawMessage `json:"data"`
+}
+
+func TestDispatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	databaseClient, err := dialer.Dial(ctx, &config.Database{
+		Driver: database.DriverSQLite,
+		URI:    filepath.Join(t.TempDir(), "node.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, databaseClient.Migrate(ctx))
+
+	var (
+		received atomic.Pointer[payload]
+		failing  atomic.Bool
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+
+		timestamp, err := strconv.ParseInt(request.Header.Get(webhook.HeaderTimestamp), 10, 64)
+		require.NoError(t, err)
+		require.True(t, webhook.Verify("secret", timestamp, body, request.Header.Get(webhook.HeaderSignature)))
+
+		if failing.Load() {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		var value payload
+		require.NoError(t, json.Unmarshal(body, &value))
+
+		received.Store(&value)
+	}))
+	t.Cleanup(server.Close)
+
+	require.NoError(t, databaseClient.SaveWebhook(ctx, &model.Webhook{
+		ID:      "webhook",
+		URL:     server.URL,
+		Secret:  "secret",
+		Filter:  model.WebhookFilter{Tags: []tag.Tag{tag.Exchange}},
+		Enabled: true,
+	}))
+
+	deliveries, err := webhook.NewMatcher(databaseClient).Deliveries(ctx, []*activityx.Activity{activity})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.NoError(t, databaseClient.SaveWebhookDeliveries(ctx, deliveries))
+
+	go func() {
+		_ = webhook.NewDispatcher(databaseClient).Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return received.Load() != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, deliveries[0].ID, received.Load().ID)
+	require.Len(t, received.Load().Data, 1)
+
+	require.Eventually(t, func() bool {
+		logs, err := databaseClient.FindWebhookDeliveries(ctx, model.WebhookDeliveriesQuery{WebhookID: "webhook", Limit: 1})
+		require.NoError(t, err)
+
+		return len(logs) == 1 && logs[0].Status == model.WebhookDeliveryStatusDelivered
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A failed attempt is scheduled to be retried later.
+	failing.Store(true)
+
+	require.NoError(t, databaseClient.SaveWebhookDeliveries(ctx, []*model.WebhookDelivery{
+		{
+			WebhookID:     "webhook",
+			Activities:    []*activityx.Activity{activity},
+			Status:        model.WebhookDeliveryStatusPending,
+			NextAttemptAt: time.Now(),
+		},
+	}))
+
+	require.Eventually(t, func() bool {
+		logs, err := databaseClient.FindWebhookDeliveries(ctx, model.WebhookDeliveriesQuery{WebhookID: "webhook", Limit: 1})
+		require.NoError(t, err)
+
+		return len(logs) == 1 && logs[0].Attempts == 1 && logs[0].ResponseStatus == http.StatusServiceUnavailable &&
+			logs[0].Status == model.WebhookDeliveryStatusPending && logs[0].NextAttemptAt.After(time.Now())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A delivery whose last attempt was claimed by a dispatcher that stopped before saving the result fails once its lease expires.
+	require.NoError(t, databaseClient.SaveWebhookDeliveries(ctx, []*model.WebhookDelivery{
+		{
+			WebhookID:     "webhook",
+			Activities:    []*activityx.Activity{activity},
+			Status:        model.WebhookDeliveryStatusPending,
+			Attempts:      webhook.MaxAttempts,
+			NextAttemptAt: time.Now(),
+		},
+	}))
+
+	require.Eventually(t, func() bool {
+		logs, err := databaseClient.FindWebhookDeliveries(ctx, model.WebhookDeliveriesQuery{WebhookID: "webhook", Limit: 1})
+		require.NoError(t, err)
+
+		return len(logs) == 1 && logs[0].Attempts == webhook.MaxAttempts && logs[0].Status == model.WebhookDeliveryStatusFailed
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -3,11 +3,8 @@ package mastodon
 import (
 	"bytes"
 	"context"
-	"crypto/rand"
 	"crypto/rsa"
-	"crypto/x509"
 	"encoding/json"
-	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
@@ -21,6 +18,7 @@ import (
 	"github.com/go-fed/httpsig"
 	"github.com/go-playground/form/v4"
 	"github.com/google/uuid"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/rss3-network/node/v2/provider/activitypub"
@@ -72,6 +70,13 @@ type Client interface {
 
 	// SendMessage queues a message to be processed.
 	SendMessage(object string)
+
+	// LookupAccount resolves the actor ID of an account handle (@username@domain) via WebFinger.
+	LookupAccount(ctx context.Context, handle string) (string, error)
+
+	// FetchOutbox pages the outbox of the actor from the newest activities.
+	// The handler is called with the activities of each page until it returns false or the pages are exhausted.
+	FetchOutbox(ctx context.Context, actorID string, handler OutboxHandler) error
 }
 
 type client struct {
@@ -85,6 +90,7 @@ type client struct {
 	relayURLs     []string
 	server        *echo.Echo
 	port          int64
+	publicKeys    *expirable.LRU[string, *actorPublicKey]
 }
 
 // NewClient creates a new Mastodon client with the specified public endpoint (domain) and relay URLs (relayURLList).
@@ -100,7 +106,13 @@ type client struct {
 //
 //     You can add multiple relay URLs in config.yaml:
 //     config.yaml -> component -> federated -> id: mastodon-core -> parameters -> relay_url_list
-func NewClient(ctx context.Context, endpoint string, relayList []string, port int64, errorChan chan<- error) (Client, error) {
+//
+//   - port: The port of the inbox server. A port of zero disables the inbox server and the relay follows,
+//     which is used to only fetch documents from remote servers, e.g. backfilling the outbox of accounts.
+//
+//   - privateKey: The persisted key of the actor, which keeps the identity of the node stable across restarts.
+//     A temporary key is generated if it is nil.
+func NewClient(ctx context.Context, endpoint string, relayList []string, port int64, privateKey *rsa.PrivateKey, errorChan chan<- error) (Client, error) {
 	// Input Validation
 	if endpoint == "" {
 		return nil, fmt.Errorf("endpoint cannot be empty")
@@ -111,13 +123,21 @@ func NewClient(ctx context.Context, endpoint string, relayList []string, port in
 		zap.Int64("server port", port),
 		zap.Strings("relay URL List", relayList))
 
-	// Generate the Key Pair
-	privateKey, publicKeyPem, err := generateKeyPair()
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate key pair: %w", err)
+	if privateKey == nil {
+		zap.L().Warn("no persisted actor key, generating a temporary key pair")
+
+		generatedKey, err := GeneratePrivateKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate key pair: %w", err)
+		}
+
+		privateKey = generatedKey
 	}
 
-	zap.L().Debug("successfully generated key pair")
+	publicKeyPem, err := encodePublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
 
 	// Create actor
 	actor, err := createActor(endpoint, publicKeyPem)
@@ -142,6 +162,13 @@ func NewClient(ctx context.Context, endpoint string, relayList []string, port in
 		attempts:      defaultAttempts,
 		port:          port,
 		relayURLs:     relayList,
+		publicKeys:    expirable.NewLRU[string, *actorPublicKey](publicKeyCacheSize, nil, publicKeyCacheTTL),
+	}
+
+	if port == 0 {
+		zap.L().Info("inbox server is disabled, skipping the relay follows")
+
+		return c, nil
 	}
 
 	// Setup and store Echo server
@@ -275,7 +302,8 @@ func (c *client) handleGetActor(e echo.Context) error {
 	return e.JSON(http.StatusOK, actor)
 }
 
-// handleActorInbox processes incoming ActivityPub messages sent to the actor's inbox.
+// handleActorInbox processes incoming ActivityPub messages sent to the actor's inbox,
+// the messages must be signed by an actor of the same origin as the activity.
 func (c *client) handleActorInbox(e echo.Context) error {
 	requestBody := http.MaxBytesReader(e.Response(), e.Request().Body, maxRequestBodySize)
 
@@ -290,6 +318,14 @@ func (c *client) handleActorInbox(e echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to read request body")
 	}
 
+	if err := c.verifyInboxRequest(e.Request().Context(), e.Request(), data); err != nil {
+		zap.L().Warn("rejected unverified inbox request",
+			zap.String("remoteAddr", e.RealIP()),
+			zap.Error(err))
+
+		return echo.NewHTTPError(http.StatusUnauthorized, "failed to verify request signature")
+	}
+
 	message := string(data)
 	zap.L().Info("received relay object", zap.String("message", message))
 
@@ -409,30 +445,6 @@ func newSigner(instanceURL *url.URL) (httpsig.Signer, error) {
 	return signer, nil
 }
 
-// generateKeyPair creates a new RSA key pair for signing ActivityPub messages.
-func generateKeyPair() (*rsa.PrivateKey, string, error) {
-	privateKey, err := rsa.GenerateKey(rand.Reader, keySize)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to generate key using rsa: %w", err)
-	}
-
-	publicKeyBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to get make marshal public key: %w", err)
-	}
-
-	// Encode in PEM format with PUBLIC KEY header
-	publicKeyPem := pem.EncodeToMemory(&pem.Block{
-		Type:  "PUBLIC KEY",
-		Bytes: publicKeyBytes,
-	})
-	if publicKeyPem == nil {
-		return nil, "", fmt.Errorf("failed to encode public key")
-	}
-
-	return privateKey, string(publicKeyPem), nil
-}
-
 // FollowRelayServices attempts to follow all configured relay services.
 func (c *client) FollowRelayServices(ctx context.Context) error {
 	zap.L().Debug("beginning to follow relay services", zap.Strings("relayURLs", c.relayURLs))
@@ -0,0 +1,98 @@
+package mastodon
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// GeneratePrivateKey creates a new RSA private key for signing ActivityPub messages.
+func GeneratePrivateKey() (*rsa.PrivateKey, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key using rsa: %w", err)
+	}
+
+	return privateKey, nil
+}
+
+// MarshalPrivateKey encodes the private key in PKCS #8 PEM format, which is used to persist the identity of the actor.
+func MarshalPrivateKey(privateKey *rsa.PrivateKey) (string, error) {
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal private key: %w", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: privateKeyBytes,
+	})), nil
+}
+
+// ParsePrivateKey decodes a RSA private key in PKCS #8 or PKCS #1 PEM format.
+func ParsePrivateKey(privateKeyPem string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPem))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode private key pem")
+	}
+
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return privateKey, nil
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+
+	return rsaPrivateKey, nil
+}
+
+// encodePublicKey encodes the public key in PEM format with PUBLIC KEY header.
+func encodePublicKey(publicKey *rsa.PublicKey) (string, error) {
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to get make marshal public key: %w", err)
+	}
+
+	publicKeyPem := pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: publicKeyBytes,
+	})
+	if publicKeyPem == nil {
+		return "", fmt.Errorf("failed to encode public key")
+	}
+
+	return string(publicKeyPem), nil
+}
+
+// parsePublicKey decodes a RSA public key of a remote actor in PKIX or PKCS #1 PEM format.
+func parsePublicKey(publicKeyPem string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKeyPem))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode public key pem")
+	}
+
+	if publicKey, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return publicKey, nil
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	return rsaPublicKey, nil
+}
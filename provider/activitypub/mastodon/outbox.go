@@ -0,0 +1,227 @@
+package mastodon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rss3-network/node/v2/provider/activitypub"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	webFingerPath    = "/.well-known/webfinger"
+	webFingerRelSelf = "self"
+	jrdJSONType      = "application/jrd+json"
+	activityLDType   = "application/ld+json"
+
+	// maxOutboxPages bounds the pages fetched from an outbox, Mastodon serves 20 activities per page.
+	maxOutboxPages = 1000
+)
+
+var ErrAccountNotFound = errors.New("account not found")
+
+// OutboxHandler handles the activities of an outbox page, it returns false to stop paging.
+type OutboxHandler func(activities []activitypub.Object) (bool, error)
+
+// LookupAccount resolves the actor ID of an account handle (@username@domain) via WebFinger.
+func (c *client) LookupAccount(ctx context.Context, handle string) (string, error) {
+	username, domain, ok := strings.Cut(strings.TrimPrefix(handle, "@"), "@")
+	if !ok || username == "" || domain == "" {
+		return "", fmt.Errorf("invalid account handle %s", handle)
+	}
+
+	webFingerURL := url.URL{
+		Scheme:   "https",
+		Host:     domain,
+		Path:     webFingerPath,
+		RawQuery: url.Values{"resource": {fmt.Sprintf("acct:%s@%s", username, domain)}}.Encode(),
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, webFingerURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+
+	request.Header.Set(headerAccept, jrdJSONType)
+
+	response, err := c.netHTTPClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("fetch webfinger: %w", err)
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusGone:
+		return "", fmt.Errorf("%w: %s", ErrAccountNotFound, handle)
+	default:
+		return "", fmt.Errorf("unexpected status %s", response.Status)
+	}
+
+	var webFinger activitypub.WebFinger
+	if err := json.NewDecoder(io.LimitReader(response.Body, maxDocumentSize)).Decode(&webFinger); err != nil {
+		return "", fmt.Errorf("decode webfinger: %w", err)
+	}
+
+	link, found := lo.Find(webFinger.Links, func(link activitypub.WebFingerLink) bool {
+		return link.Rel == webFingerRelSelf && (link.Type == activityJSONType || strings.HasPrefix(link.Type, activityLDType))
+	})
+
+	if !found || link.Href == "" {
+		return "", fmt.Errorf("%w: %s has no actor link", ErrAccountNotFound, handle)
+	}
+
+	return link.Href, nil
+}
+
+// FetchOutbox pages the outbox of the actor from the newest activities, and calls the handler with
+// the activities of each page until the handler returns false or the pages are exhausted.
+func (c *client) FetchOutbox(ctx context.Context, actorID string, handler OutboxHandler) error {
+	data, err := c.fetchActivityDocument(ctx, actorID)
+	if err != nil {
+		return fmt.Errorf("fetch actor %s: %w", actorID, err)
+	}
+
+	var actor activitypub.Actor
+	if err := json.Unmarshal(data, &actor); err != nil {
+		return fmt.Errorf("decode actor: %w", err)
+	}
+
+	// The outbox must be served by the server of the actor, so the activities can not be spoofed.
+	if actor.Outbox == "" || !sameOrigin(actor.Outbox, actorID) {
+		return fmt.Errorf("invalid outbox %q of actor %s", actor.Outbox, actorID)
+	}
+
+	collection, err := c.fetchCollectionPage(ctx, actor.Outbox)
+	if err != nil {
+		return fmt.Errorf("fetch outbox %s: %w", actor.Outbox, err)
+	}
+
+	page := collection
+
+	// The first page is either embedded in the collection or linked by it.
+	if len(collection.OrderedItems) == 0 && len(collection.First) > 0 {
+		if page, err = c.loadCollectionPage(ctx, collection.First); err != nil {
+			return fmt.Errorf("load first page of %s: %w", actor.Outbox, err)
+		}
+	}
+
+	for pages := 1; ; pages++ {
+		activities := make([]activitypub.Object, 0, len(page.OrderedItems))
+
+		for _, item := range page.OrderedItems {
+			var activity activitypub.Object
+
+			// Skip the items that are links instead of embedded activities.
+			if err := json.Unmarshal(item, &activity); err != nil || !sameOrigin(activity.ID, actorID) {
+				continue
+			}
+
+			activities = append(activities, activity)
+		}
+
+		next, err := handler(activities)
+		if err != nil {
+			return err
+		}
+
+		if !next || page.Next == "" {
+			return nil
+		}
+
+		if pages >= maxOutboxPages {
+			zap.L().Warn("reached the maximum pages of outbox", zap.String("outbox", actor.Outbox))
+
+			return nil
+		}
+
+		nextURL := page.Next
+
+		if page, err = c.fetchCollectionPage(ctx, nextURL); err != nil {
+			return fmt.Errorf("fetch outbox page %s: %w", nextURL, err)
+		}
+	}
+}
+
+// loadCollectionPage loads a collection page, which is either an embedded object or a link to it.
+func (c *client) loadCollectionPage(ctx context.Context, value json.RawMessage) (*activitypub.OrderedCollection, error) {
+	var pageURL string
+	if err := json.Unmarshal(value, &pageURL); err == nil {
+		return c.fetchCollectionPage(ctx, pageURL)
+	}
+
+	var page activitypub.OrderedCollection
+	if err := json.Unmarshal(value, &page); err != nil {
+		return nil, fmt.Errorf("decode collection page: %w", err)
+	}
+
+	return &page, nil
+}
+
+// fetchCollectionPage fetches an ordered collection or a page of it.
+func (c *client) fetchCollectionPage(ctx context.Context, pageURL string) (*activitypub.OrderedCollection, error) {
+	data, err := c.fetchActivityDocument(ctx, pageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var page activitypub.OrderedCollection
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, fmt.Errorf("decode collection page: %w", err)
+	}
+
+	return &page, nil
+}
+
+// fetchActivityDocument fetches an ActivityPub document with a signed request,
+// which is required by the servers running in authorized fetch mode.
+func (c *client) fetchActivityDocument(ctx context.Context, documentURL string) ([]byte, error) {
+	parsedURL, err := url.Parse(documentURL)
+	if err != nil || parsedURL.Host == "" {
+		return nil, fmt.Errorf("invalid document url %s", documentURL)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, parsedURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	request.Header.Set(headerAccept, activityJSONType)
+	request.Header.Set(headerDate, time.Now().UTC().Format(http.TimeFormat))
+	request.Header.Set(headerHost, parsedURL.Host)
+
+	if err := c.signFetchRequest(request); err != nil {
+		return nil, fmt.Errorf("sign request: %w", err)
+	}
+
+	response, err := c.netHTTPClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("fetch document: %w", err)
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", response.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxDocumentSize))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	return data, nil
+}
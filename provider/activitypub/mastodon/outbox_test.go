@@ -0,0 +1,112 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/rss3-network/node/v2/provider/activitypub"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchOutbox(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	actorID := server.URL + "/users/alice"
+
+	mux.HandleFunc(webFingerPath, func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Query().Get("resource") != fmt.Sprintf("acct:alice@%s", request.Host) {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = fmt.Fprintf(writer, `{"subject":"acct:alice@%s","links":[{"rel":"http://webfinger.net/rel/profile-page","type":"text/html","href":"%s/@alice"},{"rel":"self","type":"application/activity+json","href":%q}]}`, request.Host, server.URL, actorID)
+	})
+
+	mux.HandleFunc("/users/alice", func(writer http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(writer, `{"id":%q,"type":"Person","outbox":"%s/outbox"}`, actorID, actorID)
+	})
+
+	mux.HandleFunc("/users/alice/outbox", func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Query().Get("page") {
+		case "":
+			_, _ = fmt.Fprintf(writer, `{"id":"%s/outbox","type":"OrderedCollection","totalItems":3,"first":"%s/outbox?page=1"}`, actorID, actorID)
+		case "1":
+			_, _ = fmt.Fprintf(writer, `{"type":"OrderedCollectionPage","next":"%[1]s/outbox?page=2","orderedItems":[`+
+				`{"id":"%[1]s/statuses/2/activity","type":"Create","actor":%[1]q,"published":"2024-10-11T08:25:33Z","object":{"id":"%[1]s/statuses/2","type":"Note"}},`+
+				`"%[1]s/statuses/1/activity",`+
+				`{"id":"https://mastodon.social/users/bob/statuses/1/activity","type":"Create","actor":"https://mastodon.social/users/bob"}]}`, actorID)
+		case "2":
+			_, _ = fmt.Fprintf(writer, `{"type":"OrderedCollectionPage","orderedItems":[`+
+				`{"id":"%[1]s/statuses/0/activity","type":"Announce","actor":%[1]q,"published":"2024-10-10T08:25:33Z","object":"https://mastodon.social/users/bob/statuses/1"}]}`, actorID)
+		}
+	})
+
+	localKey, err := GeneratePrivateKey()
+	require.NoError(t, err)
+
+	c := &client{
+		netHTTPClient: *server.Client(),
+		privateKey:    localKey,
+		actor:         &activitypub.Actor{PublicKey: activitypub.PublicKey{ID: "https://node.example/actor#main-key"}},
+	}
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	host := serverURL.Host
+
+	t.Run("Lookup account", func(t *testing.T) {
+		t.Parallel()
+
+		resolvedID, err := c.LookupAccount(context.Background(), fmt.Sprintf("@alice@%s", host))
+		require.NoError(t, err)
+		require.Equal(t, actorID, resolvedID)
+
+		_, err = c.LookupAccount(context.Background(), fmt.Sprintf("@bob@%s", host))
+		require.ErrorIs(t, err, ErrAccountNotFound)
+
+		_, err = c.LookupAccount(context.Background(), "alice")
+		require.Error(t, err)
+	})
+
+	t.Run("Fetch all pages", func(t *testing.T) {
+		t.Parallel()
+
+		var ids []string
+
+		err := c.FetchOutbox(context.Background(), actorID, func(activities []activitypub.Object) (bool, error) {
+			for _, activity := range activities {
+				ids = append(ids, activity.ID)
+			}
+
+			return true, nil
+		})
+		require.NoError(t, err)
+
+		// The linked activities and the activities of other servers are skipped.
+		require.Equal(t, []string{actorID + "/statuses/2/activity", actorID + "/statuses/0/activity"}, ids)
+	})
+
+	t.Run("Stop paging", func(t *testing.T) {
+		t.Parallel()
+
+		var pages int
+
+		err := c.FetchOutbox(context.Background(), actorID, func(_ []activitypub.Object) (bool, error) {
+			pages++
+
+			return false, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, pages)
+	})
+}
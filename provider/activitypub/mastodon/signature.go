@@ -0,0 +1,241 @@
+package mastodon
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+const (
+	// signatureMaxAge is the maximum age of the Date header of a signed request.
+	signatureMaxAge = 12 * time.Hour
+	// signatureClockSkew is the tolerance of the Date header in the future.
+	signatureClockSkew = time.Hour
+
+	publicKeyCacheSize = 1 << 12
+	publicKeyCacheTTL  = 24 * time.Hour
+	maxDocumentSize    = 1 << 20 // 1 MB
+
+	headerSignature       = "Signature"
+	headerAccept          = "Accept"
+	digestAlgorithmSHA256 = "SHA-256"
+)
+
+var (
+	ErrUnsignedRequest   = errors.New("request is not signed")
+	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrMismatchedOrigin  = errors.New("activity actor does not match the signing key")
+	signatureHeadersRule = regexp.MustCompile(`headers="([^"]*)"`)
+)
+
+// actorPublicKey is the public key of a remote actor.
+type actorPublicKey struct {
+	ID    string
+	Owner string
+	Key   *rsa.PublicKey
+}
+
+// verifyInboxRequest verifies the draft-cavage HTTP Signature of a request posted to the inbox,
+// and checks that the actor of the activity belongs to the same origin as the signing key.
+func (c *client) verifyInboxRequest(ctx context.Context, request *http.Request, body []byte) error {
+	if request.Header.Get(headerSignature) == "" {
+		return ErrUnsignedRequest
+	}
+
+	verifier, err := httpsig.NewVerifier(request)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+
+	if err := verifySignedHeaders(request, body); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+
+	publicKey, cached, err := c.loadActorPublicKey(ctx, verifier.KeyId(), false)
+	if err != nil {
+		return fmt.Errorf("load public key %s: %w", verifier.KeyId(), err)
+	}
+
+	if err := verifier.Verify(publicKey.Key, httpsig.RSA_SHA256); err != nil {
+		if !cached {
+			return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+		}
+
+		// The remote actor may have rotated its key since the key was cached.
+		if publicKey, _, err = c.loadActorPublicKey(ctx, verifier.KeyId(), true); err != nil {
+			return fmt.Errorf("reload public key %s: %w", verifier.KeyId(), err)
+		}
+
+		if err := verifier.Verify(publicKey.Key, httpsig.RSA_SHA256); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+		}
+	}
+
+	actor := gjson.GetBytes(body, "actor")
+	if actor.IsObject() {
+		actor = actor.Get("id")
+	}
+
+	if !sameOrigin(actor.String(), publicKey.Owner) {
+		return fmt.Errorf("%w: actor %s, key owner %s", ErrMismatchedOrigin, actor.String(), publicKey.Owner)
+	}
+
+	return nil
+}
+
+// verifySignedHeaders checks that the signature covers the request target, host, date and body digest,
+// and that the date and digest headers match the request.
+func verifySignedHeaders(request *http.Request, body []byte) error {
+	matches := signatureHeadersRule.FindStringSubmatch(request.Header.Get(headerSignature))
+	if len(matches) != 2 {
+		return fmt.Errorf("missing signed headers")
+	}
+
+	signedHeaders := strings.Fields(strings.ToLower(matches[1]))
+
+	for _, header := range []string{httpsig.RequestTarget, strings.ToLower(headerHost), strings.ToLower(headerDate), strings.ToLower(headerDigest)} {
+		if !lo.Contains(signedHeaders, header) {
+			return fmt.Errorf("header %s is not signed", header)
+		}
+	}
+
+	date, err := http.ParseTime(request.Header.Get(headerDate))
+	if err != nil {
+		return fmt.Errorf("invalid date header: %w", err)
+	}
+
+	if age := time.Since(date); age > signatureMaxAge || age < -signatureClockSkew {
+		return fmt.Errorf("date header %s is out of range", date)
+	}
+
+	digest := sha256.Sum256(body)
+
+	for _, value := range strings.Split(request.Header.Get(headerDigest), ",") {
+		algorithm, encoded, _ := strings.Cut(strings.TrimSpace(value), "=")
+		if !strings.EqualFold(algorithm, digestAlgorithmSHA256) {
+			continue
+		}
+
+		if encoded != base64.StdEncoding.EncodeToString(digest[:]) {
+			return fmt.Errorf("digest header does not match the body")
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("missing %s digest", digestAlgorithmSHA256)
+}
+
+// loadActorPublicKey returns the public key from the cache, or fetches it from the remote actor.
+func (c *client) loadActorPublicKey(ctx context.Context, keyID string, refresh bool) (*actorPublicKey, bool, error) {
+	if !refresh {
+		if publicKey, ok := c.publicKeys.Get(keyID); ok {
+			return publicKey, true, nil
+		}
+	}
+
+	publicKey, err := c.fetchActorPublicKey(ctx, keyID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.publicKeys.Add(keyID, publicKey)
+
+	return publicKey, false, nil
+}
+
+// fetchActorPublicKey fetches the public key document or the actor document referenced by the key id.
+func (c *client) fetchActorPublicKey(ctx context.Context, keyID string) (*actorPublicKey, error) {
+	keyURL, err := url.Parse(keyID)
+	if err != nil || keyURL.Host == "" {
+		return nil, fmt.Errorf("invalid key id %s", keyID)
+	}
+
+	keyURL.Fragment = ""
+
+	data, err := c.fetchActivityDocument(ctx, keyURL.String())
+	if err != nil {
+		return nil, fmt.Errorf("fetch public key: %w", err)
+	}
+
+	document := gjson.ParseBytes(data)
+	owner := document.Get("owner").String()
+
+	// The key id usually references the actor document, which embeds the public key.
+	if publicKey := document.Get("publicKey"); publicKey.Exists() {
+		if publicKey.IsArray() {
+			publicKey, _ = lo.Find(publicKey.Array(), func(element gjson.Result) bool {
+				return element.Get("id").String() == keyID
+			})
+		}
+
+		owner = lo.CoalesceOrEmpty(publicKey.Get("owner").String(), document.Get("id").String())
+		document = publicKey
+	}
+
+	if id := document.Get("id").String(); id != keyID {
+		return nil, fmt.Errorf("key id %s does not match the document %s", keyID, id)
+	}
+
+	// The key must be owned by an actor of the same server.
+	if !sameOrigin(owner, keyID) {
+		return nil, fmt.Errorf("key owner %s does not match the key id", owner)
+	}
+
+	key, err := parsePublicKey(document.Get("publicKeyPem").String())
+	if err != nil {
+		return nil, err
+	}
+
+	zap.L().Debug("fetched actor public key", zap.String("keyID", keyID), zap.String("owner", owner))
+
+	return &actorPublicKey{
+		ID:    keyID,
+		Owner: owner,
+		Key:   key,
+	}, nil
+}
+
+// signFetchRequest signs a GET request with the key of the actor.
+func (c *client) signFetchRequest(request *http.Request) error {
+	signer, _, err := httpsig.NewSigner(
+		[]httpsig.Algorithm{httpsig.RSA_SHA256},
+		httpsig.DigestSha256,
+		[]string{httpsig.RequestTarget, headerHost, headerDate},
+		httpsig.Signature,
+		sigExpiry,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to make a NewSigner call: %w", err)
+	}
+
+	return signer.SignRequest(c.privateKey, c.actor.PublicKey.ID, request, nil)
+}
+
+// sameOrigin reports whether the URLs belong to the same scheme and host.
+func sameOrigin(a, b string) bool {
+	aURL, err := url.Parse(a)
+	if err != nil || aURL.Host == "" {
+		return false
+	}
+
+	bURL, err := url.Parse(b)
+	if err != nil || bURL.Host == "" {
+		return false
+	}
+
+	return strings.EqualFold(aURL.Scheme, bURL.Scheme) && strings.EqualFold(aURL.Host, bURL.Host)
+}
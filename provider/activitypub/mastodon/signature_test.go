@@ -0,0 +1,141 @@
+package mastodon
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/rss3-network/node/v2/provider/activitypub"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyInboxRequest(t *testing.T) {
+	t.Parallel()
+
+	remoteKey, err := GeneratePrivateKey()
+	require.NoError(t, err)
+
+	remotePublicKeyPem, err := encodePublicKey(&remoteKey.PublicKey)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		actorID := fmt.Sprintf("http://%s/users/alice", request.Host)
+
+		writer.Header().Set(headerContentType, activityJSONType)
+
+		_, _ = fmt.Fprintf(writer, `{"id":%q,"type":"Person","publicKey":{"id":%q,"owner":%q,"publicKeyPem":%q}}`, actorID, actorID+"#main-key", actorID, remotePublicKeyPem)
+	}))
+	t.Cleanup(server.Close)
+
+	localKey, err := GeneratePrivateKey()
+	require.NoError(t, err)
+
+	c := &client{
+		netHTTPClient: *server.Client(),
+		privateKey:    localKey,
+		actor:         &activitypub.Actor{PublicKey: activitypub.PublicKey{ID: "https://node.example/actor#main-key"}},
+		publicKeys:    expirable.NewLRU[string, *actorPublicKey](publicKeyCacheSize, nil, publicKeyCacheTTL),
+	}
+
+	keyID := server.URL + "/users/alice#main-key"
+	body := []byte(fmt.Sprintf(`{"type":"Create","actor":"%s/users/alice"}`, server.URL))
+
+	newRequest := func(body []byte, signingKey *rsa.PrivateKey, headers []string) *http.Request {
+		request := httptest.NewRequest(http.MethodPost, "https://node.example/inbox", bytes.NewReader(body))
+		request.Header.Set(headerHost, request.Host)
+		request.Header.Set(headerDate, time.Now().UTC().Format(http.TimeFormat))
+
+		if signingKey == nil {
+			return request
+		}
+
+		signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, headers, httpsig.Signature, sigExpiry)
+		require.NoError(t, err)
+		require.NoError(t, signer.SignRequest(signingKey, keyID, request, body))
+
+		return request
+	}
+
+	defaultHeaders := []string{httpsig.RequestTarget, headerHost, headerDate, headerDigest}
+
+	testcases := []struct {
+		name      string
+		request   *http.Request
+		body      []byte
+		wantError error
+	}{
+		{
+			name:    "Valid signature",
+			request: newRequest(body, remoteKey, defaultHeaders),
+			body:    body,
+		},
+		{
+			name:      "Unsigned request",
+			request:   newRequest(body, nil, nil),
+			body:      body,
+			wantError: ErrUnsignedRequest,
+		},
+		{
+			name:      "Unknown key",
+			request:   newRequest(body, localKey, defaultHeaders),
+			body:      body,
+			wantError: ErrInvalidSignature,
+		},
+		{
+			name:      "Tampered body",
+			request:   newRequest(body, remoteKey, defaultHeaders),
+			body:      []byte(fmt.Sprintf(`{"type":"Delete","actor":"%s/users/alice"}`, server.URL)),
+			wantError: ErrInvalidSignature,
+		},
+		{
+			name:      "Unsigned digest",
+			request:   newRequest(body, remoteKey, []string{httpsig.RequestTarget, headerHost, headerDate}),
+			body:      body,
+			wantError: ErrInvalidSignature,
+		},
+		{
+			name:      "Mismatched origin",
+			request:   newRequest([]byte(`{"type":"Create","actor":"https://mastodon.social/users/bob"}`), remoteKey, defaultHeaders),
+			body:      []byte(`{"type":"Create","actor":"https://mastodon.social/users/bob"}`),
+			wantError: ErrMismatchedOrigin,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.verifyInboxRequest(context.Background(), testcase.request, testcase.body)
+			if testcase.wantError == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, testcase.wantError)
+		})
+	}
+}
+
+func TestPrivateKeyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := GeneratePrivateKey()
+	require.NoError(t, err)
+
+	privateKeyPem, err := MarshalPrivateKey(privateKey)
+	require.NoError(t, err)
+
+	parsedKey, err := ParsePrivateKey(privateKeyPem)
+	require.NoError(t, err)
+	require.True(t, privateKey.Equal(parsedKey))
+}
@@ -8,6 +8,10 @@ const (
 	MessageTypeCreate                      // Create ActivityPub message
 	MessageTypeAnnounce                    // Announce ActivityPub message
 	MessageTypeLike                        // Like ActivityPub message
+	MessageTypeUpdate                      // Update ActivityPub message
+	MessageTypeDelete                      // Delete ActivityPub message
+	MessageTypeFollow                      // Follow ActivityPub message
+	MessageTypeUndo                        // Undo ActivityPub message
 )
 
 // ActivityPub standard contexts and public addressing
@@ -42,6 +46,10 @@ const (
 	Tag                 = "tag"
 	TagTypeHashtag      = "Hashtag"
 	TagTypeMention      = "Mention"
+	ObjectTypeNote      = "Note"
+	ObjectTypeArticle   = "Article"
+	ObjectTypePage      = "Page"
+	ObjectTypeQuestion  = "Question"
 )
 
 // HTTP paths and headers
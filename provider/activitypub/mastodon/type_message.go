@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _MessageTypeName = "NoneCreateAnnounceLike"
+const _MessageTypeName = "NoneCreateAnnounceLikeUpdateDeleteFollowUndo"
 
-var _MessageTypeIndex = [...]uint8{0, 4, 10, 18, 22}
+var _MessageTypeIndex = [...]uint8{0, 4, 10, 18, 22, 28, 34, 40, 44}
 
-const _MessageTypeLowerName = "nonecreateannouncelike"
+const _MessageTypeLowerName = "nonecreateannouncelikeupdatedeletefollowundo"
 
 func (i MessageType) String() string {
 	if i < 0 || i >= MessageType(len(_MessageTypeIndex)-1) {
@@ -32,9 +32,13 @@ func _MessageTypeNoOp() {
 	_ = x[MessageTypeCreate-(1)]
 	_ = x[MessageTypeAnnounce-(2)]
 	_ = x[MessageTypeLike-(3)]
+	_ = x[MessageTypeUpdate-(4)]
+	_ = x[MessageTypeDelete-(5)]
+	_ = x[MessageTypeFollow-(6)]
+	_ = x[MessageTypeUndo-(7)]
 }
 
-var _MessageTypeValues = []MessageType{MessageTypeNone, MessageTypeCreate, MessageTypeAnnounce, MessageTypeLike}
+var _MessageTypeValues = []MessageType{MessageTypeNone, MessageTypeCreate, MessageTypeAnnounce, MessageTypeLike, MessageTypeUpdate, MessageTypeDelete, MessageTypeFollow, MessageTypeUndo}
 
 var _MessageTypeNameToValueMap = map[string]MessageType{
 	_MessageTypeName[0:4]:        MessageTypeNone,
@@ -45,6 +49,14 @@ var _MessageTypeNameToValueMap = map[string]MessageType{
 	_MessageTypeLowerName[10:18]: MessageTypeAnnounce,
 	_MessageTypeName[18:22]:      MessageTypeLike,
 	_MessageTypeLowerName[18:22]: MessageTypeLike,
+	_MessageTypeName[22:28]:      MessageTypeUpdate,
+	_MessageTypeLowerName[22:28]: MessageTypeUpdate,
+	_MessageTypeName[28:34]:      MessageTypeDelete,
+	_MessageTypeLowerName[28:34]: MessageTypeDelete,
+	_MessageTypeName[34:40]:      MessageTypeFollow,
+	_MessageTypeLowerName[34:40]: MessageTypeFollow,
+	_MessageTypeName[40:44]:      MessageTypeUndo,
+	_MessageTypeLowerName[40:44]: MessageTypeUndo,
 }
 
 var _MessageTypeNames = []string{
@@ -52,6 +64,10 @@ var _MessageTypeNames = []string{
 	_MessageTypeName[4:10],
 	_MessageTypeName[10:18],
 	_MessageTypeName[18:22],
+	_MessageTypeName[22:28],
+	_MessageTypeName[28:34],
+	_MessageTypeName[34:40],
+	_MessageTypeName[40:44],
 }
 
 // MessageTypeString retrieves an enum value from the enum constants string name.
@@ -1,6 +1,9 @@
 package activitypub
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Object represents a general ActivityPub object or activity.
 type Object struct {
@@ -135,3 +138,28 @@ type InstanceInfo struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 }
+
+// WebFinger represents the JSON Resource Descriptor of an account.
+type WebFinger struct {
+	Subject string          `json:"subject"`
+	Aliases []string        `json:"aliases,omitempty"`
+	Links   []WebFingerLink `json:"links"`
+}
+
+// WebFingerLink represents a link in the JSON Resource Descriptor.
+type WebFingerLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href,omitempty"`
+}
+
+// OrderedCollection represents an ordered collection or a page of it, such as the outbox of an actor.
+// The first page and the items may be embedded objects or links.
+type OrderedCollection struct {
+	ID           string            `json:"id"`
+	Type         string            `json:"type"`
+	TotalItems   int64             `json:"totalItems,omitempty"`
+	First        json.RawMessage   `json:"first,omitempty"`
+	Next         string            `json:"next,omitempty"`
+	OrderedItems []json.RawMessage `json:"orderedItems,omitempty"`
+}
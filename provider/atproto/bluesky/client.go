@@ -18,6 +18,7 @@ import (
 	"github.com/bluesky-social/indigo/atproto/syntax"
 	"github.com/bluesky-social/indigo/repo"
 	"github.com/bluesky-social/indigo/xrpc"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/ipfs/go-cid"
 	at "github.com/rss3-network/node/v2/provider/atproto"
 	"github.com/samber/lo"
@@ -31,6 +32,13 @@ const (
 	BskySubscribeURI = "wss://bsky.network/xrpc/com.atproto.sync.subscribeRepos"
 
 	SyncListReposLimit = 10
+
+	// RepoOpDelete is the action of the firehose operations deleting a record.
+	RepoOpDelete = "delete"
+
+	// handleCacheSize and handleCacheTTL bound the cache of the handles resolved for the streamed records.
+	handleCacheSize = 100_000
+	handleCacheTTL  = time.Hour
 )
 
 type Client struct {
@@ -42,6 +50,7 @@ type Client struct {
 	defaultClient  *XrpcClient
 	cacheClient    map[string]*XrpcClient
 	httpClient     *http.Client
+	handles        *expirable.LRU[syntax.DID, string]
 }
 
 type XrpcClient struct {
@@ -240,6 +249,161 @@ func (c *Client) ParseCARList(ctx context.Context, did syntax.DID, handle string
 	return recList, nil
 }
 
+// ParseCommit decodes the records created or updated by a firehose commit from the blocks it carries,
+// so the records do not need to be fetched from the PDS of the repository.
+// The records of commits that are too big to carry their blocks are fetched from the PDS instead.
+// Returns the messages of the records matching the filter, followed by the messages of the deleted records.
+func (c *Client) ParseCommit(ctx context.Context, commit *atproto.SyncSubscribeRepos_Commit) ([]*at.Message, error) {
+	// Deleted records are not carried by the commit, only their paths are known.
+	deleted := lo.FilterMap(commit.Ops, func(op *atproto.SyncSubscribeRepos_RepoOp, _ int) (*at.Message, bool) {
+		if op.Action != RepoOpDelete {
+			return nil, false
+		}
+
+		timestamp, err := dateparse.ParseAny(commit.Time)
+		if err != nil {
+			timestamp = time.Now()
+		}
+
+		return c.BuildDeletedMessage(commit.Repo, op.Path, timestamp)
+	})
+
+	ops := lo.Filter(commit.Ops, func(op *atproto.SyncSubscribeRepos_RepoOp, _ int) bool {
+		collection, _ := c.ParsePath(op.Path)
+
+		return op.Cid != nil && lo.Contains(c.filter, collection)
+	})
+
+	if len(ops) == 0 {
+		return deleted, nil
+	}
+
+	if commit.TooBig || len(commit.Blocks) == 0 {
+		messages, err := c.getRepoRecords(ctx, commit.Repo, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(messages, deleted...), nil
+	}
+
+	r, err := repo.ReadRepoFromCar(ctx, bytes.NewReader(commit.Blocks))
+	if err != nil {
+		zap.L().Warn("read commit blocks failed, fetching records instead", zap.String("repo", commit.Repo), zap.Error(err))
+
+		messages, err := c.getRepoRecords(ctx, commit.Repo, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(messages, deleted...), nil
+	}
+
+	did, err := syntax.ParseDID(commit.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("parse DID: %w", err)
+	}
+
+	// The handle is resolved once for all records of the commit.
+	handle, err := c.ResolveHandle(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*at.Message, 0, len(ops))
+
+	for _, op := range ops {
+		_, rec, err := r.GetRecord(ctx, op.Path)
+		if err != nil {
+			zap.L().Warn("get record from commit blocks failed, fetching record instead", zap.String("repo", commit.Repo), zap.String("path", op.Path), zap.Error(err))
+
+			message, err := c.GetRepoRecord(ctx, commit.Repo, op.Path)
+			if err != nil {
+				return nil, err
+			}
+
+			if message != nil {
+				messages = append(messages, message)
+			}
+
+			continue
+		}
+
+		collection, rkey := c.ParsePath(op.Path)
+
+		message := &at.Message{
+			URI:        c.BuildURI(did, collection, rkey),
+			Did:        did,
+			Handle:     handle,
+			Collection: collection,
+			Rkey:       rkey,
+		}
+
+		isValid, err := c.ParseRecord(ctx, rec, message)
+		if err != nil {
+			zap.L().Error("parse record failed", zap.Error(err))
+
+			continue
+		}
+
+		if isValid {
+			messages = append(messages, message)
+		}
+	}
+
+	return append(messages, deleted...), nil
+}
+
+// BuildDeletedMessage builds the message of a deleted record matching the filter.
+// Parameters:
+// - repo: The DID of the repository
+// - path: The path in format "collection/rkey"
+// - timestamp: The time of the deletion
+// Returns the message and whether the record matches the filter.
+func (c *Client) BuildDeletedMessage(repo string, path string, timestamp time.Time) (*at.Message, bool) {
+	collection, rkey := c.ParsePath(path)
+
+	if !lo.Contains(c.filter, collection) {
+		return nil, false
+	}
+
+	did, err := syntax.ParseDID(repo)
+	if err != nil {
+		zap.L().Warn("parse DID failed", zap.Error(err), zap.String("repo", repo))
+
+		return nil, false
+	}
+
+	return &at.Message{
+		URI:        c.BuildURI(did, collection, rkey),
+		Did:        did,
+		Collection: collection,
+		Rkey:       rkey,
+		CreatedAt:  timestamp,
+		Deleted:    true,
+	}, true
+}
+
+// getRepoRecords fetches the records of the operations from the PDS of the repository.
+func (c *Client) getRepoRecords(ctx context.Context, repo string, ops []*atproto.SyncSubscribeRepos_RepoOp) ([]*at.Message, error) {
+	messages := make([]*at.Message, 0, len(ops))
+
+	for _, op := range ops {
+		message, err := c.GetRepoRecord(ctx, repo, op.Path)
+		if err != nil {
+			zap.L().Error("get subscribe repo record failed", zap.Error(err), zap.String("repo", repo), zap.Any("op", op))
+
+			return nil, fmt.Errorf("get subscribe repo record failed: %w", err)
+		}
+
+		if message != nil {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, nil
+}
+
 // GetRepoRecord retrieves a specific record from a repository.
 // Parameters:
 // - repo: The DID of the repository
@@ -291,12 +455,12 @@ func (c *Client) GetRecord(ctx context.Context, repo string, path string) (*at.M
 		return nil, nil, fmt.Errorf("create xrpc client: %w", err)
 	}
 
-	// Fetch the handle associated with the DID
-	handle, err := c.GetHandle(ctx, client, did)
+	// Fetch the handle associated with the DID, the records of a commit share the cached handle.
+	handle, err := c.ResolveHandle(ctx, did)
 	if err != nil {
-		zap.L().Error("get profile failed", zap.Error(err))
+		zap.L().Error("resolve handle failed", zap.Error(err))
 
-		return nil, nil, fmt.Errorf("get profile: %w", err)
+		return nil, nil, err
 	}
 
 	message := &at.Message{
@@ -346,6 +510,33 @@ func (c *Client) GetRecord(ctx context.Context, repo string, path string) (*at.M
 	return message, nil, nil
 }
 
+// ResolveHandle resolves the handle of a DID from the PDS hosting its repository,
+// the handles are cached as the streamed records of an account usually come in bursts.
+func (c *Client) ResolveHandle(ctx context.Context, did syntax.DID) (string, error) {
+	if handle, ok := c.handles.Get(did); ok {
+		return handle, nil
+	}
+
+	client, err := c.GetXrpcClient(ctx, c.LookupDIDEndpoint(ctx, did))
+	if err != nil {
+		return "", fmt.Errorf("create xrpc client: %w", err)
+	}
+
+	handle, err := c.GetHandle(ctx, client, did)
+	if err != nil {
+		return "", fmt.Errorf("get profile: %w", err)
+	}
+
+	c.handles.Add(did, handle)
+
+	return handle, nil
+}
+
+// EvictHandle removes the cached handle of a DID, it is resolved again once the account has changed its identity.
+func (c *Client) EvictHandle(did syntax.DID) {
+	c.handles.Remove(did)
+}
+
 // GetHandle retrieves the handle (username) for a given DID.
 // Parameters:
 // - did: User's decentralized identifier
@@ -463,6 +654,38 @@ func (c *Client) ParseRecord(ctx context.Context, rec cbg.CBORMarshaler, message
 		}
 
 		message.CreatedAt = createdAt
+	case *bsky.GraphFollow:
+		createdAt, isValid := c.ParseCreatedAt(ctx, rec.CreatedAt)
+		if !isValid {
+			return false, nil
+		}
+
+		message.CreatedAt = createdAt
+		message.Follow = rec
+	case *bsky.GraphBlock:
+		createdAt, isValid := c.ParseCreatedAt(ctx, rec.CreatedAt)
+		if !isValid {
+			return false, nil
+		}
+
+		message.CreatedAt = createdAt
+		message.Block = rec
+	case *bsky.GraphList:
+		createdAt, isValid := c.ParseCreatedAt(ctx, rec.CreatedAt)
+		if !isValid {
+			return false, nil
+		}
+
+		message.CreatedAt = createdAt
+		message.List = rec
+	case *bsky.GraphListitem:
+		createdAt, isValid := c.ParseCreatedAt(ctx, rec.CreatedAt)
+		if !isValid {
+			return false, nil
+		}
+
+		message.CreatedAt = createdAt
+		message.ListItem = rec
 	default:
 		return false, nil
 	}
@@ -691,6 +914,7 @@ func NewClient(_ context.Context, username string, password string, options ...O
 		password:    password,
 		cacheClient: make(map[string]*XrpcClient),
 		httpClient:  http.DefaultClient,
+		handles:     expirable.NewLRU[syntax.DID, string](handleCacheSize, nil, handleCacheTTL),
 	}
 
 	defaultXrpcClient, err := client.createAndAuthenticateClient(context.Background(), BskyEndpoint)
@@ -0,0 +1,144 @@
+package bluesky
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	lexutil "github.com/bluesky-social/indigo/lex/util"
+	at "github.com/rss3-network/node/v2/provider/atproto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	JetstreamURI = "wss://jetstream2.us-east.bsky.network/subscribe"
+
+	JetstreamKindCommit   = "commit"
+	JetstreamKindIdentity = "identity"
+	JetstreamKindAccount  = "account"
+
+	JetstreamOperationCreate = "create"
+	JetstreamOperationUpdate = "update"
+	JetstreamOperationDelete = "delete"
+)
+
+// JetstreamEvent is an event of a Jetstream instance, which serves the repository events of a relay as JSON.
+type JetstreamEvent struct {
+	Did    string           `json:"did"`
+	TimeUS int64            `json:"time_us"`
+	Kind   string           `json:"kind"`
+	Commit *JetstreamCommit `json:"commit,omitempty"`
+}
+
+// JetstreamCommit is a record operation of a commit event.
+type JetstreamCommit struct {
+	Rev        string          `json:"rev"`
+	Operation  string          `json:"operation"`
+	Collection string          `json:"collection"`
+	Rkey       string          `json:"rkey"`
+	Record     json.RawMessage `json:"record,omitempty"`
+	Cid        string          `json:"cid,omitempty"`
+}
+
+// BuildJetstreamURI builds the subscription URL of a Jetstream instance, filtered by the collections
+// and resuming from the cursor, which is the time of the last event in microseconds.
+func BuildJetstreamURI(endpoint string, collections []string, cursor int64) (string, error) {
+	uri, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("parse url: %w", err)
+	}
+
+	query := uri.Query()
+
+	for _, collection := range collections {
+		query.Add("wantedCollections", collection)
+	}
+
+	if cursor > 0 {
+		query.Set("cursor", strconv.FormatInt(cursor, 10))
+	}
+
+	uri.RawQuery = query.Encode()
+
+	return uri.String(), nil
+}
+
+// ParseJetstreamEvent decodes the record created, updated or deleted by a Jetstream commit event,
+// and evicts the cached handle of the account of an identity event.
+// Returns nil if the event does not carry a record matching the filter.
+func (c *Client) ParseJetstreamEvent(ctx context.Context, event *JetstreamEvent) (*at.Message, error) {
+	switch event.Kind {
+	case JetstreamKindIdentity:
+		// The cached handle is stale once the account has changed its handle.
+		if did, err := syntax.ParseDID(event.Did); err == nil {
+			c.EvictHandle(did)
+		}
+
+		return nil, nil
+	case JetstreamKindCommit:
+	default:
+		return nil, nil
+	}
+
+	commit := event.Commit
+
+	if commit == nil || !lo.Contains(c.filter, commit.Collection) {
+		return nil, nil
+	}
+
+	if commit.Operation == JetstreamOperationDelete {
+		if message, ok := c.BuildDeletedMessage(event.Did, commit.Collection+"/"+commit.Rkey, time.UnixMicro(event.TimeUS)); ok {
+			return message, nil
+		}
+
+		return nil, nil
+	}
+
+	if !lo.Contains([]string{JetstreamOperationCreate, JetstreamOperationUpdate}, commit.Operation) || len(commit.Record) == 0 {
+		return nil, nil
+	}
+
+	var record lexutil.LexiconTypeDecoder
+
+	if err := json.Unmarshal(commit.Record, &record); err != nil {
+		zap.L().Warn("decode jetstream record failed", zap.String("did", event.Did), zap.String("collection", commit.Collection), zap.Error(err))
+
+		return nil, nil
+	}
+
+	did, err := syntax.ParseDID(event.Did)
+	if err != nil {
+		return nil, fmt.Errorf("parse DID: %w", err)
+	}
+
+	handle, err := c.ResolveHandle(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &at.Message{
+		URI:        c.BuildURI(did, commit.Collection, commit.Rkey),
+		Did:        did,
+		Handle:     handle,
+		Collection: commit.Collection,
+		Rkey:       commit.Rkey,
+	}
+
+	isValid, err := c.ParseRecord(ctx, record.Val, message)
+	if err != nil {
+		zap.L().Error("parse record failed", zap.Error(err))
+
+		return nil, nil
+	}
+
+	if !isValid {
+		return nil, nil
+	}
+
+	return message, nil
+}
@@ -18,4 +18,12 @@ type Message struct {
 	Feed       *bsky.FeedPost
 	Profile    *bsky.ActorProfile
 	RefMessage *Message
+
+	Follow   *bsky.GraphFollow
+	Block    *bsky.GraphBlock
+	List     *bsky.GraphList
+	ListItem *bsky.GraphListitem
+
+	// Deleted is set if the record has been deleted, only its URI is known and CreatedAt is the time of the deletion.
+	Deleted bool
 }
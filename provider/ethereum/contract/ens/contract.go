@@ -15,6 +15,7 @@ import (
 //go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi abi/PublicResolverV1.abi --pkg ens --type PublicResolverV1 --out contract_public_resolver_v1.go
 //go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi abi/PublicResolverV2.abi --pkg ens --type PublicResolverV2 --out contract_public_resolver_v2.go
 //go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi abi/NameWrapper.abi --pkg ens --type NameWrapper --out contract_name_wrapper.go
+//go:generate go run -mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi abi/ENSRegistry.abi --pkg ens --type ENSRegistry --out contract_ens_registry.go
 
 var (
 	AddressBaseRegistrarImplementation = common.HexToAddress("0x57f1887a8BF19b14fC0dF6Fd9B2acc9Af147eA85")
@@ -23,6 +24,7 @@ var (
 	AddressPublicResolverV1            = common.HexToAddress("0x4976fb03C32e5B8cfe2b6cCB31c09Ba78EBaBa41")
 	AddressPublicResolverV2            = common.HexToAddress("0x231b0ee14048e9dccd1d247744d114a4eb5e8e63")
 	AddressNameWrapper                 = common.HexToAddress("0xD4416b13d2b3a9aBae7AcD5D6C2BbDBE25686401")
+	AddressENSRegistry                 = common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
 
 	EventNameRegisteredControllerV1 = contract.EventHash("NameRegistered(string,bytes32,address,uint256,uint256)")
 	EventNameRegisteredControllerV2 = contract.EventHash("NameRegistered(string,bytes32,address,uint256,uint256,uint256)")
@@ -78,7 +78,8 @@ type CastID struct {
 }
 
 type Embed struct {
-	URL string `json:"url"`
+	URL    string  `json:"url,omitempty"`
+	CastID *CastID `json:"castId,omitempty"`
 }
 
 type CastRemoveBody struct {
@@ -98,9 +99,10 @@ type ReactionBody struct {
 }
 
 type LinkBody struct {
-	Type             string `json:"type"`
-	DisplayTimestamp uint32 `json:"displayTimestamp"`
-	TargetFid        uint64 `json:"targetFid"`
+	Type             string         `json:"type"`
+	DisplayTimestamp uint32         `json:"displayTimestamp"`
+	TargetFid        uint64         `json:"targetFid"`
+	TargetProfile    *model.Profile `json:"targetProfile,omitempty"`
 }
 
 type VerificationAddEthAddressBody struct {
@@ -153,7 +155,28 @@ type MergeUserNameProofBody struct {
 	DeletedUsernameProofMessage Message       `json:"deletedUsernameProofMessage"`
 }
 
-type MergeOnChainEventBody struct{}
+type MergeOnChainEventBody struct {
+	OnChainEvent OnChainEvent `json:"onChainEvent"`
+}
+
+type OnChainEvent struct {
+	Type                string               `json:"type"`
+	ChainID             uint32               `json:"chainId"`
+	BlockNumber         uint32               `json:"blockNumber"`
+	BlockHash           string               `json:"blockHash"`
+	BlockTimestamp      uint64               `json:"blockTimestamp"`
+	TransactionHash     string               `json:"transactionHash"`
+	LogIndex            uint32               `json:"logIndex"`
+	Fid                 uint64               `json:"fid"`
+	IDRegisterEventBody *IDRegisterEventBody `json:"idRegisterEventBody,omitempty"`
+}
+
+type IDRegisterEventBody struct {
+	To              string `json:"to"`
+	EventType       string `json:"eventType"`
+	From            string `json:"from"`
+	RecoveryAddress string `json:"recoveryAddress"`
+}
 
 // Profile redis profile
 type Profile struct {
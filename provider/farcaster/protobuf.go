@@ -0,0 +1,236 @@
+package farcaster
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mr-tron/base58"
+	"github.com/rss3-network/node/v2/provider/farcaster/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// The events of the gRPC API are decoded into the messages generated from the Hub protobuf schemas,
+// and converted into the types of the HTTP API, so that the events of both transports are handled the same way.
+
+// DecodeHubEvent decodes a HubEvent protobuf message.
+func DecodeHubEvent(data []byte) (*HubEvent, error) {
+	var event protobuf.HubEvent
+
+	if err := proto.Unmarshal(data, &event); err != nil {
+		return nil, fmt.Errorf("decode hub event: %w", err)
+	}
+
+	return NewHubEvent(&event), nil
+}
+
+// NewHubEvent converts a HubEvent protobuf message into the event of the HTTP API.
+func NewHubEvent(event *protobuf.HubEvent) *HubEvent {
+	result := HubEvent{
+		Type: HubEventType(event.GetType()).String(),
+		ID:   event.GetId(),
+	}
+
+	switch body := event.GetBody().(type) {
+	case *protobuf.HubEvent_MergeMessageBody:
+		result.MergeMessageBody = &MergeMessageBody{
+			Message: newMessage(body.MergeMessageBody.GetMessage()),
+		}
+
+		for _, message := range body.MergeMessageBody.GetDeletedMessages() {
+			result.MergeMessageBody.DeletedMessages = append(result.MergeMessageBody.DeletedMessages, newMessage(message))
+		}
+	case *protobuf.HubEvent_PruneMessageBody:
+		result.PruneMessageBody = &PruneMessageBody{
+			Message: newMessage(body.PruneMessageBody.GetMessage()),
+		}
+	case *protobuf.HubEvent_RevokeMessageBody:
+		result.RevokeMessageBody = &RevokeMessageBody{
+			Message: newMessage(body.RevokeMessageBody.GetMessage()),
+		}
+	case *protobuf.HubEvent_MergeUsernameProofBody:
+		result.MergeUserNameProofBody = &MergeUserNameProofBody{
+			UserNameProof:               newUserNameProof(body.MergeUsernameProofBody.GetUsernameProof()),
+			DeletedUserNameProof:        newUserNameProof(body.MergeUsernameProofBody.GetDeletedUsernameProof()),
+			UsernameProofMessage:        newMessage(body.MergeUsernameProofBody.GetUsernameProofMessage()),
+			DeletedUsernameProofMessage: newMessage(body.MergeUsernameProofBody.GetDeletedUsernameProofMessage()),
+		}
+	case *protobuf.HubEvent_MergeOnChainEventBody:
+		result.MergeOnChainEventBody = &MergeOnChainEventBody{
+			OnChainEvent: newOnChainEvent(body.MergeOnChainEventBody.GetOnChainEvent()),
+		}
+	}
+
+	return &result
+}
+
+func newMessage(message *protobuf.Message) Message {
+	return Message{
+		Data:            newMessageData(message.GetData()),
+		Hash:            encodeBytes(message.GetHash()),
+		HashScheme:      message.GetHashScheme().String(),
+		Signature:       base64.StdEncoding.EncodeToString(message.GetSignature()),
+		SignatureScheme: message.GetSignatureScheme().String(),
+		Signer:          encodeBytes(message.GetSigner()),
+	}
+}
+
+func newMessageData(data *protobuf.MessageData) MessageData {
+	result := MessageData{
+		Type:      MessageType(data.GetType()).String(),
+		Fid:       data.GetFid(),
+		Timestamp: data.GetTimestamp(),
+		Network:   data.GetNetwork().String(),
+	}
+
+	switch body := data.GetBody().(type) {
+	case *protobuf.MessageData_CastAddBody:
+		result.CastAddBody = newCastAddBody(body.CastAddBody)
+	case *protobuf.MessageData_CastRemoveBody:
+		result.CastRemoveBody = &CastRemoveBody{
+			TargetHash: encodeBytes(body.CastRemoveBody.GetTargetHash()),
+		}
+	case *protobuf.MessageData_ReactionBody:
+		result.ReactionBody = &ReactionBody{
+			Type:      ReactionType(body.ReactionBody.GetType()).String(),
+			TargetURL: body.ReactionBody.GetTargetUrl(),
+		}
+
+		if castID := body.ReactionBody.GetTargetCastId(); castID != nil {
+			result.ReactionBody.TargetCastID = newCastID(castID)
+		}
+	case *protobuf.MessageData_VerificationAddAddressBody:
+		protocol := AccountType(body.VerificationAddAddressBody.GetProtocol())
+
+		result.VerificationAddEthAddressBody = &VerificationAddEthAddressBody{
+			Address:      encodeAddress(body.VerificationAddAddressBody.GetAddress(), protocol),
+			EthSignature: base64.StdEncoding.EncodeToString(body.VerificationAddAddressBody.GetClaimSignature()),
+			BlockHash:    encodeBytes(body.VerificationAddAddressBody.GetBlockHash()),
+			Protocol:     protocol.String(),
+		}
+	case *protobuf.MessageData_VerificationRemoveBody:
+		result.VerificationRemoveBody = &VerificationRemoveBody{
+			Address: encodeAddress(body.VerificationRemoveBody.GetAddress(), AccountType(body.VerificationRemoveBody.GetProtocol())),
+		}
+	case *protobuf.MessageData_UserDataBody:
+		result.UserDataBody = &UserDataBody{
+			Type:  UserDataType(body.UserDataBody.GetType()).String(),
+			Value: body.UserDataBody.GetValue(),
+		}
+	case *protobuf.MessageData_LinkBody:
+		result.LinkBody = &LinkBody{
+			Type:             body.LinkBody.GetType(),
+			DisplayTimestamp: body.LinkBody.GetDisplayTimestamp(),
+			TargetFid:        body.LinkBody.GetTargetFid(),
+		}
+	case *protobuf.MessageData_UsernameProofBody:
+		proof := newUserNameProof(body.UsernameProofBody)
+		result.UserNameProof = &proof
+	}
+
+	return result
+}
+
+func newCastAddBody(body *protobuf.CastAddBody) *CastAddBody {
+	result := CastAddBody{
+		EmbedsDeprecated: body.GetEmbedsDeprecated(),
+		Mentions:         body.GetMentions(),
+		ParentURL:        body.GetParentUrl(),
+		Text:             body.GetText(),
+	}
+
+	if castID := body.GetParentCastId(); castID != nil {
+		parentCastID := newCastID(castID)
+		result.ParentCastID = &parentCastID
+	}
+
+	for _, position := range body.GetMentionsPositions() {
+		result.MentionsPositions = append(result.MentionsPositions, int32(position))
+	}
+
+	for _, embed := range body.GetEmbeds() {
+		value := Embed{URL: embed.GetUrl()}
+
+		if castID := embed.GetCastId(); castID != nil {
+			embedCastID := newCastID(castID)
+			value.CastID = &embedCastID
+		}
+
+		result.Embeds = append(result.Embeds, value)
+	}
+
+	return &result
+}
+
+func newCastID(castID *protobuf.CastId) CastID {
+	return CastID{
+		Fid:  castID.GetFid(),
+		Hash: encodeBytes(castID.GetHash()),
+	}
+}
+
+func newUserNameProof(proof *protobuf.UserNameProof) UserNameProof {
+	return UserNameProof{
+		Timestamp: uint32(proof.GetTimestamp()),
+		Name:      string(proof.GetName()),
+		Owner:     encodeBytes(proof.GetOwner()),
+		Signature: base64.StdEncoding.EncodeToString(proof.GetSignature()),
+		Fid:       proof.GetFid(),
+		Type:      UsernameProofType(proof.GetType()).String(),
+	}
+}
+
+func newOnChainEvent(event *protobuf.OnChainEvent) OnChainEvent {
+	result := OnChainEvent{
+		Type:            event.GetType().String(),
+		ChainID:         event.GetChainId(),
+		BlockNumber:     event.GetBlockNumber(),
+		BlockHash:       encodeBytes(event.GetBlockHash()),
+		BlockTimestamp:  event.GetBlockTimestamp(),
+		TransactionHash: encodeBytes(event.GetTransactionHash()),
+		LogIndex:        event.GetLogIndex(),
+		Fid:             event.GetFid(),
+	}
+
+	if body := event.GetIdRegisterEventBody(); body != nil {
+		result.IDRegisterEventBody = &IDRegisterEventBody{
+			To:              encodeBytes(body.GetTo()),
+			EventType:       body.GetEventType().String(),
+			From:            encodeBytes(body.GetFrom()),
+			RecoveryAddress: encodeBytes(body.GetRecoveryAddress()),
+		}
+	}
+
+	return result
+}
+
+// encodeBytes encodes the bytes in hex the way the HTTP API does, the empty bytes of unset fields are left empty.
+func encodeBytes(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	return hexutil.Encode(data)
+}
+
+// encodeAddress encodes an address the way the HTTP API does, in hex for Ethereum and in base58 for Solana.
+func encodeAddress(address []byte, protocol AccountType) string {
+	if protocol == ProtocolSolana {
+		return base58.Encode(address)
+	}
+
+	return hexutil.Encode(address)
+}
+
+// newSubscribeRequest builds the request subscribing to the events of the types from the event ID.
+func newSubscribeRequest(eventTypes []HubEventType, fromEventID *uint64) *protobuf.SubscribeRequest {
+	request := protobuf.SubscribeRequest{
+		FromId: fromEventID,
+	}
+
+	for _, eventType := range eventTypes {
+		request.EventTypes = append(request.EventTypes, protobuf.HubEventType(eventType))
+	}
+
+	return &request
+}
@@ -0,0 +1,4 @@
+// Package protobuf contains the messages generated from the Hub protobuf schemas, regenerate them in the provider directory with
+//
+//	protoc --proto_path=. --go_out=. --go_opt=paths=source_relative farcaster/protobuf/*.proto
+package protobuf
@@ -0,0 +1,671 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: farcaster/protobuf/hub_event.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HubEventType int32
+
+const (
+	HubEventType_HUB_EVENT_TYPE_NONE                 HubEventType = 0
+	HubEventType_HUB_EVENT_TYPE_MERGE_MESSAGE        HubEventType = 1
+	HubEventType_HUB_EVENT_TYPE_PRUNE_MESSAGE        HubEventType = 2
+	HubEventType_HUB_EVENT_TYPE_REVOKE_MESSAGE       HubEventType = 3
+	HubEventType_HUB_EVENT_TYPE_MERGE_USERNAME_PROOF HubEventType = 6
+	HubEventType_HUB_EVENT_TYPE_MERGE_ON_CHAIN_EVENT HubEventType = 9
+)
+
+// Enum value maps for HubEventType.
+var (
+	HubEventType_name = map[int32]string{
+		0: "HUB_EVENT_TYPE_NONE",
+		1: "HUB_EVENT_TYPE_MERGE_MESSAGE",
+		2: "HUB_EVENT_TYPE_PRUNE_MESSAGE",
+		3: "HUB_EVENT_TYPE_REVOKE_MESSAGE",
+		6: "HUB_EVENT_TYPE_MERGE_USERNAME_PROOF",
+		9: "HUB_EVENT_TYPE_MERGE_ON_CHAIN_EVENT",
+	}
+	HubEventType_value = map[string]int32{
+		"HUB_EVENT_TYPE_NONE":                 0,
+		"HUB_EVENT_TYPE_MERGE_MESSAGE":        1,
+		"HUB_EVENT_TYPE_PRUNE_MESSAGE":        2,
+		"HUB_EVENT_TYPE_REVOKE_MESSAGE":       3,
+		"HUB_EVENT_TYPE_MERGE_USERNAME_PROOF": 6,
+		"HUB_EVENT_TYPE_MERGE_ON_CHAIN_EVENT": 9,
+	}
+)
+
+func (x HubEventType) Enum() *HubEventType {
+	p := new(HubEventType)
+	*p = x
+	return p
+}
+
+func (x HubEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HubEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_farcaster_protobuf_hub_event_proto_enumTypes[0].Descriptor()
+}
+
+func (HubEventType) Type() protoreflect.EnumType {
+	return &file_farcaster_protobuf_hub_event_proto_enumTypes[0]
+}
+
+func (x HubEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HubEventType.Descriptor instead.
+func (HubEventType) EnumDescriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_hub_event_proto_rawDescGZIP(), []int{0}
+}
+
+type MergeMessageBody struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Message         *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	DeletedMessages []*Message             `protobuf:"bytes,2,rep,name=deleted_messages,json=deletedMessages,proto3" json:"deleted_messages,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeMessageBody) Reset() {
+	*x = MergeMessageBody{}
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeMessageBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeMessageBody) ProtoMessage() {}
+
+func (x *MergeMessageBody) ProtoReflect() protoreflect.Message {
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeMessageBody.ProtoReflect.Descriptor instead.
+func (*MergeMessageBody) Descriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_hub_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MergeMessageBody) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MergeMessageBody) GetDeletedMessages() []*Message {
+	if x != nil {
+		return x.DeletedMessages
+	}
+	return nil
+}
+
+type PruneMessageBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PruneMessageBody) Reset() {
+	*x = PruneMessageBody{}
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PruneMessageBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneMessageBody) ProtoMessage() {}
+
+func (x *PruneMessageBody) ProtoReflect() protoreflect.Message {
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneMessageBody.ProtoReflect.Descriptor instead.
+func (*PruneMessageBody) Descriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_hub_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PruneMessageBody) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type RevokeMessageBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMessageBody) Reset() {
+	*x = RevokeMessageBody{}
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMessageBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMessageBody) ProtoMessage() {}
+
+func (x *RevokeMessageBody) ProtoReflect() protoreflect.Message {
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMessageBody.ProtoReflect.Descriptor instead.
+func (*RevokeMessageBody) Descriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_hub_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RevokeMessageBody) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type MergeOnChainEventBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OnChainEvent  *OnChainEvent          `protobuf:"bytes,1,opt,name=on_chain_event,json=onChainEvent,proto3" json:"on_chain_event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeOnChainEventBody) Reset() {
+	*x = MergeOnChainEventBody{}
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeOnChainEventBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeOnChainEventBody) ProtoMessage() {}
+
+func (x *MergeOnChainEventBody) ProtoReflect() protoreflect.Message {
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeOnChainEventBody.ProtoReflect.Descriptor instead.
+func (*MergeOnChainEventBody) Descriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_hub_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MergeOnChainEventBody) GetOnChainEvent() *OnChainEvent {
+	if x != nil {
+		return x.OnChainEvent
+	}
+	return nil
+}
+
+type MergeUserNameProofBody struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	UsernameProof               *UserNameProof         `protobuf:"bytes,1,opt,name=username_proof,json=usernameProof,proto3" json:"username_proof,omitempty"`
+	DeletedUsernameProof        *UserNameProof         `protobuf:"bytes,2,opt,name=deleted_username_proof,json=deletedUsernameProof,proto3" json:"deleted_username_proof,omitempty"`
+	UsernameProofMessage        *Message               `protobuf:"bytes,3,opt,name=username_proof_message,json=usernameProofMessage,proto3" json:"username_proof_message,omitempty"`
+	DeletedUsernameProofMessage *Message               `protobuf:"bytes,4,opt,name=deleted_username_proof_message,json=deletedUsernameProofMessage,proto3" json:"deleted_username_proof_message,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *MergeUserNameProofBody) Reset() {
+	*x = MergeUserNameProofBody{}
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeUserNameProofBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeUserNameProofBody) ProtoMessage() {}
+
+func (x *MergeUserNameProofBody) ProtoReflect() protoreflect.Message {
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeUserNameProofBody.ProtoReflect.Descriptor instead.
+func (*MergeUserNameProofBody) Descriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_hub_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MergeUserNameProofBody) GetUsernameProof() *UserNameProof {
+	if x != nil {
+		return x.UsernameProof
+	}
+	return nil
+}
+
+func (x *MergeUserNameProofBody) GetDeletedUsernameProof() *UserNameProof {
+	if x != nil {
+		return x.DeletedUsernameProof
+	}
+	return nil
+}
+
+func (x *MergeUserNameProofBody) GetUsernameProofMessage() *Message {
+	if x != nil {
+		return x.UsernameProofMessage
+	}
+	return nil
+}
+
+func (x *MergeUserNameProofBody) GetDeletedUsernameProofMessage() *Message {
+	if x != nil {
+		return x.DeletedUsernameProofMessage
+	}
+	return nil
+}
+
+type HubEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  HubEventType           `protobuf:"varint,1,opt,name=type,proto3,enum=farcaster.HubEventType" json:"type,omitempty"`
+	Id    uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*HubEvent_MergeMessageBody
+	//	*HubEvent_PruneMessageBody
+	//	*HubEvent_RevokeMessageBody
+	//	*HubEvent_MergeUsernameProofBody
+	//	*HubEvent_MergeOnChainEventBody
+	Body          isHubEvent_Body `protobuf_oneof:"body"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HubEvent) Reset() {
+	*x = HubEvent{}
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HubEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HubEvent) ProtoMessage() {}
+
+func (x *HubEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_farcaster_protobuf_hub_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HubEvent.ProtoReflect.Descriptor instead.
+func (*HubEvent) Descriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_hub_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HubEvent) GetType() HubEventType {
+	if x != nil {
+		return x.Type
+	}
+	return HubEventType_HUB_EVENT_TYPE_NONE
+}
+
+func (x *HubEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HubEvent) GetBody() isHubEvent_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *HubEvent) GetMergeMessageBody() *MergeMessageBody {
+	if x != nil {
+		if x, ok := x.Body.(*HubEvent_MergeMessageBody); ok {
+			return x.MergeMessageBody
+		}
+	}
+	return nil
+}
+
+func (x *HubEvent) GetPruneMessageBody() *PruneMessageBody {
+	if x != nil {
+		if x, ok := x.Body.(*HubEvent_PruneMessageBody); ok {
+			return x.PruneMessageBody
+		}
+	}
+	return nil
+}
+
+func (x *HubEvent) GetRevokeMessageBody() *RevokeMessageBody {
+	if x != nil {
+		if x, ok := x.Body.(*HubEvent_RevokeMessageBody); ok {
+			return x.RevokeMessageBody
+		}
+	}
+	return nil
+}
+
+func (x *HubEvent) GetMergeUsernameProofBody() *MergeUserNameProofBody {
+	if x != nil {
+		if x, ok := x.Body.(*HubEvent_MergeUsernameProofBody); ok {
+			return x.MergeUsernameProofBody
+		}
+	}
+	return nil
+}
+
+func (x *HubEvent) GetMergeOnChainEventBody() *MergeOnChainEventBody {
+	if x != nil {
+		if x, ok := x.Body.(*HubEvent_MergeOnChainEventBody); ok {
+			return x.MergeOnChainEventBody
+		}
+	}
+	return nil
+}
+
+type isHubEvent_Body interface {
+	isHubEvent_Body()
+}
+
+type HubEvent_MergeMessageBody struct {
+	MergeMessageBody *MergeMessageBody `protobuf:"bytes,3,opt,name=merge_message_body,json=mergeMessageBody,proto3,oneof"`
+}
+
+type HubEvent_PruneMessageBody struct {
+	PruneMessageBody *PruneMessageBody `protobuf:"bytes,4,opt,name=prune_message_body,json=pruneMessageBody,proto3,oneof"`
+}
+
+type HubEvent_RevokeMessageBody struct {
+	RevokeMessageBody *RevokeMessageBody `protobuf:"bytes,5,opt,name=revoke_message_body,json=revokeMessageBody,proto3,oneof"`
+}
+
+type HubEvent_MergeUsernameProofBody struct {
+	MergeUsernameProofBody *MergeUserNameProofBody `protobuf:"bytes,8,opt,name=merge_username_proof_body,json=mergeUsernameProofBody,proto3,oneof"`
+}
+
+type HubEvent_MergeOnChainEventBody struct {
+	MergeOnChainEventBody *MergeOnChainEventBody `protobuf:"bytes,11,opt,name=merge_on_chain_event_body,json=mergeOnChainEventBody,proto3,oneof"`
+}
+
+func (*HubEvent_MergeMessageBody) isHubEvent_Body() {}
+
+func (*HubEvent_PruneMessageBody) isHubEvent_Body() {}
+
+func (*HubEvent_RevokeMessageBody) isHubEvent_Body() {}
+
+func (*HubEvent_MergeUsernameProofBody) isHubEvent_Body() {}
+
+func (*HubEvent_MergeOnChainEventBody) isHubEvent_Body() {}
+
+var File_farcaster_protobuf_hub_event_proto protoreflect.FileDescriptor
+
+var file_farcaster_protobuf_hub_event_proto_rawDesc = string([]byte{
+	0x0a, 0x22, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x68, 0x75, 0x62, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x1a,
+	0x20, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x26, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x66, 0x61, 0x72, 0x63, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x7f, 0x0a, 0x10, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x72, 0x63, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61,
+	0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x56, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x72, 0x63,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x0c, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0xcc, 0x02, 0x0a, 0x16, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x3f, 0x0a, 0x0e, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0d, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x4e, 0x0a, 0x16,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x48, 0x0a, 0x16,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66,
+	0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x14, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x57, 0x0a, 0x1e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x1b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xf7, 0x03, 0x0a, 0x08, 0x48, 0x75, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x72,
+	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x75, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f,
+	0x64, 0x79, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x4b, 0x0a, 0x12, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50,
+	0x72, 0x75, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x48,
+	0x00, 0x52, 0x10, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x4e, 0x0a, 0x13, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x00,
+	0x52, 0x11, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x5e, 0x0a, 0x19, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x00, 0x52, 0x16, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x5c, 0x0a, 0x19, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x48, 0x00, 0x52, 0x15, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x2a, 0xe0, 0x01, 0x0a, 0x0c, 0x48, 0x75,
+	0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x48, 0x55,
+	0x42, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x55, 0x42, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x55, 0x42, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x5f, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x55, 0x42, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45,
+	0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x48, 0x55,
+	0x42, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x52,
+	0x47, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x4f,
+	0x46, 0x10, 0x06, 0x12, 0x27, 0x0a, 0x23, 0x48, 0x55, 0x42, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x4f, 0x4e, 0x5f, 0x43,
+	0x48, 0x41, 0x49, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x09, 0x42, 0x3d, 0x5a, 0x3b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x73, 0x73, 0x33, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x32, 0x2f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+})
+
+var (
+	file_farcaster_protobuf_hub_event_proto_rawDescOnce sync.Once
+	file_farcaster_protobuf_hub_event_proto_rawDescData []byte
+)
+
+func file_farcaster_protobuf_hub_event_proto_rawDescGZIP() []byte {
+	file_farcaster_protobuf_hub_event_proto_rawDescOnce.Do(func() {
+		file_farcaster_protobuf_hub_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_farcaster_protobuf_hub_event_proto_rawDesc), len(file_farcaster_protobuf_hub_event_proto_rawDesc)))
+	})
+	return file_farcaster_protobuf_hub_event_proto_rawDescData
+}
+
+var file_farcaster_protobuf_hub_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_farcaster_protobuf_hub_event_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_farcaster_protobuf_hub_event_proto_goTypes = []any{
+	(HubEventType)(0),              // 0: farcaster.HubEventType
+	(*MergeMessageBody)(nil),       // 1: farcaster.MergeMessageBody
+	(*PruneMessageBody)(nil),       // 2: farcaster.PruneMessageBody
+	(*RevokeMessageBody)(nil),      // 3: farcaster.RevokeMessageBody
+	(*MergeOnChainEventBody)(nil),  // 4: farcaster.MergeOnChainEventBody
+	(*MergeUserNameProofBody)(nil), // 5: farcaster.MergeUserNameProofBody
+	(*HubEvent)(nil),               // 6: farcaster.HubEvent
+	(*Message)(nil),                // 7: farcaster.Message
+	(*OnChainEvent)(nil),           // 8: farcaster.OnChainEvent
+	(*UserNameProof)(nil),          // 9: farcaster.UserNameProof
+}
+var file_farcaster_protobuf_hub_event_proto_depIdxs = []int32{
+	7,  // 0: farcaster.MergeMessageBody.message:type_name -> farcaster.Message
+	7,  // 1: farcaster.MergeMessageBody.deleted_messages:type_name -> farcaster.Message
+	7,  // 2: farcaster.PruneMessageBody.message:type_name -> farcaster.Message
+	7,  // 3: farcaster.RevokeMessageBody.message:type_name -> farcaster.Message
+	8,  // 4: farcaster.MergeOnChainEventBody.on_chain_event:type_name -> farcaster.OnChainEvent
+	9,  // 5: farcaster.MergeUserNameProofBody.username_proof:type_name -> farcaster.UserNameProof
+	9,  // 6: farcaster.MergeUserNameProofBody.deleted_username_proof:type_name -> farcaster.UserNameProof
+	7,  // 7: farcaster.MergeUserNameProofBody.username_proof_message:type_name -> farcaster.Message
+	7,  // 8: farcaster.MergeUserNameProofBody.deleted_username_proof_message:type_name -> farcaster.Message
+	0,  // 9: farcaster.HubEvent.type:type_name -> farcaster.HubEventType
+	1,  // 10: farcaster.HubEvent.merge_message_body:type_name -> farcaster.MergeMessageBody
+	2,  // 11: farcaster.HubEvent.prune_message_body:type_name -> farcaster.PruneMessageBody
+	3,  // 12: farcaster.HubEvent.revoke_message_body:type_name -> farcaster.RevokeMessageBody
+	5,  // 13: farcaster.HubEvent.merge_username_proof_body:type_name -> farcaster.MergeUserNameProofBody
+	4,  // 14: farcaster.HubEvent.merge_on_chain_event_body:type_name -> farcaster.MergeOnChainEventBody
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_farcaster_protobuf_hub_event_proto_init() }
+func file_farcaster_protobuf_hub_event_proto_init() {
+	if File_farcaster_protobuf_hub_event_proto != nil {
+		return
+	}
+	file_farcaster_protobuf_message_proto_init()
+	file_farcaster_protobuf_onchain_event_proto_init()
+	file_farcaster_protobuf_username_proof_proto_init()
+	file_farcaster_protobuf_hub_event_proto_msgTypes[5].OneofWrappers = []any{
+		(*HubEvent_MergeMessageBody)(nil),
+		(*HubEvent_PruneMessageBody)(nil),
+		(*HubEvent_RevokeMessageBody)(nil),
+		(*HubEvent_MergeUsernameProofBody)(nil),
+		(*HubEvent_MergeOnChainEventBody)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_farcaster_protobuf_hub_event_proto_rawDesc), len(file_farcaster_protobuf_hub_event_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_farcaster_protobuf_hub_event_proto_goTypes,
+		DependencyIndexes: file_farcaster_protobuf_hub_event_proto_depIdxs,
+		EnumInfos:         file_farcaster_protobuf_hub_event_proto_enumTypes,
+		MessageInfos:      file_farcaster_protobuf_hub_event_proto_msgTypes,
+	}.Build()
+	File_farcaster_protobuf_hub_event_proto = out.File
+	file_farcaster_protobuf_hub_event_proto_goTypes = nil
+	file_farcaster_protobuf_hub_event_proto_depIdxs = nil
+}
@@ -0,0 +1,149 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: farcaster/protobuf/request_response.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventTypes    []HubEventType         `protobuf:"varint,1,rep,packed,name=event_types,json=eventTypes,proto3,enum=farcaster.HubEventType" json:"event_types,omitempty"`
+	FromId        *uint64                `protobuf:"varint,2,opt,name=from_id,json=fromId,proto3,oneof" json:"from_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_farcaster_protobuf_request_response_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farcaster_protobuf_request_response_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_request_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetEventTypes() []HubEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetFromId() uint64 {
+	if x != nil && x.FromId != nil {
+		return *x.FromId
+	}
+	return 0
+}
+
+var File_farcaster_protobuf_request_response_proto protoreflect.FileDescriptor
+
+var file_farcaster_protobuf_request_response_proto_rawDesc = string([]byte{
+	0x0a, 0x29, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x66, 0x61, 0x72,
+	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x1a, 0x22, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x68, 0x75, 0x62, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x76, 0x0a, 0x10, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
+	0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x48, 0x75, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x66, 0x72, 0x6f,
+	0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x69, 0x64, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x72, 0x73, 0x73, 0x33, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x66,
+	0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_farcaster_protobuf_request_response_proto_rawDescOnce sync.Once
+	file_farcaster_protobuf_request_response_proto_rawDescData []byte
+)
+
+func file_farcaster_protobuf_request_response_proto_rawDescGZIP() []byte {
+	file_farcaster_protobuf_request_response_proto_rawDescOnce.Do(func() {
+		file_farcaster_protobuf_request_response_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_farcaster_protobuf_request_response_proto_rawDesc), len(file_farcaster_protobuf_request_response_proto_rawDesc)))
+	})
+	return file_farcaster_protobuf_request_response_proto_rawDescData
+}
+
+var file_farcaster_protobuf_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_farcaster_protobuf_request_response_proto_goTypes = []any{
+	(*SubscribeRequest)(nil), // 0: farcaster.SubscribeRequest
+	(HubEventType)(0),        // 1: farcaster.HubEventType
+}
+var file_farcaster_protobuf_request_response_proto_depIdxs = []int32{
+	1, // 0: farcaster.SubscribeRequest.event_types:type_name -> farcaster.HubEventType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_farcaster_protobuf_request_response_proto_init() }
+func file_farcaster_protobuf_request_response_proto_init() {
+	if File_farcaster_protobuf_request_response_proto != nil {
+		return
+	}
+	file_farcaster_protobuf_hub_event_proto_init()
+	file_farcaster_protobuf_request_response_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_farcaster_protobuf_request_response_proto_rawDesc), len(file_farcaster_protobuf_request_response_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_farcaster_protobuf_request_response_proto_goTypes,
+		DependencyIndexes: file_farcaster_protobuf_request_response_proto_depIdxs,
+		MessageInfos:      file_farcaster_protobuf_request_response_proto_msgTypes,
+	}.Build()
+	File_farcaster_protobuf_request_response_proto = out.File
+	file_farcaster_protobuf_request_response_proto_goTypes = nil
+	file_farcaster_protobuf_request_response_proto_depIdxs = nil
+}
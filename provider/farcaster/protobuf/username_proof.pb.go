@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: farcaster/protobuf/username_proof.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserNameType int32
+
+const (
+	UserNameType_USERNAME_TYPE_NONE     UserNameType = 0
+	UserNameType_USERNAME_TYPE_FNAME    UserNameType = 1
+	UserNameType_USERNAME_TYPE_ENS_L1   UserNameType = 2
+	UserNameType_USERNAME_TYPE_BASENAME UserNameType = 3
+)
+
+// Enum value maps for UserNameType.
+var (
+	UserNameType_name = map[int32]string{
+		0: "USERNAME_TYPE_NONE",
+		1: "USERNAME_TYPE_FNAME",
+		2: "USERNAME_TYPE_ENS_L1",
+		3: "USERNAME_TYPE_BASENAME",
+	}
+	UserNameType_value = map[string]int32{
+		"USERNAME_TYPE_NONE":     0,
+		"USERNAME_TYPE_FNAME":    1,
+		"USERNAME_TYPE_ENS_L1":   2,
+		"USERNAME_TYPE_BASENAME": 3,
+	}
+)
+
+func (x UserNameType) Enum() *UserNameType {
+	p := new(UserNameType)
+	*p = x
+	return p
+}
+
+func (x UserNameType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserNameType) Descriptor() protoreflect.EnumDescriptor {
+	return file_farcaster_protobuf_username_proof_proto_enumTypes[0].Descriptor()
+}
+
+func (UserNameType) Type() protoreflect.EnumType {
+	return &file_farcaster_protobuf_username_proof_proto_enumTypes[0]
+}
+
+func (x UserNameType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserNameType.Descriptor instead.
+func (UserNameType) EnumDescriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_username_proof_proto_rawDescGZIP(), []int{0}
+}
+
+type UserNameProof struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     uint64                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Name          []byte                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner         []byte                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Fid           uint64                 `protobuf:"varint,5,opt,name=fid,proto3" json:"fid,omitempty"`
+	Type          UserNameType           `protobuf:"varint,6,opt,name=type,proto3,enum=farcaster.UserNameType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserNameProof) Reset() {
+	*x = UserNameProof{}
+	mi := &file_farcaster_protobuf_username_proof_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserNameProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserNameProof) ProtoMessage() {}
+
+func (x *UserNameProof) ProtoReflect() protoreflect.Message {
+	mi := &file_farcaster_protobuf_username_proof_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNameProof.ProtoReflect.Descriptor instead.
+func (*UserNameProof) Descriptor() ([]byte, []int) {
+	return file_farcaster_protobuf_username_proof_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserNameProof) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *UserNameProof) GetName() []byte {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UserNameProof) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *UserNameProof) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *UserNameProof) GetFid() uint64 {
+	if x != nil {
+		return x.Fid
+	}
+	return 0
+}
+
+func (x *UserNameProof) GetType() UserNameType {
+	if x != nil {
+		return x.Type
+	}
+	return UserNameType_USERNAME_TYPE_NONE
+}
+
+var File_farcaster_protobuf_username_proof_proto protoreflect.FileDescriptor
+
+var file_farcaster_protobuf_username_proof_proto_rawDesc = string([]byte{
+	0x0a, 0x27, 0x66, 0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x66, 0x61, 0x72, 0x63, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x22, 0xb4, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x66, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x69, 0x64, 0x12, 0x2b,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66,
+	0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x75, 0x0a, 0x0c, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x55,
+	0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e,
+	0x53, 0x5f, 0x4c, 0x31, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x03, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x72, 0x73, 0x73, 0x33, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x66,
+	0x61, 0x72, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_farcaster_protobuf_username_proof_proto_rawDescOnce sync.Once
+	file_farcaster_protobuf_username_proof_proto_rawDescData []byte
+)
+
+func file_farcaster_protobuf_username_proof_proto_rawDescGZIP() []byte {
+	file_farcaster_protobuf_username_proof_proto_rawDescOnce.Do(func() {
+		file_farcaster_protobuf_username_proof_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_farcaster_protobuf_username_proof_proto_rawDesc), len(file_farcaster_protobuf_username_proof_proto_rawDesc)))
+	})
+	return file_farcaster_protobuf_username_proof_proto_rawDescData
+}
+
+var file_farcaster_protobuf_username_proof_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_farcaster_protobuf_username_proof_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_farcaster_protobuf_username_proof_proto_goTypes = []any{
+	(UserNameType)(0),     // 0: farcaster.UserNameType
+	(*UserNameProof)(nil), // 1: farcaster.UserNameProof
+}
+var file_farcaster_protobuf_username_proof_proto_depIdxs = []int32{
+	0, // 0: farcaster.UserNameProof.type:type_name -> farcaster.UserNameType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_farcaster_protobuf_username_proof_proto_init() }
+func file_farcaster_protobuf_username_proof_proto_init() {
+	if File_farcaster_protobuf_username_proof_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_farcaster_protobuf_username_proof_proto_rawDesc), len(file_farcaster_protobuf_username_proof_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_farcaster_protobuf_username_proof_proto_goTypes,
+		DependencyIndexes: file_farcaster_protobuf_username_proof_proto_depIdxs,
+		EnumInfos:         file_farcaster_protobuf_username_proof_proto_enumTypes,
+		MessageInfos:      file_farcaster_protobuf_username_proof_proto_msgTypes,
+	}.Build()
+	File_farcaster_protobuf_username_proof_proto = out.File
+	file_farcaster_protobuf_username_proof_proto_goTypes = nil
+	file_farcaster_protobuf_username_proof_proto_depIdxs = nil
+}
@@ -0,0 +1,121 @@
+package farcaster_test
+
+import (
+	"testing"
+
+	"github.com/rss3-network/node/v2/provider/farcaster"
+	"github.com/rss3-network/node/v2/provider/farcaster/protobuf"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDecodeHubEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("merge cast add message", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := proto.Marshal(&protobuf.HubEvent{
+			Type: protobuf.HubEventType_HUB_EVENT_TYPE_MERGE_MESSAGE,
+			Id:   432183841886217,
+			Body: &protobuf.HubEvent_MergeMessageBody{
+				MergeMessageBody: &protobuf.MergeMessageBody{
+					Message: &protobuf.Message{
+						Data: &protobuf.MessageData{
+							Type:      protobuf.MessageType_MESSAGE_TYPE_CAST_ADD,
+							Fid:       14142,
+							Timestamp: 78224681,
+							Network:   protobuf.FarcasterNetwork_FARCASTER_NETWORK_MAINNET,
+							Body: &protobuf.MessageData_CastAddBody{
+								CastAddBody: &protobuf.CastAddBody{
+									Mentions: []uint64{2, 5},
+									Parent: &protobuf.CastAddBody_ParentCastId{
+										ParentCastId: &protobuf.CastId{Fid: 3, Hash: []byte{0xab, 0xcd}},
+									},
+									Text: "gm",
+									Embeds: []*protobuf.Embed{
+										{Embed: &protobuf.Embed_Url{Url: "https://rss3.io"}},
+									},
+								},
+							},
+						},
+						Hash:            []byte{0x01, 0x02},
+						HashScheme:      protobuf.HashScheme_HASH_SCHEME_BLAKE3,
+						SignatureScheme: protobuf.SignatureScheme_SIGNATURE_SCHEME_ED25519,
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		result, err := farcaster.DecodeHubEvent(data)
+		require.NoError(t, err)
+
+		require.Equal(t, &farcaster.HubEvent{
+			Type: farcaster.HubEventTypeMergeMessage.String(),
+			ID:   432183841886217,
+			MergeMessageBody: &farcaster.MergeMessageBody{
+				Message: farcaster.Message{
+					Data: farcaster.MessageData{
+						Type:      farcaster.MessageTypeCastAdd.String(),
+						Fid:       14142,
+						Timestamp: 78224681,
+						Network:   "FARCASTER_NETWORK_MAINNET",
+						CastAddBody: &farcaster.CastAddBody{
+							Mentions:     []uint64{2, 5},
+							ParentCastID: &farcaster.CastID{Fid: 3, Hash: "0xabcd"},
+							Text:         "gm",
+							Embeds:       []farcaster.Embed{{URL: "https://rss3.io"}},
+						},
+					},
+					Hash:            "0x0102",
+					HashScheme:      "HASH_SCHEME_BLAKE3",
+					SignatureScheme: "SIGNATURE_SCHEME_ED25519",
+				},
+			},
+		}, result)
+	})
+
+	t.Run("merge id register transfer", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := proto.Marshal(&protobuf.HubEvent{
+			Type: protobuf.HubEventType_HUB_EVENT_TYPE_MERGE_ON_CHAIN_EVENT,
+			Id:   1,
+			Body: &protobuf.HubEvent_MergeOnChainEventBody{
+				MergeOnChainEventBody: &protobuf.MergeOnChainEventBody{
+					OnChainEvent: &protobuf.OnChainEvent{
+						Type: protobuf.OnChainEventType_EVENT_TYPE_ID_REGISTER,
+						Fid:  14142,
+						Body: &protobuf.OnChainEvent_IdRegisterEventBody{
+							IdRegisterEventBody: &protobuf.IdRegisterEventBody{
+								To:        []byte{0x0a},
+								EventType: protobuf.IdRegisterEventType_ID_REGISTER_EVENT_TYPE_TRANSFER,
+							},
+						},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		result, err := farcaster.DecodeHubEvent(data)
+		require.NoError(t, err)
+
+		require.Equal(t, farcaster.OnChainEvent{
+			Type: farcaster.OnChainEventTypeIDRegister,
+			Fid:  14142,
+			IDRegisterEventBody: &farcaster.IDRegisterEventBody{
+				To:        "0x0a",
+				EventType: farcaster.IDRegisterEventTypeTransfer,
+			},
+		}, result.MergeOnChainEventBody.OnChainEvent)
+	})
+
+	t.Run("truncated message", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := farcaster.DecodeHubEvent([]byte{0x1a, 0x05, 0x0a})
+		require.Error(t, err)
+	})
+}
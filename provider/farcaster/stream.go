@@ -0,0 +1,124 @@
+package farcaster
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+
+	"github.com/rss3-network/node/v2/provider/farcaster/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// EndpointStreamMainnet is the gRPC endpoint of the public Hub, the gRPC API is served on the port 2283.
+	EndpointStreamMainnet = "grpc://nemes.farcaster.xyz:2283"
+
+	// methodSubscribe is the Subscribe method of the HubService, which is declared without a package by the Hub schemas.
+	methodSubscribe = "/HubService/Subscribe"
+)
+
+var _ StreamClient = (*streamClient)(nil)
+
+// StreamClient subscribes to the events of a Hub over gRPC.
+type StreamClient interface {
+	// Subscribe subscribes to the events of the types from the event ID, the latest events are streamed if it is nil.
+	Subscribe(ctx context.Context, eventTypes []HubEventType, fromEventID *uint64) (EventStream, error)
+	Close() error
+}
+
+// EventStream receives the events of a subscription in order.
+type EventStream interface {
+	Recv() (*HubEvent, error)
+}
+
+type streamClient struct {
+	connection *grpc.ClientConn
+	apiKey     *string
+}
+
+func (c *streamClient) Subscribe(ctx context.Context, eventTypes []HubEventType, fromEventID *uint64) (EventStream, error) {
+	if c.apiKey != nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, "api_key", *c.apiKey)
+	}
+
+	stream, err := c.connection.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, methodSubscribe)
+	if err != nil {
+		return nil, fmt.Errorf("open stream: %w", err)
+	}
+
+	if err := stream.SendMsg(newSubscribeRequest(eventTypes, fromEventID)); err != nil {
+		return nil, fmt.Errorf("send subscribe request: %w", err)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return nil, fmt.Errorf("close send: %w", err)
+	}
+
+	return &eventStream{stream: stream}, nil
+}
+
+func (c *streamClient) Close() error {
+	return c.connection.Close()
+}
+
+type eventStream struct {
+	stream grpc.ClientStream
+}
+
+func (s *eventStream) Recv() (*HubEvent, error) {
+	var event protobuf.HubEvent
+
+	if err := s.stream.RecvMsg(&event); err != nil {
+		return nil, err
+	}
+
+	return NewHubEvent(&event), nil
+}
+
+// NewStreamClient creates a gRPC client of the Hub, the endpoint is served over TLS
+// if its scheme is grpcs or https, and in plaintext if it is grpc or http.
+func NewStreamClient(endpoint string, options ...StreamClientOption) (StreamClient, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse endpoint: %w", err)
+	}
+
+	var transportCredentials credentials.TransportCredentials
+
+	switch endpointURL.Scheme {
+	case "grpcs", "https":
+		transportCredentials = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	case "grpc", "http":
+		transportCredentials = insecure.NewCredentials()
+	default:
+		return nil, fmt.Errorf("unsupported endpoint scheme %q", endpointURL.Scheme)
+	}
+
+	var instance streamClient
+
+	for _, option := range options {
+		if err := option(&instance); err != nil {
+			return nil, fmt.Errorf("apply options: %w", err)
+		}
+	}
+
+	if instance.connection, err = grpc.NewClient(endpointURL.Host, grpc.WithTransportCredentials(transportCredentials)); err != nil {
+		return nil, fmt.Errorf("dial %s: %w", endpointURL.Host, err)
+	}
+
+	return &instance, nil
+}
+
+type StreamClientOption func(client *streamClient) error
+
+func WithStreamAPIKey(apiKey *string) StreamClientOption {
+	return func(c *streamClient) error {
+		c.apiKey = apiKey
+
+		return nil
+	}
+}
@@ -0,0 +1,68 @@
+package farcaster_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/rss3-network/node/v2/provider/farcaster"
+	"github.com/rss3-network/node/v2/provider/farcaster/protobuf"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestStreamClient(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	// The Hub streams the events from the requested event ID.
+	server := grpc.NewServer()
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "HubService",
+		HandlerType: (*any)(nil),
+		Streams: []grpc.StreamDesc{
+			{
+				StreamName:    "Subscribe",
+				ServerStreams: true,
+				Handler: func(_ any, stream grpc.ServerStream) error {
+					var request protobuf.SubscribeRequest
+
+					if err := stream.RecvMsg(&request); err != nil {
+						return err
+					}
+
+					return stream.SendMsg(&protobuf.HubEvent{
+						Type: request.GetEventTypes()[0],
+						Id:   request.GetFromId(),
+					})
+				},
+			},
+		},
+	}, nil)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	client, err := farcaster.NewStreamClient("grpc://" + listener.Addr().String())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	stream, err := client.Subscribe(context.Background(), []farcaster.HubEventType{farcaster.HubEventTypeMergeOnChainEvent}, lo.ToPtr(uint64(432183841886217)))
+	require.NoError(t, err)
+
+	event, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, &farcaster.HubEvent{
+		Type: farcaster.HubEventTypeMergeOnChainEvent.String(),
+		ID:   432183841886217,
+	}, event)
+}
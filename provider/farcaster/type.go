@@ -66,3 +66,18 @@ const (
 	ProtocolEthereum AccountType = 0
 	ProtocolSolana   AccountType = 1
 )
+
+// LinkTypeFollow is the type of the links following an account, it is the only type of link defined by the protocol.
+const LinkTypeFollow = "follow"
+
+// The on-chain event types are only matched by name, so they are kept as strings.
+const (
+	OnChainEventTypeSigner         = "EVENT_TYPE_SIGNER"
+	OnChainEventTypeSignerMigrated = "EVENT_TYPE_SIGNER_MIGRATED"
+	OnChainEventTypeIDRegister     = "EVENT_TYPE_ID_REGISTER"
+	OnChainEventTypeStorageRent    = "EVENT_TYPE_STORAGE_RENT"
+
+	IDRegisterEventTypeRegister       = "ID_REGISTER_EVENT_TYPE_REGISTER"
+	IDRegisterEventTypeTransfer       = "ID_REGISTER_EVENT_TYPE_TRANSFER"
+	IDRegisterEventTypeChangeRecovery = "ID_REGISTER_EVENT_TYPE_CHANGE_RECOVERY"
+)
@@ -12,6 +12,10 @@ import (
 
 // NewClient creates a new Redis client.
 func NewClient(option config.Redis) (rueidis.Client, error) {
+	if option.Embedded {
+		return newEmbeddedClient(option)
+	}
+
 	clientOption := rueidis.ClientOption{
 		InitAddress:  []string{option.Endpoint},
 		Username:     option.Username,
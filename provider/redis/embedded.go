@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/v2/config"
+	"go.uber.org/zap"
+)
+
+var _ rueidis.Client = (*embeddedClient)(nil)
+
+// embeddedClient is a client of an in-process Redis server, the server is stopped when the client is closed.
+type embeddedClient struct {
+	rueidis.Client
+
+	server *miniredis.Miniredis
+}
+
+func (c *embeddedClient) Close() {
+	c.Client.Close()
+	c.server.Close()
+}
+
+// newEmbeddedClient starts an in-process Redis server listening on the endpoint and connects to it.
+// The data of the server is kept in memory only and is not persisted, it is lost when the node stops,
+// and the epoch and block start parameters are pulled again when the node starts.
+// The server is never shared between processes, so it fails if the endpoint is already in use.
+func newEmbeddedClient(option config.Redis) (rueidis.Client, error) {
+	server := miniredis.NewMiniRedis()
+
+	if option.Username != "" || option.Password != "" {
+		server.RequireUserAuth(option.Username, option.Password)
+	}
+
+	clientOption := rueidis.ClientOption{
+		InitAddress:  []string{option.Endpoint},
+		Username:     option.Username,
+		Password:     option.Password,
+		DisableCache: true,
+	}
+
+	if err := server.StartAddr(option.Endpoint); err != nil {
+		if errors.Is(err, syscall.EADDRINUSE) {
+			return nil, fmt.Errorf("start embedded redis server: endpoint %s is already in use, use an external redis server to share it between processes: %w", option.Endpoint, err)
+		}
+
+		return nil, fmt.Errorf("start embedded redis server: %w", err)
+	}
+
+	zap.L().Info("embedded redis server started", zap.String("endpoint", server.Addr()))
+
+	client, err := rueidis.NewClient(clientOption)
+	if err != nil {
+		server.Close()
+
+		return nil, err
+	}
+
+	return &embeddedClient{
+		Client: client,
+		server: server,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package rss
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	DefaultTimeout = 30 * time.Second
+
+	// maxFeedSize bounds the size of a feed document.
+	maxFeedSize = 10 << 20
+
+	headerAccept          = "Accept"
+	headerETag            = "ETag"
+	headerIfModifiedSince = "If-Modified-Since"
+	headerIfNoneMatch     = "If-None-Match"
+	headerLastModified    = "Last-Modified"
+	headerUserAgent       = "User-Agent"
+
+	feedAcceptTypes = "application/rss+xml, application/atom+xml, application/feed+json, application/xml;q=0.9, text/xml;q=0.9, application/json;q=0.8, */*;q=0.1"
+)
+
+var ErrNotModified = errors.New("feed not modified")
+
+var _ Client = (*client)(nil)
+
+type Client interface {
+	// Fetch fetches and parses a feed with a conditional request, ErrNotModified is returned
+	// if the feed has not changed since the validator was returned.
+	Fetch(ctx context.Context, feedURL string, validator Validator) (*Feed, *Validator, error)
+}
+
+type client struct {
+	httpClient *http.Client
+	userAgent  string
+}
+
+func (c *client) Fetch(ctx context.Context, feedURL string, validator Validator) (*Feed, *Validator, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, feedURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create request: %w", err)
+	}
+
+	request.Header.Set(headerAccept, feedAcceptTypes)
+
+	if c.userAgent != "" {
+		request.Header.Set(headerUserAgent, c.userAgent)
+	}
+
+	if validator.ETag != "" {
+		request.Header.Set(headerIfNoneMatch, validator.ETag)
+	}
+
+	if validator.LastModified != "" {
+		request.Header.Set(headerIfModifiedSince, validator.LastModified)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("send request: %w", err)
+	}
+
+	defer lo.Try(response.Body.Close)
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil, &validator, ErrNotModified
+	default:
+		return nil, nil, fmt.Errorf("unexpected status %s", response.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxFeedSize))
+	if err != nil {
+		return nil, nil, fmt.Errorf("read response: %w", err)
+	}
+
+	feed, err := Parse(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse feed: %w", err)
+	}
+
+	return feed, &Validator{
+		ETag:         response.Header.Get(headerETag),
+		LastModified: response.Header.Get(headerLastModified),
+	}, nil
+}
+
+func NewClient(options ...ClientOption) (Client, error) {
+	instance := client{
+		httpClient: &http.Client{
+			Timeout: DefaultTimeout,
+		},
+	}
+
+	for _, option := range options {
+		if err := option(&instance); err != nil {
+			return nil, fmt.Errorf("apply options: %w", err)
+		}
+	}
+
+	return &instance, nil
+}
+
+type ClientOption func(client *client) error
+
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *client) error {
+		c.httpClient = httpClient
+
+		return nil
+	}
+}
+
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *client) error {
+		c.userAgent = userAgent
+
+		return nil
+	}
+}
@@ -0,0 +1,382 @@
+package rss
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"golang.org/x/net/html/charset"
+)
+
+const jsonFeedVersionPrefix = "https://jsonfeed.org/version/"
+
+var ErrUnsupportedFormat = errors.New("unsupported feed format")
+
+// timeLayouts are the date formats found in the wild, RSS uses RFC 822 and Atom and JSON Feed use RFC 3339.
+var timeLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339Nano,
+	time.RFC3339,
+	time.RFC822Z,
+	time.RFC822,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"Mon, 2 Jan 2006 15:04 -0700",
+	"Mon, 2 Jan 2006 15:04 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2 Jan 2006 15:04:05 MST",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Parse parses a feed document, the format is detected from the root of the document.
+func Parse(data []byte) (*Feed, error) {
+	data = bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+
+	if len(data) == 0 {
+		return nil, ErrUnsupportedFormat
+	}
+
+	if data[0] == '{' {
+		return parseJSONFeed(data)
+	}
+
+	decoder := newXMLDecoder(data)
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, ErrUnsupportedFormat
+			}
+
+			return nil, fmt.Errorf("decode xml: %w", err)
+		}
+
+		element, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch element.Name.Local {
+		case "rss", "RDF":
+			return parseRSS(data)
+		case "feed":
+			return parseAtom(data)
+		default:
+			return nil, fmt.Errorf("%w: root element %s", ErrUnsupportedFormat, element.Name.Local)
+		}
+	}
+}
+
+// newXMLDecoder creates a decoder that accepts the documents in legacy charsets and with HTML entities.
+func newXMLDecoder(data []byte) *xml.Decoder {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.CharsetReader = charset.NewReaderLabel
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+
+	return decoder
+}
+
+type rssDocument struct {
+	Channel rssChannel `xml:"channel"`
+	// RSS 1.0 places the items beside the channel.
+	Items []rssItem `xml:"item"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Links       []string  `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	About       string         `xml:"http://www.w3.org/1999/02/22-rdf-syntax-ns# about,attr"`
+	GUID        string         `xml:"guid"`
+	Links       []string       `xml:"link"`
+	Title       string         `xml:"title"`
+	Description string         `xml:"description"`
+	Content     string         `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Author      string         `xml:"author"`
+	Creators    []string       `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Categories  []string       `xml:"category"`
+	PubDate     string         `xml:"pubDate"`
+	Date        string         `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Enclosures  []rssEnclosure `xml:"enclosure"`
+}
+
+type rssEnclosure struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// parseRSS parses an RSS 2.0 or RSS 1.0 (RDF) document.
+func parseRSS(data []byte) (*Feed, error) {
+	var document rssDocument
+	if err := newXMLDecoder(data).Decode(&document); err != nil {
+		return nil, fmt.Errorf("decode rss: %w", err)
+	}
+
+	feed := Feed{
+		Title:       strings.TrimSpace(document.Channel.Title),
+		Link:        firstNonEmpty(document.Channel.Links...),
+		Description: strings.TrimSpace(document.Channel.Description),
+	}
+
+	for _, item := range append(document.Channel.Items, document.Items...) {
+		feed.Items = append(feed.Items, &Item{
+			ID:         firstNonEmpty(item.GUID, item.About),
+			Link:       firstNonEmpty(item.Links...),
+			Title:      strings.TrimSpace(item.Title),
+			Summary:    strings.TrimSpace(item.Description),
+			Content:    strings.TrimSpace(item.Content),
+			Authors:    nonEmpty(append([]string{item.Author}, item.Creators...)),
+			Categories: nonEmpty(item.Categories),
+			Enclosures: lo.FilterMap(item.Enclosures, func(enclosure rssEnclosure, _ int) (Enclosure, bool) {
+				return Enclosure{URL: strings.TrimSpace(enclosure.URL), MimeType: enclosure.Type}, strings.TrimSpace(enclosure.URL) != ""
+			}),
+			PublishedAt: parseTime(firstNonEmpty(item.PubDate, item.Date)),
+		})
+	}
+
+	return &feed, nil
+}
+
+type atomFeed struct {
+	Title    atomText    `xml:"title"`
+	Subtitle atomText    `xml:"subtitle"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      atomText       `xml:"title"`
+	Links      []atomLink     `xml:"link"`
+	Summary    atomText       `xml:"summary"`
+	Content    atomText       `xml:"content"`
+	Authors    []atomPerson   `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+}
+
+type atomText struct {
+	Type     string `xml:"type,attr"`
+	Text     string `xml:",chardata"`
+	InnerXML string `xml:",innerxml"`
+}
+
+// String returns the text, the XHTML text is kept as markup.
+func (t atomText) String() string {
+	if t.Type == "xhtml" {
+		return strings.TrimSpace(t.InnerXML)
+	}
+
+	return strings.TrimSpace(t.Text)
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// parseAtom parses an Atom 1.0 document.
+func parseAtom(data []byte) (*Feed, error) {
+	var document atomFeed
+	if err := newXMLDecoder(data).Decode(&document); err != nil {
+		return nil, fmt.Errorf("decode atom: %w", err)
+	}
+
+	feed := Feed{
+		Title:       document.Title.String(),
+		Link:        atomAlternateLink(document.Links),
+		Description: document.Subtitle.String(),
+	}
+
+	for _, entry := range document.Entries {
+		feed.Items = append(feed.Items, &Item{
+			ID:      strings.TrimSpace(entry.ID),
+			Link:    atomAlternateLink(entry.Links),
+			Title:   entry.Title.String(),
+			Summary: entry.Summary.String(),
+			Content: entry.Content.String(),
+			Authors: nonEmpty(lo.Map(entry.Authors, func(author atomPerson, _ int) string {
+				return author.Name
+			})),
+			Categories: nonEmpty(lo.Map(entry.Categories, func(category atomCategory, _ int) string {
+				return category.Term
+			})),
+			Enclosures: lo.FilterMap(entry.Links, func(link atomLink, _ int) (Enclosure, bool) {
+				return Enclosure{URL: link.Href, MimeType: link.Type}, link.Rel == "enclosure" && link.Href != ""
+			}),
+			PublishedAt: parseTime(entry.Published),
+			UpdatedAt:   parseTime(entry.Updated),
+		})
+	}
+
+	return &feed, nil
+}
+
+// atomAlternateLink returns the alternate link, which is the link without a relation by default.
+func atomAlternateLink(links []atomLink) string {
+	link, _ := lo.Find(links, func(link atomLink) bool {
+		return link.Href != "" && (link.Rel == "" || link.Rel == "alternate")
+	})
+
+	return strings.TrimSpace(link.Href)
+}
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	Description string         `json:"description"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            json.RawMessage      `json:"id"`
+	URL           string               `json:"url"`
+	ExternalURL   string               `json:"external_url"`
+	Title         string               `json:"title"`
+	ContentHTML   string               `json:"content_html"`
+	ContentText   string               `json:"content_text"`
+	Summary       string               `json:"summary"`
+	Image         string               `json:"image"`
+	DatePublished string               `json:"date_published"`
+	DateModified  string               `json:"date_modified"`
+	Author        *jsonFeedAuthor      `json:"author"`
+	Authors       []jsonFeedAuthor     `json:"authors"`
+	Tags          []string             `json:"tags"`
+	Attachments   []jsonFeedAttachment `json:"attachments"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedAttachment struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+}
+
+// parseJSONFeed parses a JSON Feed 1.0 or 1.1 document.
+func parseJSONFeed(data []byte) (*Feed, error) {
+	var document jsonFeed
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("decode json feed: %w", err)
+	}
+
+	if !strings.HasPrefix(document.Version, jsonFeedVersionPrefix) {
+		return nil, fmt.Errorf("%w: json feed version %q", ErrUnsupportedFormat, document.Version)
+	}
+
+	feed := Feed{
+		Title:       strings.TrimSpace(document.Title),
+		Link:        strings.TrimSpace(document.HomePageURL),
+		Description: strings.TrimSpace(document.Description),
+	}
+
+	for _, item := range document.Items {
+		// JSON Feed 1.0 has a single author, which is replaced by the authors in 1.1.
+		authors := item.Authors
+		if item.Author != nil {
+			authors = append(authors, *item.Author)
+		}
+
+		enclosures := lo.FilterMap(item.Attachments, func(attachment jsonFeedAttachment, _ int) (Enclosure, bool) {
+			return Enclosure{URL: attachment.URL, MimeType: attachment.MimeType}, attachment.URL != ""
+		})
+
+		if item.Image != "" {
+			enclosures = append(enclosures, Enclosure{URL: item.Image})
+		}
+
+		feed.Items = append(feed.Items, &Item{
+			ID:      jsonFeedItemID(item.ID),
+			Link:    firstNonEmpty(item.URL, item.ExternalURL),
+			Title:   strings.TrimSpace(item.Title),
+			Summary: strings.TrimSpace(item.Summary),
+			Content: firstNonEmpty(item.ContentHTML, item.ContentText),
+			Authors: nonEmpty(lo.Map(authors, func(author jsonFeedAuthor, _ int) string {
+				return author.Name
+			})),
+			Categories:  nonEmpty(item.Tags),
+			Enclosures:  enclosures,
+			PublishedAt: parseTime(item.DatePublished),
+			UpdatedAt:   parseTime(item.DateModified),
+		})
+	}
+
+	return &feed, nil
+}
+
+// jsonFeedItemID returns the ID of a JSON Feed item, which must be a string but is a number in some feeds.
+func jsonFeedItemID(value json.RawMessage) string {
+	var id string
+	if err := json.Unmarshal(value, &id); err == nil {
+		return strings.TrimSpace(id)
+	}
+
+	if bytes.Equal(value, []byte("null")) {
+		return ""
+	}
+
+	return string(value)
+}
+
+// parseTime parses a date of a feed, a zero time is returned if the date is missing or malformed.
+func parseTime(value string) time.Time {
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return time.Time{}
+	}
+
+	for _, layout := range timeLayouts {
+		if timestamp, err := time.Parse(layout, value); err == nil {
+			return timestamp.UTC()
+		}
+	}
+
+	return time.Time{}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func nonEmpty(values []string) []string {
+	return lo.Uniq(lo.FilterMap(values, func(value string, _ int) (string, bool) {
+		value = strings.TrimSpace(value)
+
+		return value, value != ""
+	}))
+}
@@ -0,0 +1,216 @@
+package rss_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/v2/provider/rss"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	rssDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom" xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>RSS3 Blog</title>
+    <atom:link href="https://rss3.io/blog/rss.xml" rel="self" type="application/rss+xml"/>
+    <link>https://rss3.io/blog</link>
+    <description>News of the Open Information Layer.</description>
+    <item>
+      <title>Introducing Native Feeds</title>
+      <link>https://rss3.io/blog/native-feeds</link>
+      <guid isPermaLink="false">post-2</guid>
+      <description>Feeds are indexed natively.</description>
+      <content:encoded><![CDATA[<p>Feeds are indexed <b>natively</b>.</p>]]></content:encoded>
+      <dc:creator>Alice</dc:creator>
+      <category>Node</category>
+      <enclosure url="https://rss3.io/blog/native-feeds.png" type="image/png" length="1024"/>
+      <pubDate>Thu, 17 Oct 2024 08:00:00 +0000</pubDate>
+    </item>
+    <item>
+      <title>Hello &amp; Welcome</title>
+      <link>https://rss3.io/blog/hello</link>
+      <pubDate>Wed, 16 Oct 2024 08:00:00 GMT</pubDate>
+    </item>
+  </channel>
+</rss>`
+
+	atomDocument = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>RSS3 Blog</title>
+  <subtitle>News of the Open Information Layer.</subtitle>
+  <link href="https://rss3.io/blog/atom.xml" rel="self"/>
+  <link href="https://rss3.io/blog"/>
+  <entry>
+    <id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+    <title type="html">Introducing &lt;i&gt;Native&lt;/i&gt; Feeds</title>
+    <link rel="alternate" href="https://rss3.io/blog/native-feeds"/>
+    <link rel="enclosure" href="https://rss3.io/blog/native-feeds.mp3" type="audio/mpeg"/>
+    <summary>Feeds are indexed natively.</summary>
+    <content type="xhtml"><div xmlns="http://www.w3.org/1999/xhtml"><p>Indexed</p></div></content>
+    <author><name>Alice</name></author>
+    <category term="Node"/>
+    <updated>2024-10-17T09:00:00Z</updated>
+    <published>2024-10-17T08:00:00+00:00</published>
+  </entry>
+</feed>`
+
+	jsonFeedDocument = `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "RSS3 Blog",
+  "home_page_url": "https://rss3.io/blog",
+  "items": [
+    {
+      "id": 2,
+      "url": "https://rss3.io/blog/native-feeds",
+      "title": "Introducing Native Feeds",
+      "content_html": "<p>Feeds are indexed natively.</p>",
+      "image": "https://rss3.io/blog/native-feeds.png",
+      "date_published": "2024-10-17T08:00:00Z",
+      "authors": [{"name": "Alice"}],
+      "tags": ["Node"]
+    }
+  ]
+}`
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	published := time.Date(2024, time.October, 17, 8, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name     string
+		document string
+		want     *rss.Feed
+	}{
+		{
+			name:     "RSS 2.0",
+			document: rssDocument,
+			want: &rss.Feed{
+				Title:       "RSS3 Blog",
+				Link:        "https://rss3.io/blog",
+				Description: "News of the Open Information Layer.",
+				Items: []*rss.Item{
+					{
+						ID:          "post-2",
+						Link:        "https://rss3.io/blog/native-feeds",
+						Title:       "Introducing Native Feeds",
+						Summary:     "Feeds are indexed natively.",
+						Content:     "<p>Feeds are indexed <b>natively</b>.</p>",
+						Authors:     []string{"Alice"},
+						Categories:  []string{"Node"},
+						Enclosures:  []rss.Enclosure{{URL: "https://rss3.io/blog/native-feeds.png", MimeType: "image/png"}},
+						PublishedAt: published,
+					},
+					{
+						Link:        "https://rss3.io/blog/hello",
+						Title:       "Hello & Welcome",
+						Authors:     []string{},
+						Categories:  []string{},
+						Enclosures:  []rss.Enclosure{},
+						PublishedAt: published.Add(-24 * time.Hour),
+					},
+				},
+			},
+		},
+		{
+			name:     "Atom",
+			document: atomDocument,
+			want: &rss.Feed{
+				Title:       "RSS3 Blog",
+				Link:        "https://rss3.io/blog",
+				Description: "News of the Open Information Layer.",
+				Items: []*rss.Item{
+					{
+						ID:          "urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a",
+						Link:        "https://rss3.io/blog/native-feeds",
+						Title:       "Introducing <i>Native</i> Feeds",
+						Summary:     "Feeds are indexed natively.",
+						Content:     `<div xmlns="http://www.w3.org/1999/xhtml"><p>Indexed</p></div>`,
+						Authors:     []string{"Alice"},
+						Categories:  []string{"Node"},
+						Enclosures:  []rss.Enclosure{{URL: "https://rss3.io/blog/native-feeds.mp3", MimeType: "audio/mpeg"}},
+						PublishedAt: published,
+						UpdatedAt:   published.Add(time.Hour),
+					},
+				},
+			},
+		},
+		{
+			name:     "JSON Feed",
+			document: jsonFeedDocument,
+			want: &rss.Feed{
+				Title: "RSS3 Blog",
+				Link:  "https://rss3.io/blog",
+				Items: []*rss.Item{
+					{
+						ID:          "2",
+						Link:        "https://rss3.io/blog/native-feeds",
+						Title:       "Introducing Native Feeds",
+						Content:     "<p>Feeds are indexed natively.</p>",
+						Authors:     []string{"Alice"},
+						Categories:  []string{"Node"},
+						Enclosures:  []rss.Enclosure{{URL: "https://rss3.io/blog/native-feeds.png"}},
+						PublishedAt: published,
+					},
+				},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			feed, err := rss.Parse([]byte(testcase.document))
+			require.NoError(t, err)
+			require.Equal(t, testcase.want, feed)
+		})
+	}
+
+	t.Run("Unsupported format", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := rss.Parse([]byte(`<html><body>Not a feed</body></html>`))
+		require.ErrorIs(t, err, rss.ErrUnsupportedFormat)
+
+		_, err = rss.Parse([]byte(`{"title": "Not a feed"}`))
+		require.ErrorIs(t, err, rss.ErrUnsupportedFormat)
+	})
+}
+
+func TestClient_Fetch(t *testing.T) {
+	t.Parallel()
+
+	const etag = `"v1"`
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("If-None-Match") == etag {
+			writer.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		writer.Header().Set("ETag", etag)
+		writer.Header().Set("Last-Modified", "Thu, 17 Oct 2024 08:00:00 GMT")
+		_, _ = writer.Write([]byte(rssDocument))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := rss.NewClient()
+	require.NoError(t, err)
+
+	feed, validator, err := client.Fetch(context.Background(), server.URL, rss.Validator{})
+	require.NoError(t, err)
+	require.Len(t, feed.Items, 2)
+	require.Equal(t, &rss.Validator{ETag: etag, LastModified: "Thu, 17 Oct 2024 08:00:00 GMT"}, validator)
+
+	_, _, err = client.Fetch(context.Background(), server.URL, *validator)
+	require.ErrorIs(t, err, rss.ErrNotModified)
+}
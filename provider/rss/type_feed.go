@@ -0,0 +1,61 @@
+package rss
+
+import (
+	"time"
+)
+
+// Feed is a feed parsed from an RSS 2.0, RSS 1.0, Atom or JSON Feed document.
+type Feed struct {
+	Title       string  `json:"title"`
+	Link        string  `json:"link"`
+	Description string  `json:"description"`
+	Items       []*Item `json:"items"`
+}
+
+// Item is an entry of a feed.
+type Item struct {
+	ID          string      `json:"id"`
+	Link        string      `json:"link"`
+	Title       string      `json:"title"`
+	Summary     string      `json:"summary"`
+	Content     string      `json:"content"`
+	Authors     []string    `json:"authors"`
+	Categories  []string    `json:"categories"`
+	Enclosures  []Enclosure `json:"enclosures"`
+	PublishedAt time.Time   `json:"published_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// Key returns the identifier of the item in its feed, the links and titles are
+// the fallbacks of the feeds that have no identifiers for their items.
+func (i *Item) Key() string {
+	switch {
+	case i.ID != "":
+		return i.ID
+	case i.Link != "":
+		return i.Link
+	default:
+		return i.Title + "@" + i.Timestamp().UTC().Format(time.RFC3339)
+	}
+}
+
+// Timestamp returns the time the item was published, or updated if the publish time is unknown.
+func (i *Item) Timestamp() time.Time {
+	if i.PublishedAt.IsZero() {
+		return i.UpdatedAt
+	}
+
+	return i.PublishedAt
+}
+
+// Enclosure is a media file attached to an item.
+type Enclosure struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+}
+
+// Validator is the HTTP cache validator of a feed, it is sent back in the conditional requests.
+type Validator struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
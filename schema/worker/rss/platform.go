@@ -9,6 +9,7 @@ type Platform uint64
 const (
 	PlatformUnknown Platform = iota // Unknown
 	PlatformRSSHub                  // RSSHub
+	PlatformRSS                     // RSS
 )
 
 var _ echo.BindUnmarshaler = (*Platform)(nil)
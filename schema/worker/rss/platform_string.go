@@ -9,11 +9,11 @@ import (
 	"strings"
 )
 
-const _PlatformName = "UnknownRSSHub"
+const _PlatformName = "UnknownRSSHubRSS"
 
-var _PlatformIndex = [...]uint8{0, 7, 13}
+var _PlatformIndex = [...]uint8{0, 7, 13, 16}
 
-const _PlatformLowerName = "unknownrsshub"
+const _PlatformLowerName = "unknownrsshubrss"
 
 func (i Platform) String() string {
 	if i >= Platform(len(_PlatformIndex)-1) {
@@ -32,20 +32,24 @@ func _PlatformNoOp() {
 	var x [1]struct{}
 	_ = x[PlatformUnknown-(0)]
 	_ = x[PlatformRSSHub-(1)]
+	_ = x[PlatformRSS-(2)]
 }
 
-var _PlatformValues = []Platform{PlatformUnknown, PlatformRSSHub}
+var _PlatformValues = []Platform{PlatformUnknown, PlatformRSSHub, PlatformRSS}
 
 var _PlatformNameToValueMap = map[string]Platform{
-	_PlatformName[0:7]:       PlatformUnknown,
-	_PlatformLowerName[0:7]:  PlatformUnknown,
-	_PlatformName[7:13]:      PlatformRSSHub,
-	_PlatformLowerName[7:13]: PlatformRSSHub,
+	_PlatformName[0:7]:        PlatformUnknown,
+	_PlatformLowerName[0:7]:   PlatformUnknown,
+	_PlatformName[7:13]:       PlatformRSSHub,
+	_PlatformLowerName[7:13]:  PlatformRSSHub,
+	_PlatformName[13:16]:      PlatformRSS,
+	_PlatformLowerName[13:16]: PlatformRSS,
 }
 
 var _PlatformNames = []string{
 	_PlatformName[0:7],
 	_PlatformName[7:13],
+	_PlatformName[13:16],
 }
 
 // PlatformString retrieves an enum value from the enum constants string name.
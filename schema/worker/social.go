@@ -0,0 +1,18 @@
+package worker
+
+// The social graph has no dedicated activity type, so follows are indexed as
+// social profile updates of the follower, with the followed handle as the value.
+const (
+	SocialProfileKeyFollow   = "follow"
+	SocialProfileKeyUnfollow = "unfollow"
+
+	// Blocks are indexed the same way, with the blocked account as the value.
+	SocialProfileKeyBlock   = "block"
+	SocialProfileKeyUnblock = "unblock"
+
+	// Lists are indexed as updates of their owner, with the name of the list as the value
+	// of a created list, and the account added to or removed from the list as the value of a list item.
+	SocialProfileKeyList       = "list"
+	SocialProfileKeyListAdd    = "list_add"
+	SocialProfileKeyListRemove = "list_remove"
+)